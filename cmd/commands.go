@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"github.com/itering/subscan/configs"
 	"github.com/itering/subscan/internal/observer"
 	"github.com/itering/subscan/internal/script"
 	"github.com/itering/subscan/internal/service"
@@ -20,6 +21,10 @@ var commands = []cli.Command{
 		Name:  "start",
 		Usage: "Start a daemon to subscribe to block or start a worker to process events",
 		Action: func(c *cli.Context) error {
+			// external plugin processes are only started by commands delivering blocks to plugins or serving plugin api
+			if err := plugins.RegisterExternal(configs.Boot.Plugin.Dir); err != nil {
+				return err
+			}
 			observer.Run(c.Args().Get(0))
 			return nil
 		},
@@ -59,15 +64,43 @@ var commands = []cli.Command{
 			return script.MigrateAccountExtrinsicMapping()
 		},
 	},
+	{
+		Name:  "MigratePartition",
+		Usage: "convert split block, event, extrinsic, log tables to native range partitioned tables",
+		Flags: []cli.Flag{
+			cli.UintFlag{Name: "batch", Value: 10000, Usage: "blocks copied per batch"},
+			cli.BoolFlag{Name: "drop", Usage: "drop split tables after migrated"},
+		},
+		Action: func(c *cli.Context) error {
+			return script.MigratePartition(c.Uint("batch"), c.Bool("drop"))
+		},
+	},
+	{
+		Name:  "AnalyticsBackfill",
+		Usage: "push indexed blocks, extrinsics, events, logs and plugin data to clickhouse analytics sink",
+		Flags: []cli.Flag{
+			cli.UintFlag{Name: "from", Usage: "start block num"},
+			cli.UintFlag{Name: "to", Usage: "end block num, default latest finalized block"},
+			cli.UintFlag{Name: "batch", Value: 1000, Usage: "blocks pushed per batch"},
+			cli.StringSliceFlag{Name: "source", Usage: "backfill source, substrate or plugin name like evm, default all"},
+		},
+		Action: func(c *cli.Context) error {
+			return script.AnalyticsBackfill(c.Uint("from"), c.Uint("to"), c.Uint("batch"), c.StringSlice("source"))
+		},
+	},
 	{
 		Name:  "plugin",
 		Usage: "plugin sub commands",
 		Before: func(c *cli.Context) error {
-			srv := service.New()
+			if err := plugins.RegisterExternal(configs.Boot.Plugin.Dir); err != nil {
+				return err
+			}
+			refreshPluginCommands(c.App)
+			pluginSrv = service.New()
 			_, cancel := context.WithCancel(context.Background())
 			c.App.After = func(*cli.Context) error {
 				cancel()
-				srv.Close()
+				pluginSrv.Close()
 				return nil
 			}
 			return nil
@@ -77,6 +110,9 @@ var commands = []cli.Command{
 	},
 }
 
+// pluginSrv service of plugin sub commands, created before plugin sub command run
+var pluginSrv *service.Service
+
 func pluginCommands() []cli.Command {
 	var cmds []cli.Command
 	for name, plugin := range plugins.RegisteredPlugins {
@@ -88,8 +124,31 @@ func pluginCommands() []cli.Command {
 			Before: func(c *cli.Context) error {
 				return nil
 			},
-			Subcommands: plugin.Commands(),
+			Subcommands: append(plugin.Commands(), pluginResetCommand(name)),
 		})
 	}
 	return cmds
 }
+
+// refreshPluginCommands external plugins are registered before plugin sub command run, add their commands to plugin command app
+func refreshPluginCommands(app *cli.App) {
+	for _, cmd := range pluginCommands() {
+		if app.Command(cmd.Name) == nil {
+			app.Commands = append(app.Commands, cmd)
+		}
+	}
+}
+
+// pluginResetCommand delete data of plugin from block num and move checkpoint back, blocks from checkpoint delivered to plugin again
+func pluginResetCommand(name string) cli.Command {
+	return cli.Command{
+		Name:  "Reset",
+		Usage: "delete plugin data from the block num and reset plugin checkpoint, blocks from the block num are delivered to plugin again",
+		Flags: []cli.Flag{
+			cli.UintFlag{Name: "from", Usage: "block num delivered from"},
+		},
+		Action: func(c *cli.Context) error {
+			return pluginSrv.ResetPluginCheckpoint(context.Background(), name, c.Uint("from"))
+		},
+	}
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"testing"
+
+	"github.com/urfave/cli"
 )
 
 func Test_AtLeastCommands(t *testing.T) {
@@ -19,3 +21,20 @@ func Test_AtLeastCommands(t *testing.T) {
 		}
 	}
 }
+
+func Test_pluginResetCommand(t *testing.T) {
+	c := pluginResetCommand("evm")
+	if c.Name != "Reset" || len(c.Flags) != 1 || c.Flags[0].GetName() != "from" {
+		t.Errorf("plugin reset command should have from flag")
+	}
+}
+
+func Test_refreshPluginCommands(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = pluginCommands()
+	count := len(app.Commands)
+	refreshPluginCommands(app)
+	if len(app.Commands) != count {
+		t.Errorf("plugin commands should not be added twice")
+	}
+}
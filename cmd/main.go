@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"github.com/itering/subscan/internal/server/prom"
+	"github.com/itering/subscan/plugins"
 	"github.com/itering/subscan/util/mq"
 	redisUtil "github.com/itering/subscan/util/redis"
 	"os"
 	"runtime"
 
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/internal/server/grpc"
 	"github.com/itering/subscan/internal/server/http"
 	"github.com/itering/subscan/internal/service"
 	"github.com/itering/substrate-api-rpc/websocket"
@@ -43,9 +46,16 @@ func setupApp() *cli.App {
 		return nil
 	}
 	app.Commands = commands
-	app.Action = func(*cli.Context) error { run(); return nil }
+	app.Action = func(*cli.Context) error {
+		if err := plugins.RegisterExternal(configs.Boot.Plugin.Dir); err != nil {
+			return err
+		}
+		run()
+		return nil
+	}
 	app.After = func(ctx *cli.Context) error {
 		_ = prom.MetricsServer.Shutdown(context.Background())
+		plugins.CloseExternal()
 		return nil
 	}
 	return app
@@ -53,13 +63,16 @@ func setupApp() *cli.App {
 
 func run() {
 	svc := service.New()
-	httpSrv := http.NewHTTPServer(configs.Boot.Server, svc)
+	servers := []transport.Server{http.NewHTTPServer(configs.Boot.Server, svc)}
+	if grpcSrv := grpc.NewGRPCServer(configs.Boot.Server, svc); grpcSrv != nil {
+		servers = append(servers, grpcSrv)
+	}
 	defer func() {
 		// Micro services
 		svc.Close()
 	}()
 
-	app := kratos.New(kratos.Metadata(map[string]string{}), kratos.Server(httpSrv))
+	app := kratos.New(kratos.Metadata(map[string]string{}), kratos.Server(servers...))
 	if err := app.Run(); err != nil {
 		panic(err)
 	}
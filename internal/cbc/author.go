@@ -0,0 +1,240 @@
+package cbc
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/itering/scale.go/types"
+	"github.com/itering/scale.go/types/scaleBytes"
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
+)
+
+// AuthorSelectionMode values, in the variant order of the runtime enum
+const (
+	AuthorSelectionRoundRobin       = "RoundRobin"
+	AuthorSelectionStakeWeighted    = "StakeWeighted"
+	AuthorSelectionPerformanceBased = "PerformanceBased"
+	AuthorSelectionHybrid           = "Hybrid"
+)
+
+var AuthorSelectionModes = []string{
+	AuthorSelectionRoundRobin,
+	AuthorSelectionStakeWeighted,
+	AuthorSelectionPerformanceBased,
+	AuthorSelectionHybrid,
+}
+
+// CBC consensus parameters. Engine id, digest layout and storage names are not described by the type registry
+// configs/source/cbc.json, they are configured by env, and author tracking is disabled with an error when missing
+var (
+	// ConsensusEngineId engine id of CBC PreRuntime digest, 4 bytes, env CBC_CONSENSUS_ENGINE
+	ConsensusEngineId = util.GetEnv("CBC_CONSENSUS_ENGINE", "")
+	// PreDigestLayout SCALE fields of PreRuntime digest data in order, env CBC_PRE_DIGEST_LAYOUT like
+	// epoch:u32,slot:u64,author_index:u32,selection_mode:AuthorSelectionMode, slot and author_index are required,
+	// types are primitives or types of cbc.json
+	PreDigestLayout = ParseDigestLayout(util.GetEnv("CBC_PRE_DIGEST_LAYOUT", ""))
+	// EpochValidatorsStorage map of epoch index u32 to validator set, env CBC_EPOCH_VALIDATORS_STORAGE like Module.Item
+	EpochValidatorsStorage = ParseStorage(util.GetEnv("CBC_EPOCH_VALIDATORS_STORAGE", ""))
+	// ActiveValidatorsStorage current validator set, used when the epoch map is pruned, env CBC_ACTIVE_VALIDATORS_STORAGE, optional
+	ActiveValidatorsStorage = ParseStorage(util.GetEnv("CBC_ACTIVE_VALIDATORS_STORAGE", ""))
+	// EpochConfigStorage EpochConfig {blocks_per_epoch, min_stake, max_validators} in force, env CBC_EPOCH_CONFIG_STORAGE
+	EpochConfigStorage = ParseStorage(util.GetEnv("CBC_EPOCH_CONFIG_STORAGE", ""))
+)
+
+var ErrNotCBCDigest = errors.New("not a CBC consensus digest")
+
+// DigestField field of PreRuntime digest data
+type DigestField struct {
+	Name string
+	Type string
+}
+
+// ParseDigestLayout fields of name:type list, nil if any field is invalid
+func ParseDigestLayout(layout string) []DigestField {
+	var fields []DigestField
+	for _, item := range strings.Split(layout, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		name, typ, ok := strings.Cut(item, ":")
+		if !ok || strings.TrimSpace(name) == "" || strings.TrimSpace(typ) == "" {
+			return nil
+		}
+		fields = append(fields, DigestField{Name: strings.TrimSpace(name), Type: strings.TrimSpace(typ)})
+	}
+	return fields
+}
+
+// ParseStorage module and item of Module.Item, empty if invalid
+func ParseStorage(name string) [2]string {
+	module, item, ok := strings.Cut(strings.TrimSpace(name), ".")
+	if !ok || module == "" || item == "" {
+		return [2]string{}
+	}
+	return [2]string{module, item}
+}
+
+// CheckAuthorConfig error of missing or invalid CBC author parameters
+func CheckAuthorConfig() error {
+	var errs []error
+	if len(ConsensusEngineId) != 4 {
+		errs = append(errs, fmt.Errorf("CBC_CONSENSUS_ENGINE must be 4 bytes engine id, got %q", ConsensusEngineId))
+	}
+	names := make(map[string]bool)
+	for _, field := range PreDigestLayout {
+		names[field.Name] = true
+	}
+	if !names["slot"] || !names["author_index"] {
+		errs = append(errs, errors.New("CBC_PRE_DIGEST_LAYOUT must contain slot and author_index fields"))
+	}
+	if EpochValidatorsStorage[0] == "" {
+		errs = append(errs, errors.New("CBC_EPOCH_VALIDATORS_STORAGE must be Module.Item"))
+	}
+	return errors.Join(errs...)
+}
+
+// PreDigest CBC PreRuntime digest, decoded by PreDigestLayout
+type PreDigest struct {
+	Epoch         uint32 `json:"epoch"`
+	Slot          uint64 `json:"slot"`
+	AuthorIndex   uint32 `json:"author_index"`
+	SelectionMode string `json:"selection_mode"`
+}
+
+// IsCBCNetwork network node name is CBC chain
+func IsCBCNetwork(network string) bool {
+	return strings.Contains(strings.ToLower(network), "cbc")
+}
+
+// DecodePreDigest decode CBC PreRuntime digest of runtime log data, {"engine": int, "data": hex}
+func DecodePreDigest(runtimeLogData []byte) (*PreDigest, error) {
+	var log struct {
+		Data   string `json:"data"`
+		Engine int64  `json:"engine"`
+	}
+	if err := json.Unmarshal(runtimeLogData, &log); err != nil {
+		return nil, err
+	}
+	if ConsensusEngineId == "" || log.Engine != substrate.EngineId(ConsensusEngineId) {
+		return nil, ErrNotCBCDigest
+	}
+	return decodePreDigestData(util.HexToBytes(log.Data))
+}
+
+func decodePreDigestData(data []byte) (digest *PreDigest, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			digest, err = nil, fmt.Errorf("decode CBC digest error: %v", r)
+		}
+	}()
+	if len(PreDigestLayout) == 0 {
+		return nil, errors.New("CBC_PRE_DIGEST_LAYOUT is not configured")
+	}
+	e := types.ScaleDecoder{}
+	e.Init(scaleBytes.ScaleBytes{Data: data}, nil)
+	digest = new(PreDigest)
+	for _, field := range PreDigestLayout {
+		if e.Data.GetRemainingLength() == 0 {
+			return nil, fmt.Errorf("CBC digest too short, %d bytes", len(data))
+		}
+		value := e.ProcessAndUpdateData(field.Type)
+		switch field.Name {
+		case "epoch":
+			digest.Epoch = uint32(util.Int64FromInterface(value))
+		case "slot":
+			digest.Slot = uint64(util.Int64FromInterface(value))
+		case "author_index":
+			digest.AuthorIndex = uint32(util.Int64FromInterface(value))
+		case "selection_mode":
+			if digest.SelectionMode, err = selectionMode(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return digest, nil
+}
+
+// selectionMode mode of AuthorSelectionMode enum, or variant index if the layout type is an integer
+func selectionMode(value interface{}) (string, error) {
+	if mode, ok := value.(string); ok {
+		if util.StringInSlice(mode, AuthorSelectionModes) {
+			return mode, nil
+		}
+		return "", fmt.Errorf("unknown author selection mode %s", mode)
+	}
+	if index := util.Int64FromInterface(value); index >= 0 && index < int64(len(AuthorSelectionModes)) {
+		return AuthorSelectionModes[index], nil
+	}
+	return "", fmt.Errorf("unknown author selection mode %v", value)
+}
+
+func init() {
+	if CheckAuthorConfig() == nil {
+		RegisterDigestDecoder()
+	}
+}
+
+// RegisterDigestDecoder decoder of CBC PreRuntime digest of ConsensusEngineId
+func RegisterDigestDecoder() {
+	substrate.RegisterDigestDecoder(ConsensusEngineId, substrate.DigestPreRuntime, func(data []byte) (string, uint64, map[string]interface{}, error) {
+		digest, err := decodePreDigestData(data)
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "PreDigest", digest.Slot, map[string]interface{}{
+			"epoch": digest.Epoch, "slot": digest.Slot, "author_index": digest.AuthorIndex, "selection_mode": digest.SelectionMode,
+		}, nil
+	})
+}
+
+// Author validator of author index, empty if index is out of validator set
+func (d *PreDigest) Author(validators []string) string {
+	if int(d.AuthorIndex) >= len(validators) {
+		return ""
+	}
+	return validators[d.AuthorIndex]
+}
+
+// AuthorSchedule expected author of slot in validator set, empty if not known
+type AuthorSchedule func(slot uint64, validators []string) string
+
+var (
+	authorSchedulesLock sync.RWMutex
+	// authorSchedules schedule of selection mode, StakeWeighted, PerformanceBased and Hybrid depend on stake, scores
+	// and randomness of the runtime and have no schedule, so their missed slots are not attributed
+	authorSchedules = map[string]AuthorSchedule{
+		AuthorSelectionRoundRobin: func(slot uint64, validators []string) string {
+			return validators[slot%uint64(len(validators))]
+		},
+	}
+)
+
+// RegisterAuthorSchedule schedule of selection mode, replace the built-in schedule if exists
+func RegisterAuthorSchedule(mode string, schedule AuthorSchedule) {
+	authorSchedulesLock.Lock()
+	defer authorSchedulesLock.Unlock()
+	authorSchedules[mode] = schedule
+}
+
+// ExpectedAuthor validator scheduled for slot by the schedule of selection mode, empty if the mode has no schedule
+func ExpectedAuthor(mode string, slot uint64, validators []string) string {
+	authorSchedulesLock.RLock()
+	schedule := authorSchedules[mode]
+	authorSchedulesLock.RUnlock()
+	if schedule == nil || len(validators) == 0 {
+		return ""
+	}
+	return schedule(slot, validators)
+}
+
+// EncodeEpoch storage key argument of epoch index
+func EncodeEpoch(epoch uint32) string {
+	raw := make([]byte, 4)
+	binary.LittleEndian.PutUint32(raw, epoch)
+	return util.BytesToHex(raw)
+}
@@ -0,0 +1,101 @@
+package cbc
+
+import (
+	"os"
+	"testing"
+
+	"github.com/itering/subscan/share/substrate"
+	substrateRpc "github.com/itering/substrate-api-rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// AuthorSelectionMode of CBC type registry
+	if data, err := os.ReadFile("../../configs/source/cbc.json"); err == nil {
+		substrateRpc.RegCustomTypes(data)
+	}
+	ConsensusEngineId = "dcf0"
+	PreDigestLayout = ParseDigestLayout("epoch:u32,slot:u64,author_index:u32,selection_mode:AuthorSelectionMode")
+	EpochValidatorsStorage = ParseStorage("Dcf.EpochValidators")
+	RegisterDigestDecoder()
+}
+
+func TestDecodePreDigest(t *testing.T) {
+	// epoch 2, slot 300, author index 1, PerformanceBased
+	digest, err := DecodePreDigest([]byte(`{"engine":812016484,"data":"0x020000002c01000000000000010000000200"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &PreDigest{Epoch: 2, Slot: 300, AuthorIndex: 1, SelectionMode: AuthorSelectionPerformanceBased}, digest)
+	assert.Equal(t, "b", digest.Author([]string{"a", "b"}))
+	assert.Equal(t, "", digest.Author([]string{"a"}))
+
+	_, err = DecodePreDigest([]byte(`{"engine":1161969986,"data":"0x00"}`))
+	assert.ErrorIs(t, err, ErrNotCBCDigest)
+	_, err = DecodePreDigest([]byte(`{"engine":812016484,"data":"0x0200"}`))
+	assert.Error(t, err)
+	_, err = DecodePreDigest([]byte(`{"engine":812016484,"data":"0x020000002c01000000000000010000000900"}`))
+	assert.Error(t, err)
+}
+
+func TestDecodeDigest(t *testing.T) {
+	item, err := substrate.DecodeDigest(substrate.DigestPreRuntime, map[string]interface{}{"engine": 812016484, "data": "0x020000002c01000000000000010000000000"})
+	assert.NoError(t, err)
+	assert.Equal(t, ConsensusEngineId, item.Engine)
+	assert.Equal(t, "PreDigest", item.Kind)
+	assert.Equal(t, uint64(300), item.Slot)
+	assert.Equal(t, AuthorSelectionRoundRobin, item.Decoded["selection_mode"])
+}
+
+func TestDecodePreDigestLayout(t *testing.T) {
+	layout := PreDigestLayout
+	defer func() { PreDigestLayout = layout }()
+
+	// selection mode of integer variant index, no epoch
+	PreDigestLayout = ParseDigestLayout("slot:u64, author_index:u32, selection_mode:u8")
+	digest, err := decodePreDigestData([]byte{0x2c, 0x01, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, &PreDigest{Slot: 300, AuthorIndex: 1, SelectionMode: AuthorSelectionHybrid}, digest)
+	_, err = decodePreDigestData([]byte{0x2c, 0x01, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 9})
+	assert.Error(t, err)
+
+	PreDigestLayout = nil
+	_, err = decodePreDigestData([]byte{0x2c, 0x01, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 3})
+	assert.Error(t, err)
+
+	assert.Nil(t, ParseDigestLayout("slot:u64,author_index"))
+	assert.Equal(t, [2]string{"Dcf", "EpochValidators"}, ParseStorage("Dcf.EpochValidators"))
+	assert.Equal(t, [2]string{}, ParseStorage("EpochValidators"))
+}
+
+func TestCheckAuthorConfig(t *testing.T) {
+	assert.NoError(t, CheckAuthorConfig())
+
+	engine, layout, storage := ConsensusEngineId, PreDigestLayout, EpochValidatorsStorage
+	defer func() { ConsensusEngineId, PreDigestLayout, EpochValidatorsStorage = engine, layout, storage }()
+	ConsensusEngineId, PreDigestLayout, EpochValidatorsStorage = "", ParseDigestLayout("slot:u64"), [2]string{}
+	err := CheckAuthorConfig()
+	assert.ErrorContains(t, err, "CBC_CONSENSUS_ENGINE")
+	assert.ErrorContains(t, err, "CBC_PRE_DIGEST_LAYOUT")
+	assert.ErrorContains(t, err, "CBC_EPOCH_VALIDATORS_STORAGE")
+}
+
+func TestExpectedAuthor(t *testing.T) {
+	validators := []string{"a", "b", "c"}
+	assert.Equal(t, "b", ExpectedAuthor(AuthorSelectionRoundRobin, 4, validators))
+	assert.Equal(t, "", ExpectedAuthor(AuthorSelectionStakeWeighted, 4, validators))
+	assert.Equal(t, "", ExpectedAuthor(AuthorSelectionRoundRobin, 4, nil))
+	assert.Equal(t, "", ExpectedAuthor("", 4, validators))
+
+	RegisterAuthorSchedule(AuthorSelectionStakeWeighted, func(slot uint64, validators []string) string { return validators[0] })
+	defer func() {
+		authorSchedulesLock.Lock()
+		delete(authorSchedules, AuthorSelectionStakeWeighted)
+		authorSchedulesLock.Unlock()
+	}()
+	assert.Equal(t, "a", ExpectedAuthor(AuthorSelectionStakeWeighted, 4, validators))
+}
+
+func TestIsCBCNetwork(t *testing.T) {
+	assert.True(t, IsCBCNetwork("cbc"))
+	assert.True(t, IsCBCNetwork("CBC-Chain"))
+	assert.False(t, IsCBCNetwork("polkadot"))
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 
+	"github.com/itering/subscan-plugin/storage"
 	"github.com/itering/subscan/model"
 	"github.com/itering/substrate-api-rpc/metadata"
 )
@@ -10,6 +11,17 @@ import (
 type IDao interface {
 	Close()
 	Ping(ctx context.Context) (err error)
+	PingDb(ctx context.Context) error
+	GetReplicaDelay(ctx context.Context) (delay int, replica bool, err error)
+	GetReplicas(ctx context.Context) []model.DbReplica
+	GetPluginCheckpoint(ctx context.Context, plugin string) (*model.PluginCheckpoint, error)
+	DeliverPluginBlock(ctx context.Context, plugin string, next uint, deliver func(storage.Dao) error) (bool, error)
+	MarkPluginDelivering(ctx context.Context, plugin string, next uint) (bool, error)
+	AdvancePluginCheckpoint(ctx context.Context, plugin string, from, to uint) (bool, error)
+	ResetPluginCheckpoint(ctx context.Context, plugin string, next uint) error
+	GetPluginCheckpoints(ctx context.Context, plugins []string) (map[string]uint, error)
+	AcquireLock(ctx context.Context, key, token string, ttl int) bool
+	ReleaseLock(ctx context.Context, key, token string)
 	DbBegin() *GormDB
 	DbCommit(*GormDB)
 	DbRollback(*GormDB)
@@ -18,6 +30,8 @@ type IDao interface {
 	UpdateEventAndExtrinsic(*GormDB, *model.ChainBlock, int, int, int, string, bool, bool) error
 	GetNearBlock(uint) *model.ChainBlock
 	SplitBlockTable(blockNum uint)
+	MigratePartition(ctx context.Context, batch uint, dropLegacy bool) error
+	GetBlockRangeData(ctx context.Context, start, end uint) (*model.BlockRangeData, error)
 	BlocksReverseByNum([]uint) map[uint]model.ChainBlock
 	GetBlockByHash(context.Context, string) *model.ChainBlock
 	GetBlockByNum(context.Context, uint) *model.ChainBlock
@@ -36,6 +50,7 @@ type IDao interface {
 	GetEventByIdx(ctx context.Context, index string) *model.ChainEvent
 
 	CreateExtrinsic(c context.Context, txn *GormDB, extrinsic []model.ChainExtrinsic, u int) error
+	CreateExtrinsicCalls(txn *GormDB, calls []model.ChainExtrinsicCall) error
 	GetExtrinsicListCursor(c context.Context, limit int, fixedTableIndex int, beforeId, afterId uint, accountId string, queryWhere ...model.Option) (list []model.ChainExtrinsic, hasPrev, hasNext bool)
 	GetExtrinsicsByHash(c context.Context, hash string) *model.ChainExtrinsic
 	GetExtrinsicsByIndex(c context.Context, index string) *model.ChainExtrinsic
@@ -61,4 +76,35 @@ type IDao interface {
 
 	GetSessionValidatorsById(ctx context.Context, sessionId uint) []string
 	CreateNewSession(ctx context.Context, sessionId uint, validators []string) error
+
+	CreateBlockAuthor(txn *GormDB, author *model.ChainBlockAuthor, missed []model.ChainMissedSlot) error
+	GetBlockAuthor(ctx context.Context, blockNum uint) *model.ChainBlockAuthor
+	GetValidatorBlockStats(ctx context.Context, epoch *uint) []model.ValidatorBlockStat
+
+	CreateEpoch(txn *GormDB, epoch *model.ChainEpoch) error
+	CloseEpoch(txn *GormDB, epochIndex, endBlock uint, endAt int) error
+	UpdateEpochChanges(txn *GormDB, epochIndex uint, joins, leaves model.SessionValidator) error
+	GetEpoch(ctx context.Context, epochIndex uint) *model.ChainEpoch
+	GetEpochList(ctx context.Context, limit int, after *uint) (list []model.ChainEpoch, hasNext bool)
+
+	CreateApiKey(ctx context.Context, key *model.ApiKey) error
+	GetApiKeyByHash(ctx context.Context, keyHash string) (*model.ApiKey, error)
+	GetApiKeyList(ctx context.Context) []model.ApiKey
+	RevokeApiKey(ctx context.Context, id uint) error
+	TakeRateLimitToken(ctx context.Context, bucket string, rate float64, burst int) (allowed bool, remaining int, retryMs, resetMs int64, err error)
+	IncrApiKeyUsage(ctx context.Context, identity, date string) (int64, error)
+	GetApiKeyUsage(ctx context.Context, identity string, dates []string) []model.ApiKeyUsage
+
+	CreateBlockWeight(txn *GormDB, weight *model.ChainBlockWeight) error
+	GetBlockWeight(ctx context.Context, blockNum uint) *model.ChainBlockWeight
+	GetBlockWeightSeries(ctx context.Context, start, end, interval int) []model.BlockWeightPoint
+
+	GetStorageCache(ctx context.Context, key string) []byte
+	SetStorageCache(ctx context.Context, key string, value interface{}, ttl int) error
+	GetResponseCache(ctx context.Context, key string) []byte
+	SetResponseCache(ctx context.Context, key string, value []byte, ttl int) error
+	GetResponseCacheHead(ctx context.Context) int64
+	IncrResponseCacheHead(ctx context.Context) error
+	GetResponseCacheGeneration(ctx context.Context) int64
+	IncrResponseCacheGeneration(ctx context.Context) error
 }
@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/itering/subscan/model"
+)
+
+// GetBlockRangeData blocks, extrinsics, events and logs of block range [start, end], range must be in one split table
+func (d *Dao) GetBlockRangeData(ctx context.Context, start, end uint) (*model.BlockRangeData, error) {
+	var data model.BlockRangeData
+	db := d.db.WithContext(ctx)
+	if err := db.Scopes(d.TableNameFunc(&model.ChainBlock{BlockNum: start})).Where("block_num BETWEEN ? AND ?", start, end).Find(&data.Blocks).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: start})).Where("block_num BETWEEN ? AND ?", start, end).Find(&data.Extrinsics).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Scopes(d.TableNameFunc(&model.ChainEvent{BlockNum: start})).Where("block_num BETWEEN ? AND ?", start, end).Find(&data.Events).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Scopes(d.TableNameFunc(&model.ChainLog{BlockNum: start})).Where("block_num BETWEEN ? AND ?", start, end).Find(&data.Logs).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
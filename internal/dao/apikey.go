@@ -0,0 +1,139 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"gorm.io/gorm"
+)
+
+const (
+	rateLimitPrefix   = "ratelimit:"
+	apiKeyUsagePrefix = "apikey:usage:"
+	// apiKeyUsageTTL days of usage kept
+	apiKeyUsageTTL = 31 * 24 * 3600
+)
+
+func (d *Dao) CreateApiKey(ctx context.Context, key *model.ApiKey) error {
+	return d.db.WithContext(ctx).Create(key).Error
+}
+
+// GetApiKeyByHash api key of hash, nil without error if api key is unknown
+func (d *Dao) GetApiKeyByHash(ctx context.Context, keyHash string) (*model.ApiKey, error) {
+	var key model.ApiKey
+	if err := d.db.WithContext(ctx).Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (d *Dao) GetApiKeyList(ctx context.Context) []model.ApiKey {
+	var list []model.ApiKey
+	d.db.WithContext(ctx).Order("id desc").Find(&list)
+	return list
+}
+
+func (d *Dao) RevokeApiKey(ctx context.Context, id uint) error {
+	query := d.db.WithContext(ctx).Model(&model.ApiKey{}).Where("id = ?", id).Where("revoked_at = 0").Update("revoked_at", time.Now().Unix())
+	if query.Error != nil {
+		return query.Error
+	}
+	if query.RowsAffected == 0 {
+		return util.RecordNotFound
+	}
+	return nil
+}
+
+// take a token of bucket, refilled at ARGV[1] tokens per second up to ARGV[2], ARGV[3] is now in milliseconds
+// returns allowed, remaining tokens, milliseconds until next token and milliseconds until bucket is full
+var tokenBucketScript = redis.NewScript(1, `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call("HMSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+local retry = 0
+if allowed == 0 then
+	retry = math.ceil((1 - tokens) * 1000 / rate)
+end
+return {allowed, math.floor(tokens), retry, math.ceil((burst - tokens) * 1000 / rate)}`)
+
+// TakeRateLimitToken take a token of bucket, remaining tokens and durations in milliseconds are returned
+func (d *Dao) TakeRateLimitToken(ctx context.Context, bucket string, rate float64, burst int) (allowed bool, remaining int, retryMs, resetMs int64, err error) {
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return false, 0, 0, 0, err
+	}
+	defer conn.Close()
+	r, err := redis.Int64s(tokenBucketScript.Do(conn, model.RedisKeyPrefix()+rateLimitPrefix+bucket, rate, burst, time.Now().UnixMilli()))
+	if err != nil {
+		return false, 0, 0, 0, err
+	}
+	if len(r) != 4 {
+		return false, 0, 0, 0, fmt.Errorf("invalid token bucket result %v", r)
+	}
+	return r[0] == 1, int(r[1]), r[2], r[3], nil
+}
+
+// IncrApiKeyUsage increase request count of identity on date, count of the date is returned
+func (d *Dao) IncrApiKeyUsage(ctx context.Context, identity, date string) (int64, error) {
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	key := model.RedisKeyPrefix() + apiKeyUsagePrefix + identity + ":" + date
+	count, err := redis.Int64(conn.Do("INCR", key))
+	if err == nil && count == 1 {
+		_, _ = conn.Do("EXPIRE", key, apiKeyUsageTTL)
+	}
+	return count, err
+}
+
+// GetApiKeyUsage request count of identity on each date
+func (d *Dao) GetApiKeyUsage(ctx context.Context, identity string, dates []string) []model.ApiKeyUsage {
+	usage := make([]model.ApiKeyUsage, len(dates))
+	for i, date := range dates {
+		usage[i] = model.ApiKeyUsage{Date: date}
+	}
+	if len(dates) == 0 {
+		return usage
+	}
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return usage
+	}
+	defer conn.Close()
+	args := redis.Args{}
+	for _, date := range dates {
+		args = args.Add(model.RedisKeyPrefix() + apiKeyUsagePrefix + identity + ":" + date)
+	}
+	counts, _ := redis.Int64s(conn.Do("MGET", args...))
+	for i := range counts {
+		if i < len(usage) {
+			usage[i].Requests = counts[i]
+		}
+	}
+	return usage
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+	"sort"
+
+	"github.com/itering/subscan/model"
+)
+
+func (d *Dao) CreateBlockAuthor(txn *GormDB, author *model.ChainBlockAuthor, missed []model.ChainMissedSlot) error {
+	if err := txn.Scopes(model.IgnoreDuplicate).Create(author).Error; err != nil {
+		return err
+	}
+	if len(missed) == 0 {
+		return nil
+	}
+	return txn.Scopes(model.IgnoreDuplicate).Create(&missed).Error
+}
+
+func (d *Dao) GetBlockAuthor(ctx context.Context, blockNum uint) *model.ChainBlockAuthor {
+	var author model.ChainBlockAuthor
+	if err := d.db.WithContext(ctx).Where("block_num = ?", blockNum).First(&author).Error; err != nil {
+		return nil
+	}
+	return &author
+}
+
+// GetValidatorBlockStats authored and missed block count of each validator, epoch nil means all epochs
+func (d *Dao) GetValidatorBlockStats(ctx context.Context, epoch *uint) []model.ValidatorBlockStat {
+	type count struct {
+		Validator string
+		Count     int
+	}
+	var authored, missed []count
+	authoredQuery := d.db.WithContext(ctx).Model(&model.ChainBlockAuthor{}).Select("validator, count(*) as count").Where("validator <> ''")
+	missedQuery := d.db.WithContext(ctx).Model(&model.ChainMissedSlot{}).Select("validator, count(*) as count").Where("validator <> ''")
+	if epoch != nil {
+		authoredQuery = authoredQuery.Where("epoch = ?", *epoch)
+		missedQuery = missedQuery.Where("epoch = ?", *epoch)
+	}
+	authoredQuery.Group("validator").Scan(&authored)
+	missedQuery.Group("validator").Scan(&missed)
+
+	stats := make(map[string]*model.ValidatorBlockStat)
+	stat := func(validator string) *model.ValidatorBlockStat {
+		if _, ok := stats[validator]; !ok {
+			stats[validator] = &model.ValidatorBlockStat{Validator: validator}
+		}
+		return stats[validator]
+	}
+	for _, c := range authored {
+		stat(c.Validator).Authored = c.Count
+	}
+	for _, c := range missed {
+		stat(c.Validator).Missed = c.Count
+	}
+	list := make([]model.ValidatorBlockStat, 0, len(stats))
+	for _, v := range stats {
+		list = append(list, *v)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Authored != list[j].Authored {
+			return list[i].Authored > list[j].Authored
+		}
+		return list[i].Validator < list[j].Validator
+	})
+	return list
+}
@@ -11,12 +11,17 @@ import (
 var splitBlockTableCache = model.RedisKeyPrefix() + "split_block_table"
 
 func (d *Dao) SplitBlockTable(blockNum uint) {
+	if model.NativePartition {
+		// keep next partition ready before block written
+		d.AddPartition(blockNum + model.PartitionSize)
+		return
+	}
 	ctx := context.Background()
 	currentTableBlock := model.ChainBlock{BlockNum: blockNum}
 	tableName := TableNameFromInterface(currentTableBlock, d.db)
 	if s := d.redis.GetCacheString(ctx, splitBlockTableCache); s != tableName {
 		if !d.db.Migrator().HasTable(tableName) {
-			d.AddIndex(blockNum / model.SplitTableBlockNum * model.SplitTableBlockNum)
+			d.AddIndex(model.TableBlockNum(model.TableIndex(blockNum)))
 		}
 		_ = d.redis.SetCache(ctx, splitBlockTableCache, tableName, 3600*24*30)
 	}
@@ -26,7 +31,7 @@ func (d *Dao) SplitBlockTable(blockNum uint) {
 func (d *Dao) CreateBlock(ctx context.Context, txn *GormDB, cb *model.ChainBlock) (err error) {
 	query := txn.WithContext(ctx).Scopes().Scopes(d.TableNameFunc(cb), model.IgnoreDuplicate).Create(cb)
 	// Check if you need to create a new table(block, extrinsic, event, log) after created block
-	if maxTableBlockNum < cb.BlockNum+model.SplitTableBlockNum {
+	if !model.NativePartition && maxTableBlockNum < cb.BlockNum+model.SplitTableBlockNum {
 		tableName := model.TableNameFromInterface(model.ChainBlock{BlockNum: cb.BlockNum + model.SplitTableBlockNum}, d.db)
 		if !d.db.Migrator().HasTable(tableName) {
 			go func() {
@@ -85,14 +90,14 @@ func (d *Dao) GetBlockListCursor(ctx context.Context, limit int, before, after u
 	fetch := limit + 1
 	// determine max split-table index from best block
 	best, _ := d.GetFillBestBlockNum(context.TODO())
-	maxIdx := uint(best) / model.SplitTableBlockNum
+	maxIdx := model.TableIndex(uint(best))
 
 	// next page: block_num < after, walk tables downward
 	if after > 0 {
-		startIdx := int(after / model.SplitTableBlockNum)
+		startIdx := int(model.TableIndex(after))
 		for idx := startIdx; idx >= 0 && len(list) < fetch; idx-- {
 			var tableData []model.ChainBlock
-			q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainBlock{BlockNum: uint(idx) * model.SplitTableBlockNum}))
+			q := d.readDb().WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainBlock{BlockNum: model.TableBlockNum(uint(idx))}))
 			if idx == startIdx {
 				q = q.Where("block_num < ?", after)
 			}
@@ -112,10 +117,10 @@ func (d *Dao) GetBlockListCursor(ctx context.Context, limit int, before, after u
 
 	// previous page: block_num > before, walk tables upward (asc, then reverse)
 	if before > 0 {
-		startIdx := int(before / model.SplitTableBlockNum)
+		startIdx := int(model.TableIndex(before))
 		for idx := startIdx; uint(idx) <= maxIdx && len(list) < fetch; idx++ {
 			var tableData []model.ChainBlock
-			q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainBlock{BlockNum: uint(idx) * model.SplitTableBlockNum}))
+			q := d.readDb().WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainBlock{BlockNum: model.TableBlockNum(uint(idx))}))
 			if idx == startIdx {
 				q = q.Where("block_num > ?", before)
 			}
@@ -140,7 +145,7 @@ func (d *Dao) GetBlockListCursor(ctx context.Context, limit int, before, after u
 	// first page: walk from newest table downward
 	for idx := maxIdx; len(list) < fetch; idx-- {
 		var tableData []model.ChainBlock
-		q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainBlock{BlockNum: uint(idx) * model.SplitTableBlockNum}))
+		q := d.readDb().WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainBlock{BlockNum: model.TableBlockNum(uint(idx))}))
 		q = q.Order("block_num desc").Limit(fetch - len(list))
 		if err := q.Find(&tableData).Error; err != nil {
 			break
@@ -160,8 +165,8 @@ func (d *Dao) GetBlockListCursor(ctx context.Context, limit int, before, after u
 func (d *Dao) GetBlockByHash(c context.Context, hash string) *model.ChainBlock {
 	var block model.ChainBlock
 	blockNum, _ := d.GetBestBlockNum(c)
-	for index := int(blockNum / uint64(model.SplitTableBlockNum)); index >= 0; index-- {
-		query := d.db.Scopes(model.TableNameFunc(model.ChainBlock{BlockNum: uint(index) * (model.SplitTableBlockNum)})).Where("hash = ?", hash).Scan(&block)
+	for index := int(model.TableIndex(uint(blockNum))); index >= 0; index-- {
+		query := d.db.Scopes(model.TableNameFunc(model.ChainBlock{BlockNum: model.TableBlockNum(uint(index))})).Where("hash = ?", hash).Scan(&block)
 		if query != nil && query.Error == nil {
 			return &block
 		}
@@ -191,6 +196,8 @@ func (d *Dao) BlockAsJson(_ context.Context, block *model.ChainBlock) *model.Cha
 		Validator:       address.Encode(block.Validator),
 		Finalized:       block.Finalized,
 		SpecVersion:     block.SpecVersion,
+
+		AuthorSelectionMode: block.AuthorSelectionMode,
 	}
 	return &bj
 }
@@ -227,9 +234,9 @@ func (d *Dao) BlocksReverseByNum(blockNums []uint) map[uint]model.ChainBlock {
 	}
 	util.SortUintSlice(blockNums)
 	lastNum := blockNums[len(blockNums)-1]
-	for index := int(lastNum / model.SplitTableBlockNum); index >= 0; index-- {
+	for index := int(model.TableIndex(lastNum)); index >= 0; index-- {
 		var tableData []model.ChainBlock
-		query := d.db.Scopes(model.TableNameFunc(model.ChainBlock{BlockNum: uint(index) * model.SplitTableBlockNum})).Where("block_num in (?)", blockNums).Scan(&tableData)
+		query := d.db.Scopes(model.TableNameFunc(model.ChainBlock{BlockNum: model.TableBlockNum(uint(index))})).Where("block_num in (?)", blockNums).Scan(&tableData)
 		if query == nil || query.Error != nil {
 			continue
 		}
@@ -256,11 +263,11 @@ func (d *Dao) GetBlocksByNums(c context.Context, blockNums []uint, columns strin
 	}
 	idxMap := make(map[uint][]uint)
 	for _, num := range blockNums {
-		idxMap[num/model.SplitTableBlockNum] = append(idxMap[num/model.SplitTableBlockNum], num)
+		idxMap[model.TableIndex(num)] = append(idxMap[model.TableIndex(num)], num)
 	}
 	for index, ids := range idxMap {
 		var tableData []*model.ChainBlock
-		if err := d.db.WithContext(c).Select(columns).Scopes(model.TableNameFunc(&model.ChainBlock{BlockNum: index * model.SplitTableBlockNum})).Where("block_num IN ?", ids).Find(&tableData).Error; err != nil {
+		if err := d.db.WithContext(c).Select(columns).Scopes(model.TableNameFunc(&model.ChainBlock{BlockNum: model.TableBlockNum(index)})).Where("block_num IN ?", ids).Find(&tableData).Error; err != nil {
 			continue
 		}
 		blocks = append(blocks, tableData...)
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itering/subscan/model"
+)
+
+func (d *Dao) CreateBlockWeight(txn *GormDB, weight *model.ChainBlockWeight) error {
+	query := txn.Scopes(model.IgnoreDuplicate).Create(weight)
+	return query.Error
+}
+
+func (d *Dao) GetBlockWeight(ctx context.Context, blockNum uint) *model.ChainBlockWeight {
+	var weight model.ChainBlockWeight
+	query := d.readDb().WithContext(ctx).Where("block_num = ?", blockNum).First(&weight)
+	if query.Error != nil {
+		return nil
+	}
+	return &weight
+}
+
+// GetBlockWeightSeries aggregate block weight ratio by block_timestamp bucket of interval seconds
+func (d *Dao) GetBlockWeightSeries(ctx context.Context, start, end, interval int) []model.BlockWeightPoint {
+	var points []model.BlockWeightPoint
+	query := d.readDb().WithContext(ctx).Model(&model.ChainBlockWeight{}).
+		Select(fmt.Sprintf(`block_timestamp - block_timestamp %% %d as time_bucket, count(*) as block_count,
+			avg(ref_time_ratio) as avg_ref_time_ratio, max(ref_time_ratio) as max_ref_time_ratio,
+			avg(proof_size_ratio) as avg_proof_size_ratio, max(proof_size_ratio) as max_proof_size_ratio,
+			avg(length_ratio) as avg_length_ratio`, interval)).
+		Where("block_timestamp BETWEEN ? AND ?", start, end).
+		Group("time_bucket").
+		Order("time_bucket asc").
+		Scan(&points)
+	if query.Error != nil {
+		return nil
+	}
+	return points
+}
@@ -19,9 +19,9 @@ func (d *Dao) CreateEvent(txn *GormDB, events []model.ChainEvent) error {
 func (d *Dao) GetEventListCursor(ctx context.Context, limit int, _ string, fixedTableIndex int, beforeId uint, afterId uint, where ...model.Option) (list []model.ChainEvent, hasPrev, hasNext bool) {
 	fetchLimit := limit + 1
 	blockNum, _ := d.GetFillBestBlockNum(context.TODO())
-	maxTableIndex := blockNum / int(model.SplitTableBlockNum)
+	maxTableIndex := int(model.TableIndex(uint(blockNum)))
 	if afterId > 0 {
-		maxTableIndex = int(afterId/model.SplitTableBlockNum) / model.IdGenerateCoefficient
+		maxTableIndex = int(model.TableIndex(afterId / model.IdGenerateCoefficient))
 	}
 	if fixedTableIndex >= 0 {
 		maxTableIndex = fixedTableIndex
@@ -33,7 +33,7 @@ func (d *Dao) GetEventListCursor(ctx context.Context, limit int, _ string, fixed
 				continue
 			}
 			var tableData []model.ChainEvent
-			q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainEvent{BlockNum: uint(index) * model.SplitTableBlockNum}))
+			q := d.readDb().WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainEvent{BlockNum: model.TableBlockNum(uint(index))}))
 			q = q.Scopes(where...).Where("id < ?", afterId).Order("id desc").Limit(fetchLimit - len(list))
 			if err := q.Find(&tableData).Error; err != nil {
 				continue
@@ -49,7 +49,7 @@ func (d *Dao) GetEventListCursor(ctx context.Context, limit int, _ string, fixed
 	}
 
 	if beforeId > 0 { // previous page
-		startIdx := int(beforeId/model.SplitTableBlockNum) / model.IdGenerateCoefficient
+		startIdx := int(model.TableIndex(beforeId / model.IdGenerateCoefficient))
 		if fixedTableIndex >= 0 {
 			startIdx = fixedTableIndex
 		}
@@ -58,7 +58,7 @@ func (d *Dao) GetEventListCursor(ctx context.Context, limit int, _ string, fixed
 				continue
 			}
 			var tableData []model.ChainEvent
-			q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainEvent{BlockNum: uint(index) * model.SplitTableBlockNum}))
+			q := d.readDb().WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainEvent{BlockNum: model.TableBlockNum(uint(index))}))
 			q = q.Scopes(where...)
 			if index == startIdx {
 				q = q.Where("id > ?", beforeId)
@@ -86,7 +86,7 @@ func (d *Dao) GetEventListCursor(ctx context.Context, limit int, _ string, fixed
 			continue
 		}
 		var tableData []model.ChainEvent
-		q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainEvent{BlockNum: uint(index) * model.SplitTableBlockNum}))
+		q := d.readDb().WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainEvent{BlockNum: model.TableBlockNum(uint(index))}))
 		q = q.Scopes(where...).Order("id desc").Limit(fetchLimit - len(list))
 		if err := q.Find(&tableData).Error; err != nil {
 			continue
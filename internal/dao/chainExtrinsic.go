@@ -21,12 +21,19 @@ func (d *Dao) CreateExtrinsic(c context.Context, txn *GormDB, extrinsic []model.
 	return query.Error
 }
 
+func (d *Dao) CreateExtrinsicCalls(txn *GormDB, calls []model.ChainExtrinsicCall) error {
+	if len(calls) == 0 {
+		return nil
+	}
+	return txn.Scopes(model.IgnoreDuplicate).CreateInBatches(calls, 2000).Error
+}
+
 func (d *Dao) GetExtrinsicCount(ctx context.Context, queryWhere ...model.Option) int64 {
 	var count int64
 	blockNum, _ := d.GetFillBestBlockNum(context.TODO())
-	for index := blockNum / int(model.SplitTableBlockNum); index >= 0; index-- {
+	for index := int(model.TableIndex(uint(blockNum))); index >= 0; index-- {
 		var tableDataCount int64
-		q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: uint(index) * model.SplitTableBlockNum}))
+		q := d.db.WithContext(ctx).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: model.TableBlockNum(uint(index))}))
 		q = q.Scopes(queryWhere...)
 		q.Model(&model.ChainExtrinsic{}).Count(&tableDataCount)
 		count += tableDataCount
@@ -50,9 +57,9 @@ func (d *Dao) GetAccountExtrinsicMapping(ctx context.Context, accountId string)
 func (d *Dao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableIndex int, beforeId, afterId uint, accountId string, queryWhere ...model.Option) (list []model.ChainExtrinsic, hasPrev, hasNext bool) {
 	fetchLimit := limit + 1
 	blockNum, _ := d.GetFillBestBlockNum(context.TODO())
-	maxTableIndex := blockNum / int(model.SplitTableBlockNum)
+	maxTableIndex := int(model.TableIndex(uint(blockNum)))
 	if afterId > 0 {
-		maxTableIndex = int(afterId/model.SplitTableBlockNum) / model.IdGenerateCoefficient
+		maxTableIndex = int(model.TableIndex(afterId / model.IdGenerateCoefficient))
 	}
 	if fixedTableIndex >= 0 {
 		maxTableIndex = fixedTableIndex
@@ -65,7 +72,7 @@ func (d *Dao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableInd
 	}
 
 	var checkTableIndex = func(index int) bool {
-		if len(accountId) == 0 || (len(accountId) > 0 && util.IntInSlice(index, accountExtrinsics)) {
+		if model.NativePartition || len(accountId) == 0 || (len(accountId) > 0 && util.IntInSlice(index, accountExtrinsics)) {
 			return true
 		}
 		return false
@@ -77,7 +84,7 @@ func (d *Dao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableInd
 				continue
 			}
 			var tableData []model.ChainExtrinsic
-			q := d.db.WithContext(c).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: uint(index) * model.SplitTableBlockNum}))
+			q := d.readDb().WithContext(c).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: model.TableBlockNum(uint(index))}))
 			q = q.Scopes(queryWhere...)
 			q = q.Where("id < ?", afterId).Order("id desc").Limit(fetchLimit - len(list))
 			if err := q.Find(&tableData).Error; err != nil {
@@ -94,7 +101,7 @@ func (d *Dao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableInd
 	}
 
 	if beforeId > 0 { // previous page
-		startIdx := int(beforeId/model.SplitTableBlockNum) / model.IdGenerateCoefficient
+		startIdx := int(model.TableIndex(beforeId / model.IdGenerateCoefficient))
 		if fixedTableIndex >= 0 {
 			startIdx = fixedTableIndex
 		}
@@ -103,7 +110,7 @@ func (d *Dao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableInd
 				continue
 			}
 			var tableData []model.ChainExtrinsic
-			q := d.db.WithContext(c).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: uint(index) * model.SplitTableBlockNum}))
+			q := d.readDb().WithContext(c).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: model.TableBlockNum(uint(index))}))
 			q = q.Scopes(queryWhere...)
 			if index == startIdx {
 				q = q.Where("id > ?", beforeId)
@@ -132,7 +139,7 @@ func (d *Dao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableInd
 			continue
 		}
 		var tableData []model.ChainExtrinsic
-		q := d.db.WithContext(c).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: uint(index) * model.SplitTableBlockNum}))
+		q := d.readDb().WithContext(c).Scopes(d.TableNameFunc(&model.ChainExtrinsic{BlockNum: model.TableBlockNum(uint(index))}))
 		q = q.Scopes(queryWhere...).Order("id desc").Limit(fetchLimit - len(list))
 		if err := q.Find(&tableData).Error; err != nil {
 			continue
@@ -150,8 +157,8 @@ func (d *Dao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableInd
 func (d *Dao) GetExtrinsicsByHash(c context.Context, hash string) *model.ChainExtrinsic {
 	var extrinsic model.ChainExtrinsic
 	blockNum, _ := d.GetFillBestBlockNum(c)
-	for index := blockNum / int(model.SplitTableBlockNum); index >= 0; index-- {
-		query := d.db.WithContext(c).Scopes(model.TableNameFunc(model.ChainExtrinsic{BlockNum: uint(index) * model.SplitTableBlockNum})).Where("extrinsic_hash = ?", hash).First(&extrinsic)
+	for index := int(model.TableIndex(uint(blockNum))); index >= 0; index-- {
+		query := d.db.WithContext(c).Scopes(model.TableNameFunc(model.ChainExtrinsic{BlockNum: model.TableBlockNum(uint(index))})).Where("extrinsic_hash = ?", hash).First(&extrinsic)
 		if query != nil && query.Error == nil {
 			return &extrinsic
 		}
@@ -6,7 +6,11 @@ var (
 	RedisMetadataKey           = model.RedisKeyPrefix() + "metadata"
 	RedisFillAlreadyBlockNum   = model.RedisKeyPrefix() + "FillAlreadyBlockNum"
 	RedisFillFinalizedBlockNum = model.RedisKeyPrefix() + "FillFinalizedBlockNum"
+	RedisPluginProcessedBlock  = model.RedisKeyPrefix() + "PluginProcessedBlock"
 )
 
 // local cache value
 var maxTableBlockNum uint = 0
+
+// local cache value, native partitions created below this block num
+var maxPartitionBlockNum uint = 0
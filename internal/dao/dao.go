@@ -3,6 +3,8 @@ package dao
 import (
 	"context"
 	"fmt"
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/model"
 	redisDao "github.com/itering/subscan/share/redis"
 	"github.com/itering/subscan/util"
 	"gorm.io/gorm"
@@ -11,13 +13,18 @@ import (
 )
 
 type Dao struct {
-	db       *gorm.DB
-	redis    *redisDao.Dao
-	DbDriver string
+	db        *gorm.DB
+	replicaDb *gorm.DB // api reads, nil if no replica configured
+	replicas  *replicaSet
+	redis     *redisDao.Dao
+	DbDriver  string
 }
 
-// New new a dao and return.
-func New() (dao *Dao, storage *DbStorage, pool *redisDao.Dao) {
+// New new a dao and return, partition is the storage of block, event, extrinsic and log tables
+func New(partition *configs.Partition) (dao *Dao, storage *DbStorage, pool *redisDao.Dao) {
+	if partition != nil {
+		model.SetPartition(partition.Mode, partition.Size)
+	}
 	db := newDb()
 	pool = redisDao.Init()
 	dao = &Dao{
@@ -25,6 +32,10 @@ func New() (dao *Dao, storage *DbStorage, pool *redisDao.Dao) {
 		redis:    pool,
 		DbDriver: db.Dialector.Name(),
 	}
+	var err error
+	if dao.replicaDb, dao.replicas, err = newReplicaDb(db, configs.Boot.Database.ReplicaDSNs()); err != nil {
+		util.Logger().Error(fmt.Errorf("db replicas disabled, read from primary: %v", err))
+	}
 	if os.Getenv("MYSQL_MIGRATE") != "false" {
 		util.Logger().Info("start db auto migrate")
 		now := time.Now()
@@ -40,6 +51,9 @@ func (d *Dao) Close() {
 	if d.redis != nil {
 		_ = d.redis.Close()
 	}
+	if d.replicas != nil {
+		d.replicas.close()
+	}
 	_ = d.db
 }
 
@@ -85,7 +85,7 @@ func init() {
 	util.ConfDir = "../../configs"
 	configs.Init()
 
-	testDao, _, _ = New()
+	testDao, _, _ = New(configs.Boot.Database.Partition)
 	var tables []string
 	db := testDao.db
 	err := db.Raw("show tables;").Pluck("Tables_in_subscan_test", &tables).Error
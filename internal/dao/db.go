@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/itering/subscan-plugin/storage"
@@ -30,6 +31,35 @@ type DbStorage struct {
 	d        *Dao
 }
 
+var pluginDbs sync.Map
+
+// ForPlugin return storage of plugin, plugin with dedicated dsn use own database.
+// Read replicas are not used by plugins, storage of plugin always reads the database it writes
+func (d *DbStorage) ForPlugin(name string) *DbStorage {
+	ds := *d
+	ds.Prefix = name
+	ds.db = pluginDb(d.db, d.DbDriver, name)
+	return &ds
+}
+
+// pluginDb database of plugin, primary if plugin has no dedicated dsn
+// checkpoint of plugin is kept in plugin database so that it is committed with plugin data
+func pluginDb(primary *gorm.DB, dbDriver, name string) *gorm.DB {
+	dsn := configs.Boot.Database.PluginDSN(name)
+	if dsn == "" {
+		return primary
+	}
+	db, ok := pluginDbs.Load(name)
+	if !ok {
+		opened := openDb(dbDriver, dsn)
+		if err := opened.AutoMigrate(&model.PluginCheckpoint{}); err != nil {
+			util.Logger().Error(fmt.Errorf("migrate checkpoint of plugin %s error: %v", name, err))
+		}
+		db, _ = pluginDbs.LoadOrStore(name, opened)
+	}
+	return db.(*gorm.DB)
+}
+
 func (d *DbStorage) GetDbInstance() any {
 	return d.db
 }
@@ -44,9 +74,14 @@ func (d *DbStorage) GetPrefix() string {
 
 var protectedTables []string
 
+// SpecialMetadata raw metadata of spec, runtime_versions is in main db, not plugin dedicated db
 func (d *DbStorage) SpecialMetadata(spec int) string {
 	var raw model.RuntimeVersion
-	if query := d.db.Where("spec_version = ?", spec).First(&raw); query.Error != nil {
+	db := d.db
+	if d.d != nil {
+		db = d.d.db
+	}
+	if query := db.Where("spec_version = ?", spec).First(&raw); query.Error != nil {
 		return ""
 	}
 	return raw.RawData
@@ -227,17 +262,24 @@ func (n NamingStrategy) IndexName(_, column string) string {
 
 // private funcs
 func newDb() (db *gorm.DB) {
-	var err error
 	dbDriver := util.GetEnv("DB_DRIVER", "mysql")
 	util.Logger().Debug(fmt.Sprintf("Set DB_DRIVER %s", dbDriver))
+	if dbDriver == "mysql" {
+		return openDb(dbDriver, configs.Boot.Database.Mysql.DSN)
+	}
+	return openDb(dbDriver, configs.Boot.Database.Postgres.DSN)
+}
+
+func openDb(dbDriver, dsn string) (db *gorm.DB) {
+	var err error
 	conf := &gorm.Config{
 		Logger: logger.Default, SafetyAutoMigration: true,
 	}
 	if dbDriver == "mysql" {
 		conf.NamingStrategy = NamingStrategy{}
-		db, err = gorm.Open(mysql.Open(configs.Boot.Database.Mysql.DSN), conf)
+		db, err = gorm.Open(mysql.Open(dsn), conf)
 	} else {
-		db, err = gorm.Open(postgres.Open(configs.Boot.Database.Postgres.DSN), conf)
+		db, err = gorm.Open(postgres.Open(dsn), conf)
 	}
 
 	if err != nil {
@@ -249,3 +291,11 @@ func newDb() (db *gorm.DB) {
 	sqldb.SetMaxIdleConns(10)
 	return db
 }
+
+// dialectorWithConn dialector reuse opened connection pool
+func dialectorWithConn(dbDriver string, conn gorm.ConnPool) gorm.Dialector {
+	if dbDriver == "mysql" {
+		return mysql.New(mysql.Config{Conn: conn})
+	}
+	return postgres.New(postgres.Config{Conn: conn})
+}
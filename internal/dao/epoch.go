@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/itering/subscan/model"
+)
+
+// CreateEpoch save epoch if not exists, start of a saved epoch is moved back to an earlier block
+func (d *Dao) CreateEpoch(txn *GormDB, epoch *model.ChainEpoch) error {
+	if err := txn.Scopes(model.IgnoreDuplicate).Create(epoch).Error; err != nil {
+		return err
+	}
+	return txn.Model(&model.ChainEpoch{}).Where("epoch_index = ?", epoch.EpochIndex).Where("start_block > ?", epoch.StartBlock).
+		Updates(map[string]interface{}{"start_block": epoch.StartBlock, "start_at": epoch.StartAt}).Error
+}
+
+// CloseEpoch set end of epoch, end of a closed epoch is only moved back to an earlier block
+func (d *Dao) CloseEpoch(txn *GormDB, epochIndex, endBlock uint, endAt int) error {
+	return txn.Model(&model.ChainEpoch{}).Where("epoch_index = ?", epochIndex).Where("end_block = 0 or end_block > ?", endBlock).
+		Updates(map[string]interface{}{"end_block": endBlock, "end_at": endAt}).Error
+}
+
+// UpdateEpochChanges set validator set changes of epoch, the previous epoch may be saved after the epoch
+func (d *Dao) UpdateEpochChanges(txn *GormDB, epochIndex uint, joins, leaves model.SessionValidator) error {
+	return txn.Model(&model.ChainEpoch{}).Where("epoch_index = ?", epochIndex).
+		Updates(map[string]interface{}{"joins": joins, "leaves": leaves}).Error
+}
+
+func (d *Dao) GetEpoch(ctx context.Context, epochIndex uint) *model.ChainEpoch {
+	var epoch model.ChainEpoch
+	if err := d.db.WithContext(ctx).Where("epoch_index = ?", epochIndex).First(&epoch).Error; err != nil {
+		return nil
+	}
+	return &epoch
+}
+
+// GetEpochList epochs in descending order without validator set, after is the epoch index of the end of previous page
+func (d *Dao) GetEpochList(ctx context.Context, limit int, after *uint) (list []model.ChainEpoch, hasNext bool) {
+	q := d.db.WithContext(ctx).Model(&model.ChainEpoch{}).Omit("validators")
+	if after != nil {
+		q = q.Where("epoch_index < ?", *after)
+	}
+	q.Order("epoch_index desc").Limit(limit + 1).Find(&list)
+	if hasNext = len(list) > limit; hasNext {
+		list = list[:limit]
+	}
+	return list, hasNext
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// PingDb check db connection
+func (d *Dao) PingDb(ctx context.Context) error {
+	sqlDb, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDb.PingContext(ctx)
+}
+
+// GetReplicaDelay seconds of replication delay, replica is false if db is not a replica
+func (d *Dao) GetReplicaDelay(ctx context.Context) (delay int, replica bool, err error) {
+	return replicaDelay(ctx, d.db)
+}
+
+func replicaDelay(ctx context.Context, db *gorm.DB) (delay int, replica bool, err error) {
+	if db.Dialector.Name() != "mysql" {
+		var status struct {
+			Recovery bool
+			Delay    float64
+		}
+		err = db.WithContext(ctx).Raw("SELECT pg_is_in_recovery() AS recovery, COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) AS delay").Scan(&status).Error
+		return int(status.Delay), status.Recovery, err
+	}
+	rows, err := db.WithContext(ctx).Raw("SHOW SLAVE STATUS").Rows()
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return 0, false, rows.Err()
+	}
+	columns, _ := rows.Columns()
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Master" && column != "Seconds_Behind_Source" {
+			continue
+		}
+		// NULL when replication thread is not running
+		if values[i] == nil {
+			return 0, true, errors.New("replication is not running")
+		}
+		delay, err = strconv.Atoi(string(values[i]))
+		return delay, true, err
+	}
+	return 0, true, nil
+}
@@ -2,7 +2,9 @@ package dao
 
 import (
 	"context"
+	"fmt"
 	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
 	"gorm.io/gorm"
 )
 
@@ -17,15 +19,30 @@ func (d *Dao) Migration() {
 		db = db.Set("gorm:table_options", "ENGINE=InnoDB")
 	}
 	internalTables := d.internalTables(blockNum)
+	if model.NativePartition {
+		// partitioned tables must be created before auto migrate, split tables are left to MigratePartition
+		if err := d.createPartitionedTables(); err != nil {
+			util.Logger().Error(err)
+			if err = db.AutoMigrate(withoutPartitioned(internalTables)...); err != nil {
+				util.Logger().Error(fmt.Errorf("auto migrate failed: %v", err))
+			}
+			return
+		}
+		if err := db.AutoMigrate(internalTables...); err != nil {
+			util.Logger().Error(fmt.Errorf("auto migrate partitioned tables failed: %v", err))
+		}
+		d.AddPartition(blockNum + model.PartitionSize)
+		return
+	}
 	_ = db.AutoMigrate(internalTables...)
-	for i := 0; uint(i) <= blockNum/model.SplitTableBlockNum; i++ {
-		d.AddIndex(uint(i) * model.SplitTableBlockNum)
+	for i := uint(0); i <= model.TableIndex(blockNum); i++ {
+		d.AddIndex(model.TableBlockNum(i))
 	}
 }
 
 func (d *Dao) internalTables(blockNum uint) (models []interface{}) {
-	models = append(models, model.RuntimeVersion{}, model.Session{}, model.AccountExtrinsicMapping{})
-	for i := 0; uint(i) <= blockNum/model.SplitTableBlockNum; i++ {
+	models = append(models, model.RuntimeVersion{}, model.Session{}, model.AccountExtrinsicMapping{}, model.ChainBlockWeight{}, model.ChainExtrinsicCall{}, model.PluginCheckpoint{}, model.ChainBlockAuthor{}, model.ChainMissedSlot{}, model.ChainEpoch{}, model.ApiKey{})
+	for i := uint(0); i <= model.TableIndex(blockNum); i++ {
 		models = append(
 			models,
 			model.ChainBlock{BlockNum: blockNum},
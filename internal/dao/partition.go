@@ -0,0 +1,317 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+var partitionLock sync.Mutex
+
+func partitionedModels() []interface{} {
+	return []interface{}{&model.ChainBlock{}, &model.ChainEvent{}, &model.ChainExtrinsic{}, &model.ChainLog{}}
+}
+
+// createPartitionedTables create partitioned block, event, extrinsic and log tables,
+// error if split tables exist, they must be converted with MigratePartition
+func (d *Dao) createPartitionedTables() error {
+	for _, value := range partitionedModels() {
+		table := TableNameFromInterface(value, d.db)
+		if d.db.Migrator().HasTable(table) && !d.isPartitioned(table) {
+			return fmt.Errorf("%s is not partitioned, run MigratePartition to convert split tables", table)
+		}
+		if err := d.createPartitionedTable(value, table, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withoutPartitioned models except block, event, extrinsic and log
+func withoutPartitioned(models []interface{}) (list []interface{}) {
+	for _, value := range models {
+		switch value.(type) {
+		case model.ChainBlock, model.ChainEvent, model.ChainExtrinsic, model.ChainLog:
+		default:
+			list = append(list, value)
+		}
+	}
+	return
+}
+
+// createPartitionedTable create table range partitioned on block_num, partition key must be part of primary key
+// partitions named by logical table, so table can be renamed after created
+func (d *Dao) createPartitionedTable(value interface{}, table, logical string) error {
+	db := d.db
+	if db.Migrator().HasTable(table) {
+		return nil
+	}
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(value); err != nil {
+		return err
+	}
+	migrator, ok := db.Migrator().(interface {
+		FullDataTypeOf(*schema.Field) clause.Expr
+	})
+	if !ok {
+		return fmt.Errorf("%s migrator not support partitioned table", d.DbDriver)
+	}
+	var (
+		createTableSQL = "CREATE TABLE ? ("
+		values         = []interface{}{clause.Table{Name: table}}
+		primaryKeys    []interface{}
+	)
+	for _, dbName := range stmt.Schema.DBNames {
+		field := stmt.Schema.FieldsByDBName[dbName]
+		if field.IgnoreMigration {
+			continue
+		}
+		createTableSQL += "? ?,"
+		values = append(values, clause.Column{Name: dbName}, migrator.FullDataTypeOf(field))
+	}
+	for _, field := range stmt.Schema.PrimaryFields {
+		primaryKeys = append(primaryKeys, clause.Column{Name: field.DBName})
+	}
+	primaryKeys = append(primaryKeys, clause.Column{Name: "block_num"})
+	createTableSQL += "PRIMARY KEY ?)"
+	values = append(values, primaryKeys)
+
+	if d.DbDriver == "mysql" {
+		// mysql range partition table need at least one partition
+		createTableSQL += fmt.Sprintf(" ENGINE=InnoDB PARTITION BY RANGE (block_num) (PARTITION %s VALUES LESS THAN (%d))",
+			model.PartitionName(logical, 0), model.PartitionSize)
+	} else {
+		createTableSQL += " PARTITION BY RANGE (block_num)"
+	}
+	return db.Exec(createTableSQL, values...).Error
+}
+
+// isPartitioned check table is native partitioned table
+func (d *Dao) isPartitioned(table string) bool {
+	var count int64
+	if d.DbDriver == "mysql" {
+		d.db.Raw("SELECT COUNT(*) FROM information_schema.PARTITIONS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND PARTITION_NAME IS NOT NULL", table).Scan(&count)
+	} else {
+		d.db.Raw("SELECT COUNT(*) FROM pg_class WHERE relname = ? AND relkind = 'p'", table).Scan(&count)
+	}
+	return count > 0
+}
+
+// partitionIndexes exists partition indexes of table
+func (d *Dao) partitionIndexes(table string) (map[uint]bool, error) {
+	var (
+		names []string
+		err   error
+	)
+	if d.DbDriver == "mysql" {
+		err = d.db.Raw("SELECT PARTITION_NAME FROM information_schema.PARTITIONS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND PARTITION_NAME IS NOT NULL", table).Scan(&names).Error
+	} else {
+		err = d.db.Raw("SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid JOIN pg_class p ON p.oid = i.inhparent WHERE p.relname = ?", table).Scan(&names).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[uint]bool)
+	for _, name := range names {
+		if i := strings.LastIndex(name, "_p"); i >= 0 {
+			if index, err := strconv.ParseUint(name[i+2:], 10, 64); err == nil {
+				indexes[uint(index)] = true
+			}
+		}
+	}
+	return indexes, nil
+}
+
+// ensurePartitions create partitions of table until partition index
+func (d *Dao) ensurePartitions(table, logical string, index uint) error {
+	exists, err := d.partitionIndexes(table)
+	if err != nil {
+		return err
+	}
+	var start uint
+	if d.DbDriver == "mysql" {
+		// mysql range partition can only be added after the highest partition
+		for i := range exists {
+			if i >= start {
+				start = i + 1
+			}
+		}
+	}
+	for i := start; i <= index; i++ {
+		if exists[i] {
+			continue
+		}
+		var query string
+		if d.DbDriver == "mysql" {
+			query = fmt.Sprintf("ALTER TABLE %s ADD PARTITION (PARTITION %s VALUES LESS THAN (%d))",
+				d.quote(table), model.PartitionName(logical, i), (i+1)*model.PartitionSize)
+		} else {
+			query = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%d) TO (%d)",
+				d.quote(model.PartitionName(logical, i)), d.quote(table), i*model.PartitionSize, (i+1)*model.PartitionSize)
+		}
+		if err = d.db.Exec(query).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Dao) quote(name string) string {
+	return d.db.Statement.Quote(name)
+}
+
+// AddPartition create partitions of block, event, extrinsic and log tables which block num belongs to
+func (d *Dao) AddPartition(blockNum uint) {
+	if blockNum < maxPartitionBlockNum {
+		return
+	}
+	partitionLock.Lock()
+	defer partitionLock.Unlock()
+	if blockNum < maxPartitionBlockNum {
+		return
+	}
+	for _, value := range partitionedModels() {
+		table := TableNameFromInterface(value, d.db)
+		if err := d.ensurePartitions(table, table, model.PartitionIndex(blockNum)); err != nil {
+			util.Logger().Error(fmt.Errorf("add partition of %s failed: %v", table, err))
+			return
+		}
+	}
+	maxPartitionBlockNum = (model.PartitionIndex(blockNum) + 1) * model.PartitionSize
+}
+
+// MigratePartition convert split tables (chain_blocks, chain_blocks_1 ...) to native partitioned tables
+// data copied to a new partitioned table in batches, then swapped with the split table
+// split tables are renamed with _legacy suffix, or dropped if dropLegacy
+func (d *Dao) MigratePartition(ctx context.Context, batch uint, dropLegacy bool) error {
+	if batch == 0 {
+		batch = 10000
+	}
+	for _, value := range partitionedModels() {
+		table := TableNameFromInterface(value, d.db)
+		if d.isPartitioned(table) {
+			util.Logger().Info(fmt.Sprintf("%s already partitioned, skip", table))
+			continue
+		}
+		if err := d.migrateTablePartition(ctx, value, table, batch, dropLegacy); err != nil {
+			return fmt.Errorf("migrate %s: %v", table, err)
+		}
+	}
+	return nil
+}
+
+func (d *Dao) migrateTablePartition(ctx context.Context, value interface{}, table string, batch uint, dropLegacy bool) error {
+	migrator := d.db.Migrator()
+	partitioned := table + "_partitioned"
+	if err := d.createPartitionedTable(value, partitioned, table); err != nil {
+		return err
+	}
+
+	stmt := &gorm.Statement{DB: d.db}
+	if err := stmt.Parse(value); err != nil {
+		return err
+	}
+	var columns []string
+	for _, dbName := range stmt.Schema.DBNames {
+		if !stmt.Schema.FieldsByDBName[dbName].IgnoreMigration {
+			columns = append(columns, d.quote(dbName))
+		}
+	}
+	insert := "INSERT INTO %s (%s) SELECT %s FROM %s WHERE block_num >= ? AND block_num < ? ON CONFLICT DO NOTHING"
+	if d.DbDriver == "mysql" {
+		insert = "INSERT IGNORE INTO %s (%s) SELECT %s FROM %s WHERE block_num >= ? AND block_num < ?"
+	}
+
+	var splitTables []string
+	for index := uint(0); ; index++ {
+		source := table
+		if index > 0 {
+			source = fmt.Sprintf("%s_%d", table, index)
+		}
+		if !migrator.HasTable(source) {
+			break
+		}
+		splitTables = append(splitTables, source)
+
+		var blockRange struct {
+			Min *uint
+			Max *uint
+		}
+		if err := d.db.WithContext(ctx).Table(source).Select("MIN(block_num) AS min, MAX(block_num) AS max").Scan(&blockRange).Error; err != nil {
+			return err
+		}
+		if blockRange.Max == nil {
+			continue
+		}
+		if err := d.ensurePartitions(partitioned, table, model.PartitionIndex(*blockRange.Max)); err != nil {
+			return err
+		}
+		query := fmt.Sprintf(insert, d.quote(partitioned), strings.Join(columns, ","), strings.Join(columns, ","), d.quote(source))
+		for start := *blockRange.Min; start <= *blockRange.Max; start += batch {
+			if err := d.db.WithContext(ctx).Exec(query, start, start+batch).Error; err != nil {
+				return err
+			}
+			util.Logger().Info(fmt.Sprintf("%s copied block %d-%d to %s", source, start, start+batch-1, partitioned))
+		}
+	}
+
+	// continue auto increment sequence of copied rows
+	if field := stmt.Schema.PrioritizedPrimaryField; d.DbDriver == "postgres" && field != nil && field.AutoIncrement {
+		if err := d.db.Exec(fmt.Sprintf("SELECT setval(pg_get_serial_sequence('%s', '%s'), (SELECT COALESCE(MAX(%s), 0) + 1 FROM %s), false)",
+			partitioned, field.DBName, d.quote(field.DBName), d.quote(partitioned))).Error; err != nil {
+			return err
+		}
+	}
+
+	legacy := table + "_legacy"
+	if len(splitTables) > 0 {
+		if err := migrator.RenameTable(table, legacy); err != nil {
+			return err
+		}
+		if err := d.renameLegacyIndexes(table, legacy); err != nil {
+			return err
+		}
+	}
+	if err := migrator.RenameTable(partitioned, table); err != nil {
+		return err
+	}
+	if dropLegacy && len(splitTables) > 0 {
+		splitTables[0] = legacy
+		for _, source := range splitTables {
+			if err := migrator.DropTable(source); err != nil {
+				return err
+			}
+		}
+	}
+	// indexes created after data copied
+	return d.db.Table(table).AutoMigrate(value)
+}
+
+// renameLegacyIndexes postgres index names are unique in schema, indexes kept by renamed table
+// take the names of indexes of the new table, rename them with the legacy table name
+func (d *Dao) renameLegacyIndexes(table, legacy string) error {
+	if d.DbDriver == "mysql" {
+		return nil
+	}
+	var names []string
+	if err := d.db.Raw("SELECT indexname FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ?", legacy).Scan(&names).Error; err != nil {
+		return err
+	}
+	for _, name := range names {
+		if !strings.Contains(name, table) || strings.Contains(name, legacy) {
+			continue
+		}
+		if err := d.db.Exec(fmt.Sprintf("ALTER INDEX %s RENAME TO %s", d.quote(name), d.quote(strings.Replace(name, table, legacy, 1)))).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/model"
+	"gorm.io/gorm"
+)
+
+func (d *Dao) pluginDb(plugin string) *gorm.DB {
+	return pluginDb(d.db, d.DbDriver, plugin)
+}
+
+// GetPluginCheckpoint checkpoint of plugin in plugin database, created from checkpoint in primary database
+// or legacy processed block num if not exist
+func (d *Dao) GetPluginCheckpoint(ctx context.Context, plugin string) (*model.PluginCheckpoint, error) {
+	db := d.pluginDb(plugin)
+	var checkpoint model.PluginCheckpoint
+	err := db.WithContext(ctx).Where("plugin = ?", plugin).First(&checkpoint).Error
+	if err == nil {
+		return &checkpoint, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	checkpoint = model.PluginCheckpoint{Plugin: plugin}
+	// checkpoint kept in primary database before plugin database configured
+	if db == d.db || d.db.WithContext(ctx).Where("plugin = ?", plugin).First(&checkpoint).Error != nil {
+		if processed := d.legacyPluginProcessedBlockNum(ctx, plugin); processed > 0 {
+			checkpoint.Next = uint(processed) + 1
+		}
+	}
+	if err = model.AddOrUpdateItem(ctx, db, &checkpoint, []string{"plugin"}, "plugin").Error; err != nil {
+		return nil, err
+	}
+	return d.GetPluginCheckpoint(ctx, plugin)
+}
+
+// legacyPluginProcessedBlockNum max block num processed by plugin before checkpoint introduced
+func (d *Dao) legacyPluginProcessedBlockNum(ctx context.Context, plugin string) int {
+	if d.redis == nil {
+		return 0
+	}
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return 0
+	}
+	defer conn.Close()
+	num, _ := redis.Int(conn.Do("HGET", RedisPluginProcessedBlock, plugin))
+	return num
+}
+
+// DeliverPluginBlock call deliver with storage of plugin bound to a transaction, checkpoint is advanced from next in the same transaction,
+// nothing is committed if deliver failed, false if checkpoint is not next anymore (reset by others)
+func (d *Dao) DeliverPluginBlock(ctx context.Context, plugin string, next uint, deliver func(storage.Dao) error) (bool, error) {
+	var advanced bool
+	err := d.pluginDb(plugin).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// checkpoint row is locked until committed, reset waits for delivering block
+		query := tx.Model(&model.PluginCheckpoint{}).Where("plugin = ? AND next = ?", plugin, next).
+			Updates(map[string]interface{}{"next": next + 1, "delivering": false})
+		if query.Error != nil || query.RowsAffected != 1 {
+			return query.Error
+		}
+		if err := deliver(&DbStorage{db: tx, Prefix: plugin, DbDriver: d.DbDriver, d: d}); err != nil {
+			return err
+		}
+		advanced = true
+		return nil
+	})
+	return advanced && err == nil, err
+}
+
+// MarkPluginDelivering mark block next is delivering outside a transaction, false if checkpoint is not next anymore
+func (d *Dao) MarkPluginDelivering(ctx context.Context, plugin string, next uint) (bool, error) {
+	query := d.pluginDb(plugin).WithContext(ctx).Model(&model.PluginCheckpoint{}).Where("plugin = ? AND next = ?", plugin, next).Update("delivering", true)
+	return query.RowsAffected == 1, query.Error
+}
+
+// AdvancePluginCheckpoint move checkpoint from to to, false if checkpoint is not from anymore (reset by others)
+func (d *Dao) AdvancePluginCheckpoint(ctx context.Context, plugin string, from, to uint) (bool, error) {
+	query := d.pluginDb(plugin).WithContext(ctx).Model(&model.PluginCheckpoint{}).Where("plugin = ? AND next = ?", plugin, from).
+		Updates(map[string]interface{}{"next": to, "delivering": false})
+	return query.RowsAffected == 1, query.Error
+}
+
+// ResetPluginCheckpoint set next block num of plugin, blocks from next will be delivered again
+func (d *Dao) ResetPluginCheckpoint(ctx context.Context, plugin string, next uint) error {
+	return model.AddOrUpdateItem(ctx, d.pluginDb(plugin), &model.PluginCheckpoint{Plugin: plugin, Next: next}, []string{"plugin"}, "next", "delivering", "updated_at").Error
+}
+
+// GetPluginCheckpoints next block num of plugins, plugin without checkpoint is omitted
+func (d *Dao) GetPluginCheckpoints(ctx context.Context, plugins []string) (map[string]uint, error) {
+	checkpoints := make(map[string]uint, len(plugins))
+	for _, plugin := range plugins {
+		var checkpoint model.PluginCheckpoint
+		err := d.pluginDb(plugin).WithContext(ctx).Where("plugin = ?", plugin).First(&checkpoint).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		checkpoints[plugin] = checkpoint.Next
+	}
+	return checkpoints, nil
+}
+
+// acquire lock or extend ttl if lock is held by token
+var lockScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("EXPIRE", KEYS[1], ARGV[2])
+end
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "EX", ARGV[2]) then
+	return 1
+end
+return 0`)
+
+var unlockScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// AcquireLock hold lock of key with token for ttl seconds, call again before expired to extend
+func (d *Dao) AcquireLock(ctx context.Context, key, token string, ttl int) bool {
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+	ok, _ := redis.Bool(lockScript.Do(conn, model.RedisKeyPrefix()+key, token, ttl))
+	return ok
+}
+
+// ReleaseLock release lock of key if held by token
+func (d *Dao) ReleaseLock(ctx context.Context, key, token string) {
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	_, _ = unlockScript.Do(conn, model.RedisKeyPrefix()+key, token)
+}
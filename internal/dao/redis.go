@@ -3,6 +3,9 @@ package dao
 import (
 	"context"
 	"log"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/itering/subscan/model"
 )
 
 func (d *Dao) pingRedis(ctx context.Context) (err error) {
@@ -13,3 +16,61 @@ func (d *Dao) pingRedis(ctx context.Context) (err error) {
 	}
 	return
 }
+
+const storageCachePrefix = "storage:"
+
+// GetStorageCache cached storage query, nil if not cached
+func (d *Dao) GetStorageCache(ctx context.Context, key string) []byte {
+	return d.redis.GetCacheBytes(ctx, model.RedisKeyPrefix()+storageCachePrefix+key)
+}
+
+func (d *Dao) SetStorageCache(ctx context.Context, key string, value interface{}, ttl int) error {
+	return d.redis.SetCache(ctx, model.RedisKeyPrefix()+storageCachePrefix+key, value, ttl)
+}
+
+const (
+	responseCachePrefix = "response:"
+	responseCacheHead   = "response_head"
+)
+
+// GetResponseCache cached api response, nil if not cached
+func (d *Dao) GetResponseCache(ctx context.Context, key string) []byte {
+	return d.redis.GetCacheBytes(ctx, model.RedisKeyPrefix()+responseCachePrefix+key)
+}
+
+func (d *Dao) SetResponseCache(ctx context.Context, key string, value []byte, ttl int) error {
+	return d.redis.SetCache(ctx, model.RedisKeyPrefix()+responseCachePrefix+key, string(value), ttl)
+}
+
+// GetResponseCacheHead generation of head responses, part of key of head responses
+func (d *Dao) GetResponseCacheHead(ctx context.Context) int64 {
+	return d.redis.GetCacheInt64(ctx, model.RedisKeyPrefix()+responseCacheHead)
+}
+
+// GetResponseCacheGeneration generation of all responses, part of key of every response
+func (d *Dao) GetResponseCacheGeneration(ctx context.Context) int64 {
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return 0
+	}
+	defer conn.Close()
+	generation, _ := redis.Int64(conn.Do("HGET", model.ResponseCacheGenerationKey(), model.ResponseCacheGenerationField))
+	return generation
+}
+
+// IncrResponseCacheGeneration move to next generation when blocks are re-processed, cached responses are left to expire
+func (d *Dao) IncrResponseCacheGeneration(ctx context.Context) error {
+	_, err := d.redis.HINCRBY(ctx, model.ResponseCacheGenerationKey(), model.ResponseCacheGenerationField, 1)
+	return err
+}
+
+// IncrResponseCacheHead move to next generation, cached head responses are left to expire
+func (d *Dao) IncrResponseCacheHead(ctx context.Context) error {
+	conn, err := d.redis.Redis().GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Do("INCR", model.RedisKeyPrefix()+responseCacheHead)
+	return err
+}
@@ -0,0 +1,195 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
+)
+
+const replicaCheckInterval = 10 * time.Second
+
+type replica struct {
+	name    string
+	db      *gorm.DB
+	pool    gorm.ConnPool
+	healthy atomic.Bool
+	delay   atomic.Int64
+	err     atomic.Value
+}
+
+// replicaSet dbresolver policy, round robin between healthy replicas, fallback to primary when all replicas out of rotation
+type replicaSet struct {
+	primary  gorm.ConnPool
+	replicas []*replica
+	next     atomic.Uint64
+	stop     chan struct{}
+	once     sync.Once
+}
+
+func (r *replicaSet) Resolve(pools []gorm.ConnPool) gorm.ConnPool {
+	var healthy []gorm.ConnPool
+	for _, pool := range pools {
+		for _, rep := range r.replicas {
+			if rep.pool == pool && rep.healthy.Load() {
+				healthy = append(healthy, pool)
+			}
+		}
+	}
+	if len(healthy) == 0 {
+		return r.primary
+	}
+	return healthy[int(r.next.Add(1)%uint64(len(healthy)))]
+}
+
+// check replication delay of every replica, replica delay more than max delay is out of rotation
+func (r *replicaSet) check(ctx context.Context, maxDelay int) {
+	for _, rep := range r.replicas {
+		delay, _, err := replicaDelay(ctx, rep.db)
+		rep.delay.Store(int64(delay))
+		if err == nil && delay > maxDelay {
+			err = fmt.Errorf("replica delay %ds exceeds %ds", delay, maxDelay)
+		}
+		healthy := err == nil
+		if rep.healthy.Swap(healthy) != healthy {
+			if healthy {
+				util.Logger().Info(fmt.Sprintf("db replica %s back to rotation", rep.name))
+			} else {
+				util.Logger().Error(fmt.Errorf("db replica %s out of rotation: %v", rep.name, err))
+			}
+		}
+		var errMsg string
+		if err != nil {
+			errMsg = err.Error()
+		}
+		rep.err.Store(errMsg)
+	}
+}
+
+func (r *replicaSet) monitor() {
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), replicaCheckInterval)
+			r.check(ctx, maxReplicaDelay())
+			cancel()
+		}
+	}
+}
+
+func (r *replicaSet) close() {
+	r.once.Do(func() { close(r.stop) })
+	for _, rep := range r.replicas {
+		if sqlDb, err := rep.db.DB(); err == nil {
+			_ = sqlDb.Close()
+		}
+	}
+}
+
+func maxReplicaDelay() int {
+	if configs.Boot.Health != nil && configs.Boot.Health.MaxReplicaDelay != nil {
+		return *configs.Boot.Health.MaxReplicaDelay
+	}
+	return 60
+}
+
+// newReplicaDb api read db, writes go to primary, reads go to replicas by replicaSet policy
+// return nil if no replica configured or replicas can not be set up, reads go to primary then
+func newReplicaDb(primary *gorm.DB, dsns []string) (*gorm.DB, *replicaSet, error) {
+	if len(dsns) == 0 {
+		return nil, nil, nil
+	}
+	primarySqlDb, err := primary.DB()
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := gorm.Open(dialectorWithConn(primary.Dialector.Name(), primarySqlDb), primary.Config)
+	if err != nil {
+		return nil, nil, err
+	}
+	set := &replicaSet{primary: primarySqlDb, stop: make(chan struct{})}
+	// primary as the last replica, Resolve always called with more than one pools
+	dialectors := []gorm.Dialector{dialectorWithConn(primary.Dialector.Name(), primarySqlDb)}
+	for i, dsn := range dsns {
+		replicaDb, err := openReplicaDb(primary.Dialector.Name(), dsn)
+		if err != nil {
+			set.close()
+			return nil, nil, fmt.Errorf("replica-%d: %v", i, err)
+		}
+		replicaSqlDb, _ := replicaDb.DB()
+		// out of rotation until the first check pass
+		rep := &replica{name: fmt.Sprintf("replica-%d", i), db: replicaDb, pool: replicaSqlDb}
+		set.replicas = append(set.replicas, rep)
+		dialectors = append([]gorm.Dialector{dialectorWithConn(primary.Dialector.Name(), replicaSqlDb)}, dialectors...)
+	}
+	if err = db.Use(dbresolver.Register(dbresolver.Config{Replicas: dialectors, Policy: set})); err != nil {
+		set.close()
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), replicaCheckInterval)
+	set.check(ctx, maxReplicaDelay())
+	cancel()
+	go set.monitor()
+	return db, set, nil
+}
+
+// openReplicaDb open replica without connecting, unlike openDb unreachable replica does not panic,
+// it stays out of rotation until monitor check it back
+func openReplicaDb(dbDriver, dsn string) (*gorm.DB, error) {
+	conf := &gorm.Config{Logger: logger.Default, DisableAutomaticPing: true}
+	var dialector gorm.Dialector
+	if dbDriver == "mysql" {
+		conf.NamingStrategy = NamingStrategy{}
+		dialector = mysql.New(mysql.Config{DSN: dsn, SkipInitializeWithVersion: true})
+	} else {
+		dialector = postgres.New(postgres.Config{DSN: dsn})
+	}
+	db, err := gorm.Open(dialector, conf)
+	if err != nil {
+		return nil, err
+	}
+	sqldb, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqldb.SetConnMaxLifetime(5 * time.Minute)
+	sqldb.SetMaxOpenConns(util.StringToInt(util.GetEnv("MAX_DB_CONN_COUNT", "200")))
+	sqldb.SetMaxIdleConns(10)
+	return db, nil
+}
+
+// readDb db for api cursor queries, use replicas if configured
+func (d *Dao) readDb() *gorm.DB {
+	if d.replicaDb != nil {
+		return d.replicaDb
+	}
+	return d.db
+}
+
+// GetReplicas status of read replicas
+func (d *Dao) GetReplicas(_ context.Context) []model.DbReplica {
+	if d.replicas == nil {
+		return nil
+	}
+	var list []model.DbReplica
+	for _, rep := range d.replicas.replicas {
+		status := model.DbReplica{Name: rep.name, Delay: int(rep.delay.Load()), Healthy: rep.healthy.Load()}
+		status.Error, _ = rep.err.Load().(string)
+		list = append(list, status)
+	}
+	return list
+}
@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestReplicaSet_Resolve(t *testing.T) {
+	primary, r0, r1 := &sql.DB{}, &sql.DB{}, &sql.DB{}
+	set := &replicaSet{primary: primary, replicas: []*replica{{name: "replica-0", pool: r0}, {name: "replica-1", pool: r1}}}
+	set.replicas[0].healthy.Store(true)
+	set.replicas[1].healthy.Store(true)
+	pools := []gorm.ConnPool{r0, r1, primary}
+
+	first, second := set.Resolve(pools), set.Resolve(pools)
+	assert.NotSame(t, first, second)
+	assert.NotSame(t, primary, first)
+
+	set.replicas[0].healthy.Store(false)
+	assert.Same(t, r1, set.Resolve(pools))
+	assert.Same(t, r1, set.Resolve(pools))
+
+	set.replicas[1].healthy.Store(false)
+	assert.Same(t, primary, set.Resolve(pools))
+}
+
+func TestDao_GetReplicas(t *testing.T) {
+	assert.Nil(t, (&Dao{}).GetReplicas(context.TODO()))
+}
+
+func TestReplicaSet_Unreachable(t *testing.T) {
+	db, err := openReplicaDb("postgres", "host=127.0.0.1 port=1 user=subscan dbname=subscan connect_timeout=1")
+	assert.NoError(t, err)
+	sqlDb, _ := db.DB()
+	set := &replicaSet{stop: make(chan struct{}), replicas: []*replica{{name: "replica-0", db: db, pool: sqlDb}}}
+	set.check(context.TODO(), 60)
+	assert.False(t, set.replicas[0].healthy.Load())
+	assert.NotEmpty(t, set.replicas[0].err.Load())
+	set.close()
+}
@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itering/subscan/util"
+)
+
+// AdminAuth middleware
+// Require ADMIN_TOKEN in header Authorization: Bearer {token}, admin api disabled if ADMIN_TOKEN not set
+func AdminAuth() gin.HandlerFunc {
+	token := util.GetEnv("ADMIN_TOKEN", "")
+	return func(context *gin.Context) {
+		given := strings.TrimPrefix(context.GetHeader("Authorization"), "Bearer ")
+		if token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			abort(context, http.StatusUnauthorized, util.Unauthorized)
+			return
+		}
+		context.Next()
+	}
+}
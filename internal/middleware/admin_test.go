@@ -0,0 +1,33 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AdminAuth(t *testing.T) {
+	serve := func(token string) int {
+		engine := gin.New()
+		engine.Use(AdminAuth())
+		engine.POST("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		engine.ServeHTTP(w, req)
+		return w.Code
+	}
+	// disabled without ADMIN_TOKEN
+	t.Setenv("ADMIN_TOKEN", "")
+	assert.Equal(t, http.StatusUnauthorized, serve(""))
+
+	t.Setenv("ADMIN_TOKEN", "secret")
+	assert.Equal(t, http.StatusUnauthorized, serve(""))
+	assert.Equal(t, http.StatusUnauthorized, serve("wrong"))
+	assert.Equal(t, http.StatusOK, serve("secret"))
+}
@@ -0,0 +1,180 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/share/metrics"
+)
+
+// maxCacheRequestBody requests with larger body are not cached
+const maxCacheRequestBody = 64 << 10
+
+// ResponseCacheStore storage of cached responses, ResponseCacheHead is the generation of head responses,
+// ResponseCacheGeneration is the generation of all responses
+type ResponseCacheStore interface {
+	GetResponseCache(ctx context.Context, key string) []byte
+	SetResponseCache(ctx context.Context, key string, value []byte, ttl int) error
+	ResponseCacheHead(ctx context.Context) int64
+	ResponseCacheGeneration(ctx context.Context) int64
+}
+
+// ResponseCache middleware
+// Cache successful json responses of routes in config by route, query and body, set header ETag and reply 304 to If-None-Match.
+// Head responses are keyed by generation of head, so they are dropped when a finalized block is indexed,
+// all responses are keyed by generation, so they are dropped when blocks are re-processed or repaired
+func ResponseCache(store ResponseCacheStore, c *configs.Cache) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		route := context.FullPath()
+		if c == nil || c.Disabled || store == nil {
+			context.Next()
+			return
+		}
+		policy, ok := c.Routes[route]
+		if !ok || policy == nil || policy.Ttl <= 0 {
+			context.Next()
+			return
+		}
+		key, ok := responseCacheKey(context, route)
+		if !ok {
+			context.Next()
+			return
+		}
+		ctx := context.Request.Context()
+		key = fmt.Sprintf("%d:%s", store.ResponseCacheGeneration(ctx), key)
+		if policy.Head {
+			key = fmt.Sprintf("head:%d:%s", store.ResponseCacheHead(ctx), key)
+		}
+		if body := store.GetResponseCache(ctx, key); body != nil {
+			metrics.ApiCache.WithLabelValues(route, "hit").Inc()
+			context.Header("X-Cache", "HIT")
+			writeCachedResponse(context, body)
+			context.Abort()
+			return
+		}
+		metrics.ApiCache.WithLabelValues(route, "miss").Inc()
+
+		writer := bufferResponse(context)
+		body := writer.body.Bytes()
+		if writer.status == http.StatusOK && cacheableResponse(body) {
+			_ = store.SetResponseCache(ctx, key, body, policy.Ttl)
+			context.Header("X-Cache", "MISS")
+			writeCachedResponse(context, body)
+			return
+		}
+		context.Writer.WriteHeader(writer.status)
+		_, _ = context.Writer.Write(body)
+	}
+}
+
+// bufferResponse run handlers with response held in buffer
+func bufferResponse(context *gin.Context) *bufferedWriter {
+	writer := &bufferedWriter{ResponseWriter: context.Writer, status: http.StatusOK}
+	context.Writer = writer
+	// writer is restored before a panic reaches gin.Recovery, or its 500 is held in buffer and an empty 200 is replied
+	defer func() {
+		context.Writer = writer.ResponseWriter
+		if r := recover(); r != nil {
+			panic(r)
+		}
+	}()
+	context.Next()
+	return writer
+}
+
+// responseCacheKey key of method, route, query and body, request body is restored for handler
+func responseCacheKey(context *gin.Context, route string) (string, bool) {
+	var body []byte
+	if context.Request.Body != nil {
+		var err error
+		if body, err = io.ReadAll(io.LimitReader(context.Request.Body, maxCacheRequestBody+1)); err != nil {
+			return "", false
+		}
+		context.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), context.Request.Body))
+		if len(body) > maxCacheRequestBody {
+			return "", false
+		}
+	}
+	hash := sha256.New()
+	hash.Write([]byte(context.Request.Method + " " + context.Request.URL.RawQuery + "\n"))
+	hash.Write(compactJson(body))
+	return route + ":" + hex.EncodeToString(hash.Sum(nil)), true
+}
+
+// compactJson same key for params with different whitespace
+func compactJson(body []byte) []byte {
+	var buf bytes.Buffer
+	if json.Compact(&buf, body) != nil {
+		return body
+	}
+	return buf.Bytes()
+}
+
+// cacheableResponse success response with data, not found and errors are not cached
+func cacheableResponse(body []byte) bool {
+	var r struct {
+		Code int             `json:"code"`
+		Data json.RawMessage `json:"data"`
+	}
+	if json.Unmarshal(body, &r) != nil || r.Code != 0 {
+		return false
+	}
+	data := strings.TrimSpace(string(r.Data))
+	return data != "" && data != "null"
+}
+
+func writeCachedResponse(context *gin.Context, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	context.Header("ETag", etag)
+	if match := context.GetHeader("If-None-Match"); match != "" && (match == etag || match == "W/"+etag) {
+		context.Status(http.StatusNotModified)
+		context.Writer.WriteHeaderNow()
+		return
+	}
+	context.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// bufferedWriter hold response of handler, written by middleware after ETag is set
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
@@ -0,0 +1,114 @@
+package middlewares
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itering/subscan/configs"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCacheStore struct {
+	items      map[string][]byte
+	head       int64
+	generation int64
+}
+
+func (s *testCacheStore) GetResponseCache(_ context.Context, key string) []byte { return s.items[key] }
+
+func (s *testCacheStore) SetResponseCache(_ context.Context, key string, value []byte, _ int) error {
+	s.items[key] = append([]byte(nil), value...)
+	return nil
+}
+
+func (s *testCacheStore) ResponseCacheHead(_ context.Context) int64 { return s.head }
+
+func (s *testCacheStore) ResponseCacheGeneration(_ context.Context) int64 { return s.generation }
+
+func Test_ResponseCache(t *testing.T) {
+	store := &testCacheStore{items: map[string][]byte{}}
+	calls := 0
+	engine := gin.New()
+	engine.Use(gin.Recovery())
+	engine.Use(ResponseCache(store, &configs.Cache{Routes: map[string]*configs.CacheRoute{
+		"/block":  {Ttl: 60},
+		"/blocks": {Ttl: 6, Head: true},
+		"/panic":  {Ttl: 60},
+	}}))
+	handle := func(c *gin.Context) {
+		calls++
+		body, _ := io.ReadAll(c.Request.Body)
+		if strings.Contains(string(body), "missing") {
+			c.JSON(http.StatusOK, gin.H{"code": 0})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"code": 0, "data": string(body)})
+	}
+	engine.POST("/block", handle)
+	engine.POST("/blocks", handle)
+	engine.POST("/now", handle)
+	engine.POST("/panic", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"code": 0, "data": "partial"})
+		panic("handler panic")
+	})
+	serve := func(path, body, etag string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve("/block", `{"block_num": 1}`, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "MISS", w.Header().Get("X-Cache"))
+	etag := w.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	body := w.Body.String()
+
+	// same params with different whitespace
+	w = serve("/block", `{"block_num":1}`, "")
+	assert.Equal(t, "HIT", w.Header().Get("X-Cache"))
+	assert.Equal(t, body, w.Body.String())
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, 1, calls)
+
+	w = serve("/block", `{"block_num":1}`, etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	// not found is not cached
+	serve("/block", `{"missing":1}`, "")
+	serve("/block", `{"missing":1}`, "")
+	assert.Equal(t, 3, calls)
+
+	// head responses are dropped with new head
+	serve("/blocks", `{}`, "")
+	assert.Equal(t, "HIT", serve("/blocks", `{}`, "").Header().Get("X-Cache"))
+	store.head++
+	assert.Equal(t, "MISS", serve("/blocks", `{}`, "").Header().Get("X-Cache"))
+	assert.Equal(t, 5, calls)
+
+	// all responses are dropped with new generation
+	store.generation++
+	assert.Equal(t, "MISS", serve("/block", `{"block_num":1}`, "").Header().Get("X-Cache"))
+	assert.Equal(t, 6, calls)
+
+	// panic is replied by recovery, not cached
+	w = serve("/panic", `{}`, "")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	// not cached route
+	w = serve("/now", `{}`, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("X-Cache"))
+	assert.Len(t, store.items, 4)
+}
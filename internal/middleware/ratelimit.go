@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/pkg/ecode"
+	"github.com/itering/subscan/util"
+)
+
+// RateLimiter take a token of api key or client ip, and of its route if the route has a limit
+type RateLimiter interface {
+	Allow(ctx context.Context, apiKey, clientIp, route string) (*model.RateLimitState, error)
+}
+
+// RateLimit middleware
+// Api key is read from header X-API-Key or query apikey (etherscan compatible api), requests without key are limited per ip.
+// Set header with X-RateLimit-*, disabled if API_RATE_LIMIT is false
+func RateLimit(limiter RateLimiter) gin.HandlerFunc {
+	enabled := util.GetEnv("API_RATE_LIMIT", "true") != "false"
+	return func(context *gin.Context) {
+		if !enabled || limiter == nil || context.Request.Method == http.MethodOptions {
+			context.Next()
+			return
+		}
+		apiKey := context.GetHeader("X-API-Key")
+		if apiKey == "" {
+			apiKey = context.Query("apikey")
+		}
+		state, err := limiter.Allow(context.Request.Context(), apiKey, context.ClientIP(), context.FullPath())
+		if err != nil {
+			// unknown or revoked api key
+			abort(context, http.StatusUnauthorized, util.Unauthorized)
+			return
+		}
+		header := context.Writer.Header()
+		header.Set("X-RateLimit-Limit", strconv.Itoa(state.Limit))
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(state.Remaining))
+		header.Set("X-RateLimit-Reset", strconv.Itoa(state.Reset))
+		if state.QuotaLimit > 0 {
+			header.Set("X-RateLimit-Quota-Limit", strconv.FormatInt(state.QuotaLimit, 10))
+			header.Set("X-RateLimit-Quota-Remaining", strconv.FormatInt(state.QuotaRemaining, 10))
+		}
+		if !state.Allowed {
+			header.Set("Retry-After", strconv.Itoa(state.RetryAfter))
+			abort(context, http.StatusTooManyRequests, util.TooManyRequests)
+			return
+		}
+		context.Next()
+	}
+}
+
+func abort(context *gin.Context, status int, code ecode.Codes) {
+	context.AbortWithStatusJSON(status, gin.H{
+		"code":         code.Code(),
+		"message":      code.Message(),
+		"generated_at": time.Now().Unix(),
+	})
+}
@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLimiter struct {
+	apiKey, route string
+}
+
+func (l *testLimiter) Allow(_ context.Context, apiKey, _, route string) (*model.RateLimitState, error) {
+	l.apiKey, l.route = apiKey, route
+	switch apiKey {
+	case "revoked":
+		return nil, util.Unauthorized
+	case "limited":
+		return &model.RateLimitState{Limit: 10, Reset: 5, RetryAfter: 1}, nil
+	}
+	return &model.RateLimitState{Allowed: true, Limit: 10, Remaining: 9, Reset: 1, QuotaLimit: 100, QuotaRemaining: 50}, nil
+}
+
+func Test_RateLimit(t *testing.T) {
+	limiter := &testLimiter{}
+	engine := gin.New()
+	engine.Use(RateLimit(limiter))
+	engine.GET("/api/:module", func(c *gin.Context) { c.Status(http.StatusOK) })
+	serve := func(target, header string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", target, nil)
+		if header != "" {
+			req.Header.Set("X-API-Key", header)
+		}
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve("/api/logs?apikey=key", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "key", limiter.apiKey)
+	assert.Equal(t, "/api/:module", limiter.route)
+	assert.Equal(t, "9", w.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "50", w.Header().Get("X-RateLimit-Quota-Remaining"))
+
+	w = serve("/api/logs", "limited")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+
+	assert.Equal(t, http.StatusUnauthorized, serve("/api/logs", "revoked").Code)
+
+	t.Setenv("API_RATE_LIMIT", "false")
+	disabled := gin.New()
+	disabled.Use(RateLimit(limiter))
+	disabled.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("X-API-Key", "revoked")
+	disabled.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
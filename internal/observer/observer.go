@@ -44,6 +44,11 @@ func Run(dt string) {
 			defer wg.Done()
 			Consumption()
 		}()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			srv.RunPluginCheckpoints(ctx)
+		}()
 	default:
 		panic(fmt.Sprintf("no such daemon component: %s", dt))
 	}
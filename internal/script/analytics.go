@@ -0,0 +1,68 @@
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/itering/subscan/internal/service"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/analytics"
+	"github.com/itering/subscan/util"
+)
+
+// analyticsRanges split [from, to] to batches, batch never cross split table
+func analyticsRanges(from, to, batch uint) (ranges [][2]uint) {
+	if batch == 0 {
+		batch = 1
+	}
+	for start := from; start <= to; {
+		end := start + batch - 1
+		if tableEnd := model.TableBlockNum(model.TableIndex(start)+1) - 1; !model.NativePartition && end > tableEnd {
+			end = tableEnd
+		}
+		if end > to {
+			end = to
+		}
+		ranges = append(ranges, [2]uint{start, end})
+		start = end + 1
+	}
+	return
+}
+
+// AnalyticsBackfill push indexed data of block range [from, to] to clickhouse, to 0 means latest finalized block
+func AnalyticsBackfill(from, to, batch uint, sources []string) error {
+	srv := service.New()
+	defer srv.Close()
+	if !analytics.Enabled() {
+		return analytics.ErrDisabled
+	}
+	ctx := context.TODO()
+	if to == 0 {
+		finalized, err := srv.GetDao().GetFillFinalizedBlockNum(ctx)
+		if err != nil {
+			return err
+		}
+		to = uint(finalized)
+	}
+	if from > to {
+		return errors.New("invalid block range")
+	}
+	if len(sources) == 0 {
+		sources = analytics.Backfills()
+	}
+	for _, source := range sources {
+		if !util.StringInSlice(source, analytics.Backfills()) {
+			return fmt.Errorf("unknown analytics backfill source %s", source)
+		}
+	}
+	for _, r := range analyticsRanges(from, to, batch) {
+		for _, source := range sources {
+			if err := analytics.Instant.Backfill(ctx, source, r[0], r[1]); err != nil {
+				return fmt.Errorf("backfill %s %d-%d: %v", source, r[0], r[1], err)
+			}
+		}
+		util.Logger().Info(fmt.Sprintf("analytics backfill %d-%d done", r[0], r[1]))
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/internal/dao"
 	"github.com/itering/subscan/internal/service"
 	"github.com/itering/subscan/model"
 	"github.com/itering/subscan/plugins"
@@ -104,8 +106,8 @@ func CheckCompleteness(startBlock uint, fastMode bool) {
 			break
 		}
 		endBlockNum := latestBlockNum + 3000
-		if endBlockNum/model.SplitTableBlockNum != (latestBlockNum+1)/model.SplitTableBlockNum {
-			endBlockNum = (endBlockNum/model.SplitTableBlockNum)*model.SplitTableBlockNum - 1
+		if model.TableIndex(endBlockNum) != model.TableIndex(latestBlockNum+1) {
+			endBlockNum = model.TableBlockNum(model.TableIndex(endBlockNum)) - 1
 		}
 		if endBlockNum > uint(latest)-holdOnNum {
 			endBlockNum = uint(latest) - holdOnNum
@@ -126,6 +128,19 @@ func CheckCompleteness(startBlock uint, fastMode bool) {
 	}
 }
 
+// MigratePartition convert split tables to native range partitioned tables
+// stop subscribe and worker before migrating, then set database.partition.mode to native
+func MigratePartition(batch uint, dropLegacy bool) error {
+	// dao in native mode skip migration of split tables and their indexes, they are converted below
+	partition := configs.Partition{Mode: model.PartitionModeNative}
+	if configs.Boot.Database.Partition != nil {
+		partition.Size = configs.Boot.Database.Partition.Size
+	}
+	d, _, _ := dao.New(&partition)
+	defer d.Close()
+	return d.MigratePartition(context.TODO(), batch, dropLegacy)
+}
+
 func RefreshMetadata() {
 	ctx := context.TODO()
 	srv := service.New()
@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"github.com/itering/scale.go/types"
+	v1 "github.com/itering/subscan/api/scan/v1"
+	"github.com/itering/subscan/internal/service"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+)
+
+func toPagination(page service.CursorPage) *v1.Pagination {
+	p := &v1.Pagination{HasNextPage: page.HasNextPage, HasPreviousPage: page.HasPreviousPage}
+	if page.StartCursor != nil {
+		start := uint64(*page.StartCursor)
+		p.StartCursor = &start
+	}
+	if page.EndCursor != nil {
+		end := uint64(*page.EndCursor)
+		p.EndCursor = &end
+	}
+	return p
+}
+
+func blockToProto(b *model.ChainBlockJson) *v1.Block {
+	return &v1.Block{
+		BlockNum:        uint64(b.BlockNum),
+		BlockTimestamp:  int64(b.BlockTimestamp),
+		Hash:            b.Hash,
+		ParentHash:      b.ParentHash,
+		StateRoot:       b.StateRoot,
+		ExtrinsicsRoot:  b.ExtrinsicsRoot,
+		EventCount:      int32(b.EventCount),
+		ExtrinsicsCount: int32(b.ExtrinsicsCount),
+		SpecVersion:     int32(b.SpecVersion),
+		Validator:       b.Validator,
+		Finalized:       b.Finalized,
+	}
+}
+
+func sampleBlockToProto(b *model.SampleBlockJson) *v1.Block {
+	return &v1.Block{
+		BlockNum:        uint64(b.BlockNum),
+		BlockTimestamp:  int64(b.BlockTimestamp),
+		Hash:            b.Hash,
+		EventCount:      int32(b.EventCount),
+		ExtrinsicsCount: int32(b.ExtrinsicsCount),
+		Validator:       b.Validator,
+		Finalized:       b.Finalized,
+	}
+}
+
+func extrinsicToProto(e *model.ChainExtrinsicJson) *v1.Extrinsic {
+	return &v1.Extrinsic{
+		Id:                 uint64(e.Id),
+		BlockTimestamp:     int64(e.BlockTimestamp),
+		BlockNum:           uint64(e.BlockNum),
+		ExtrinsicIndex:     e.ExtrinsicIndex,
+		CallModule:         e.CallModule,
+		CallModuleFunction: e.CallModuleFunction,
+		Params:             util.ToString(e.Params),
+		AccountId:          e.AccountId,
+		Signature:          e.Signature,
+		Nonce:              int32(e.Nonce),
+		ExtrinsicHash:      e.ExtrinsicHash,
+		Success:            e.Success,
+		Fee:                e.Fee.String(),
+	}
+}
+
+func extrinsicDetailToProto(d *model.ExtrinsicDetail) *v1.ExtrinsicDetail {
+	detail := &v1.ExtrinsicDetail{
+		Extrinsic: &v1.Extrinsic{
+			BlockTimestamp:     int64(d.BlockTimestamp),
+			BlockNum:           uint64(d.BlockNum),
+			ExtrinsicIndex:     d.ExtrinsicIndex,
+			CallModule:         d.CallModule,
+			CallModuleFunction: d.CallModuleFunction,
+			Params:             util.ToString(d.Params),
+			AccountId:          d.AccountId,
+			Signature:          d.Signature,
+			Nonce:              int32(d.Nonce),
+			ExtrinsicHash:      d.ExtrinsicHash,
+			Success:            d.Success,
+			Fee:                d.Fee.String(),
+		},
+		Finalized: d.Finalized,
+	}
+	if d.Lifetime != nil {
+		detail.LifetimeBirth, detail.LifetimeDeath = d.Lifetime.Birth, d.Lifetime.Death
+	}
+	for _, e := range d.Errors {
+		detail.Errors = append(detail.Errors, &v1.ExtrinsicError{CallPath: e.CallPath, Source: e.Source, Module: e.Module, Name: e.Name, Doc: e.Doc})
+	}
+	if d.Calls != nil {
+		detail.Calls = util.ToString(d.Calls)
+	}
+	return detail
+}
+
+func eventToProto(e *model.ChainEventJson) *v1.Event {
+	return &v1.Event{
+		Id:             uint64(e.Id),
+		EventIndex:     e.EventIndex,
+		ExtrinsicIndex: e.ExtrinsicIndex,
+		BlockNum:       uint64(e.BlockNum),
+		ModuleId:       e.ModuleId,
+		EventId:        e.EventId,
+		Params:         util.ToString(e.Params),
+		EventIdx:       uint32(e.EventIdx),
+		BlockTimestamp: int64(e.BlockTimestamp),
+		Phase:          int32(e.Phase),
+	}
+}
+
+func runtimeModuleToProto(m *types.MetadataModules) *v1.RuntimeModule {
+	module := &v1.RuntimeModule{Name: m.Name, Prefix: m.Prefix, Index: int32(m.Index)}
+	for _, item := range m.Storage {
+		module.Storage = append(module.Storage, storageToProto(&item))
+	}
+	for _, call := range m.Calls {
+		c := &v1.RuntimeCall{Lookup: call.Lookup, Name: call.Name, Docs: call.Docs}
+		for _, arg := range call.Args {
+			c.Args = append(c.Args, &v1.RuntimeCallArg{Name: arg.Name, Type: arg.Type, TypeName: arg.TypeName})
+		}
+		module.Calls = append(module.Calls, c)
+	}
+	for _, event := range m.Events {
+		module.Events = append(module.Events, &v1.RuntimeEvent{
+			Lookup:       event.Lookup,
+			Name:         event.Name,
+			Args:         event.Args,
+			ArgsName:     event.ArgsName,
+			ArgsTypeName: event.ArgsTypeName,
+			Docs:         event.Docs,
+		})
+	}
+	for _, constant := range m.Constants {
+		module.Constants = append(module.Constants, &v1.RuntimeConstant{Name: constant.Name, Type: constant.Type, Value: constant.ConstantsValue, Docs: constant.Docs})
+	}
+	for _, e := range m.Errors {
+		module.Errors = append(module.Errors, &v1.RuntimeError{Name: e.Name, Docs: e.Doc})
+	}
+	return module
+}
+
+// storageToProto keys, hashers and value of plain, map, double map and n map storage
+func storageToProto(item *types.MetadataStorage) *v1.RuntimeStorage {
+	storage := &v1.RuntimeStorage{Name: item.Name, Modifier: item.Modifier, Origin: item.Type.Origin, Fallback: item.Fallback, Docs: item.Docs}
+	switch {
+	case item.Type.PlainType != nil:
+		storage.Value = *item.Type.PlainType
+	case item.Type.MapType != nil:
+		storage.Keys, storage.Hashers, storage.Value = []string{item.Type.MapType.Key}, []string{item.Type.MapType.Hasher}, item.Type.MapType.Value
+	case item.Type.DoubleMapType != nil:
+		double := item.Type.DoubleMapType
+		storage.Keys, storage.Hashers, storage.Value = []string{double.Key, double.Key2}, []string{double.Hasher, double.Key2Hasher}, double.Value
+	case item.Type.NMapType != nil:
+		storage.Keys, storage.Hashers, storage.Value = item.Type.NMapType.KeyVec, item.Type.NMapType.Hashers, item.Type.NMapType.Value
+	}
+	return storage
+}
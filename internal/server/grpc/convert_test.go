@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/itering/scale.go/types"
+	"github.com/itering/subscan/internal/service"
+	"github.com/itering/subscan/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToPagination(t *testing.T) {
+	start, end := uint(10), uint(1)
+	p := toPagination(service.CursorPage{StartCursor: &start, EndCursor: &end, HasNextPage: true})
+	assert.Equal(t, uint64(10), p.GetStartCursor())
+	assert.Equal(t, uint64(1), p.GetEndCursor())
+	assert.True(t, p.HasNextPage)
+	assert.False(t, p.HasPreviousPage)
+
+	p = toPagination(service.CursorPage{})
+	assert.Nil(t, p.StartCursor)
+	assert.Nil(t, p.EndCursor)
+}
+
+func TestEventToProto(t *testing.T) {
+	e := eventToProto(&model.ChainEventJson{
+		Id:             9469800001,
+		EventIndex:     "94698-1",
+		ExtrinsicIndex: "94698-1",
+		BlockNum:       94698,
+		ModuleId:       "balances",
+		EventId:        "Transfer",
+		Params:         model.EventParams{{Type: "AccountId", Value: "0x1"}},
+		EventIdx:       1,
+	})
+	assert.Equal(t, uint64(94698), e.BlockNum)
+	assert.Equal(t, `[{"type":"AccountId","value":"0x1"}]`, e.Params)
+}
+
+func TestExtrinsicDetailToProto(t *testing.T) {
+	d := extrinsicDetailToProto(&model.ExtrinsicDetail{
+		BlockNum:       1,
+		ExtrinsicIndex: "1-1",
+		Fee:            decimal.New(15, 9),
+		Lifetime:       &model.Lifetime{Birth: 1, Death: 65},
+		Errors:         []model.ExtrinsicErrorJson{{CallPath: "0", Module: "Balances", Name: "InsufficientBalance"}},
+	})
+	assert.Equal(t, "15000000000", d.Extrinsic.Fee)
+	assert.Equal(t, uint64(65), d.LifetimeDeath)
+	assert.Equal(t, "InsufficientBalance", d.Errors[0].Name)
+	assert.Empty(t, d.Calls)
+}
+
+func TestRuntimeModuleToProto(t *testing.T) {
+	plain := "u32"
+	m := runtimeModuleToProto(&types.MetadataModules{
+		Name:   "Balances",
+		Prefix: "Balances",
+		Index:  5,
+		Storage: []types.MetadataStorage{
+			{Name: "TotalIssuance", Type: types.StorageType{Origin: "PlainType", PlainType: &plain}},
+			{Name: "Locks", Type: types.StorageType{Origin: "Map", MapType: &types.MapType{Key: "AccountId", Hasher: "Blake2_128Concat", Value: "Vec<BalanceLock>"}}},
+		},
+		Calls:     []types.MetadataCalls{{Name: "transfer", Args: []types.MetadataModuleCallArgument{{Name: "dest", Type: "Address"}}}},
+		Events:    []types.MetadataEvents{{Name: "Transfer", Args: []string{"AccountId", "AccountId", "Balance"}}},
+		Constants: []types.MetadataConstants{{Name: "ExistentialDeposit", Type: "Balance", ConstantsValue: "0x01"}},
+		Errors:    []types.MetadataModuleError{{Name: "InsufficientBalance", Doc: []string{"Balance too low"}}},
+	})
+	assert.Equal(t, int32(5), m.Index)
+	assert.Equal(t, "u32", m.Storage[0].Value)
+	assert.Empty(t, m.Storage[0].Keys)
+	assert.Equal(t, []string{"AccountId"}, m.Storage[1].Keys)
+	assert.Equal(t, "Vec<BalanceLock>", m.Storage[1].Value)
+	assert.Equal(t, "dest", m.Calls[0].Args[0].Name)
+	assert.Len(t, m.Events[0].Args, 3)
+	assert.Equal(t, "0x01", m.Constants[0].Value)
+	assert.Equal(t, []string{"Balance too low"}, m.Errors[0].Docs)
+}
+
+func TestCheckRow(t *testing.T) {
+	assert.NoError(t, checkRow(100))
+	assert.Equal(t, codes.InvalidArgument, status.Code(checkRow(0)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(checkRow(101)))
+}
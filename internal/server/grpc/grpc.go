@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	v1 "github.com/itering/subscan/api/scan/v1"
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/internal/service"
+	"github.com/itering/subscan/util"
+)
+
+// NewGRPCServer new a gRPC server, return nil if grpc addr not configured
+func NewGRPCServer(c *configs.Server, s *service.Service) *grpc.Server {
+	if c.Grpc == nil || c.Grpc.Addr == "" {
+		return nil
+	}
+	opts := []grpc.ServerOption{grpc.Address(c.Grpc.Addr)}
+	if c.Grpc.Network != "" {
+		opts = append(opts, grpc.Network(c.Grpc.Network))
+	}
+	// unary call timeout, stream not limited
+	if c.Grpc.Timeout != "" {
+		if timeout, err := time.ParseDuration(c.Grpc.Timeout); err != nil {
+			util.Logger().Error(fmt.Errorf("invalid grpc timeout %q, default timeout is used: %v", c.Grpc.Timeout, err))
+		} else {
+			opts = append(opts, grpc.Timeout(timeout))
+		}
+	}
+	// api key and rate limit of http api
+	limit := newRateLimit(s)
+	opts = append(opts, grpc.UnaryInterceptor(limit.unary()), grpc.StreamInterceptor(limit.stream()))
+	srv := grpc.NewServer(opts...)
+	v1.RegisterScanServer(srv, &scanServer{svc: s, pollInterval: 3 * time.Second})
+	return srv
+}
+
+type scanServer struct {
+	v1.UnimplementedScanServer
+	svc          *service.Service
+	pollInterval time.Duration
+}
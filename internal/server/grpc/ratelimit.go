@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyMetadata metadata key of api key, same as header X-API-Key of http api
+const apiKeyMetadata = "x-api-key"
+
+// rateLimiter take a token of api key or client ip, same limiter as http api
+type rateLimiter interface {
+	Allow(ctx context.Context, apiKey, clientIp, route string) (*model.RateLimitState, error)
+}
+
+// rateLimit check api key and take a token of every unary call and every stream when it is opened,
+// route is the full method name, rate limit state is replied in header metadata x-ratelimit-*
+type rateLimit struct {
+	limiter rateLimiter
+	enabled bool
+}
+
+func newRateLimit(limiter rateLimiter) *rateLimit {
+	return &rateLimit{limiter: limiter, enabled: util.GetEnv("API_RATE_LIMIT", "true") != "false"}
+}
+
+func (r *rateLimit) unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		header, err := r.allow(ctx, info.FullMethod)
+		if header != nil {
+			_ = grpc.SetHeader(ctx, header)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (r *rateLimit) stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		header, err := r.allow(ss.Context(), info.FullMethod)
+		if header != nil {
+			_ = ss.SetHeader(header)
+		}
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (r *rateLimit) allow(ctx context.Context, method string) (metadata.MD, error) {
+	if !r.enabled || r.limiter == nil {
+		return nil, nil
+	}
+	var apiKey string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(apiKeyMetadata); len(values) > 0 {
+			apiKey = values[0]
+		}
+	}
+	state, err := r.limiter.Allow(ctx, apiKey, clientIp(ctx), method)
+	if err != nil {
+		// unknown or revoked api key
+		return nil, status.Error(codes.Unauthenticated, util.Unauthorized.Message())
+	}
+	header := metadata.Pairs(
+		"x-ratelimit-limit", strconv.Itoa(state.Limit),
+		"x-ratelimit-remaining", strconv.Itoa(state.Remaining),
+		"x-ratelimit-reset", strconv.Itoa(state.Reset),
+	)
+	if state.QuotaLimit > 0 {
+		header.Set("x-ratelimit-quota-limit", strconv.FormatInt(state.QuotaLimit, 10))
+		header.Set("x-ratelimit-quota-remaining", strconv.FormatInt(state.QuotaRemaining, 10))
+	}
+	if !state.Allowed {
+		header.Set("retry-after", strconv.Itoa(state.RetryAfter))
+		return header, status.Error(codes.ResourceExhausted, util.TooManyRequests.Message())
+	}
+	return header, nil
+}
+
+// clientIp ip of peer address
+func clientIp(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/itering/subscan/model"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type fakeLimiter struct {
+	apiKey, clientIp, route string
+}
+
+func (f *fakeLimiter) Allow(_ context.Context, apiKey, clientIp, route string) (*model.RateLimitState, error) {
+	f.apiKey, f.clientIp, f.route = apiKey, clientIp, route
+	switch apiKey {
+	case "revoked":
+		return nil, errors.New("unknown api key")
+	case "limited":
+		return &model.RateLimitState{Limit: 10, RetryAfter: 1}, nil
+	}
+	return &model.RateLimitState{Allowed: true, Limit: 10, Remaining: 9}, nil
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := &fakeLimiter{}
+	interceptor := (&rateLimit{limiter: limiter, enabled: true}).unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/subscan.scan.v1.Scan/ListBlocks"}
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(apiKey string) (interface{}, error) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.5"), Port: 5000}})
+		if apiKey != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(apiKeyMetadata, apiKey))
+		}
+		return interceptor(ctx, nil, info, handler)
+	}
+
+	reply, err := call("")
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", reply)
+	assert.Equal(t, "127.0.0.5", limiter.clientIp)
+	assert.Equal(t, info.FullMethod, limiter.route)
+
+	_, err = call("key")
+	assert.NoError(t, err)
+	assert.Equal(t, "key", limiter.apiKey)
+
+	_, err = call("revoked")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call("limited")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// disabled
+	interceptor = (&rateLimit{limiter: limiter}).unary()
+	_, err = call("revoked")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+
+	v1 "github.com/itering/subscan/api/scan/v1"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/address"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errInvalidRow   = status.Error(codes.InvalidArgument, "row must be between 1 and 100")
+	errNotFound     = status.Error(codes.NotFound, util.RecordNotFound.Error())
+	errInvalidIndex = status.Error(codes.InvalidArgument, util.ParamsError.Error())
+)
+
+func checkRow(row int32) error {
+	if row < 1 || row > 100 {
+		return errInvalidRow
+	}
+	return nil
+}
+
+func (s *scanServer) GetMetadata(ctx context.Context, _ *v1.GetMetadataRequest) (*v1.GetMetadataReply, error) {
+	m, err := s.svc.Metadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	reply := &v1.GetMetadataReply{Metadata: make(map[string]string, len(m))}
+	for k, v := range m {
+		reply.Metadata[k] = util.ToString(v)
+	}
+	return reply, nil
+}
+
+func (s *scanServer) ListBlocks(ctx context.Context, req *v1.ListBlocksRequest) (*v1.ListBlocksReply, error) {
+	if err := checkRow(req.Row); err != nil {
+		return nil, err
+	}
+	list, page := s.svc.GetBlocksSampleCursor(ctx, int(req.Row), uint(req.Before), uint(req.After))
+	reply := &v1.ListBlocksReply{Pagination: toPagination(page)}
+	for i := range list {
+		reply.Blocks = append(reply.Blocks, sampleBlockToProto(&list[i]))
+	}
+	return reply, nil
+}
+
+func (s *scanServer) GetBlock(ctx context.Context, req *v1.GetBlockRequest) (*v1.Block, error) {
+	var block *model.ChainBlockJson
+	if req.BlockHash == "" {
+		block = s.svc.GetBlockByNum(ctx, uint(req.BlockNum))
+	} else {
+		block = s.svc.GetBlockByHashJson(ctx, req.BlockHash)
+	}
+	if block == nil {
+		return nil, errNotFound
+	}
+	return blockToProto(block), nil
+}
+
+func (s *scanServer) ListExtrinsics(ctx context.Context, req *v1.ListExtrinsicsRequest) (*v1.ListExtrinsicsReply, error) {
+	if err := checkRow(req.Row); err != nil {
+		return nil, err
+	}
+	var query []model.Option
+	var fixedTableIndex = -1
+	if req.Module != "" || req.Call != "" {
+		query = append(query, model.WithCall(req.Module, req.Call))
+	}
+	if req.Signed {
+		query = append(query, model.Where("is_signed = ?", true))
+	}
+	if req.BlockNum > 0 {
+		query = append(query, model.Where("block_num = ?", req.BlockNum))
+		fixedTableIndex = int(model.TableIndex(uint(req.BlockNum)))
+	}
+	var accountId string
+	if req.Address != "" {
+		if accountId = address.Decode(req.Address); accountId == "" {
+			return nil, status.Error(codes.InvalidArgument, util.InvalidAccountAddress.Error())
+		}
+		query = append(query, model.Where("account_id = ? and is_signed = ?", accountId, true))
+	}
+	if req.HiddenParams {
+		query = append(query, model.Omit("params", "params_raw_bytes"))
+	}
+	list, page := s.svc.GetExtrinsicList(ctx, int(req.Row), fixedTableIndex, uint(req.Before), uint(req.After), accountId, query...)
+	reply := &v1.ListExtrinsicsReply{Pagination: toPagination(page)}
+	for _, extrinsic := range list {
+		reply.Extrinsics = append(reply.Extrinsics, extrinsicToProto(extrinsic))
+	}
+	return reply, nil
+}
+
+func (s *scanServer) GetExtrinsic(ctx context.Context, req *v1.GetExtrinsicRequest) (*v1.ExtrinsicDetail, error) {
+	var detail *model.ExtrinsicDetail
+	switch {
+	case req.ExtrinsicIndex != "":
+		detail = s.svc.GetExtrinsicByIndex(ctx, req.ExtrinsicIndex)
+	case req.Hash != "":
+		detail = s.svc.GetExtrinsicDetailByHash(ctx, req.Hash)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "extrinsic_index or hash is required")
+	}
+	if detail == nil {
+		return nil, errNotFound
+	}
+	return extrinsicDetailToProto(detail), nil
+}
+
+func (s *scanServer) ListEvents(ctx context.Context, req *v1.ListEventsRequest) (*v1.ListEventsReply, error) {
+	if err := checkRow(req.Row); err != nil {
+		return nil, err
+	}
+	var query []model.Option
+	var fixedTableIndex = -1
+	if req.Module != "" {
+		query = append(query, model.Where("module_id = ?", req.Module))
+	}
+	if req.Event != "" {
+		query = append(query, model.Where("event_id = ?", req.Event))
+	}
+	if req.BlockNum > 0 {
+		query = append(query, model.Where("block_num = ?", req.BlockNum))
+		fixedTableIndex = int(model.TableIndex(uint(req.BlockNum)))
+	}
+	if req.ExtrinsicIndex != "" {
+		parseExtrinsic := model.ParseExtrinsicOrEventIndex(req.ExtrinsicIndex)
+		if parseExtrinsic == nil {
+			return nil, errInvalidIndex
+		}
+		query = append(query, model.Where("extrinsic_index = ?", req.ExtrinsicIndex))
+		fixedTableIndex = int(model.TableIndex(parseExtrinsic.BlockNum))
+	}
+	if req.HiddenParams {
+		query = append(query, model.Omit("params", "params_raw_bytes"))
+	}
+	list, page := s.svc.EventsList(ctx, int(req.Row), fixedTableIndex, uint(req.Before), uint(req.After), query...)
+	reply := &v1.ListEventsReply{Pagination: toPagination(page)}
+	for i := range list {
+		reply.Events = append(reply.Events, eventToProto(&list[i]))
+	}
+	return reply, nil
+}
+
+func (s *scanServer) GetEvent(ctx context.Context, req *v1.GetEventRequest) (*v1.Event, error) {
+	if model.ParseExtrinsicOrEventIndex(req.EventIndex) == nil {
+		return nil, errInvalidIndex
+	}
+	event := s.svc.EventById(ctx, req.EventIndex)
+	if event == nil {
+		return nil, errNotFound
+	}
+	return eventToProto(event), nil
+}
+
+func (s *scanServer) ListLogs(ctx context.Context, req *v1.ListLogsRequest) (*v1.ListLogsReply, error) {
+	reply := &v1.ListLogsReply{}
+	for _, log := range s.svc.LogsList(ctx, uint(req.BlockNum)) {
+		reply.Logs = append(reply.Logs, &v1.Log{BlockNum: uint64(log.BlockNum), LogIndex: log.LogIndex, LogType: log.LogType, Data: log.Data})
+	}
+	return reply, nil
+}
+
+func (s *scanServer) ListRuntimes(context.Context, *v1.ListRuntimesRequest) (*v1.ListRuntimesReply, error) {
+	reply := &v1.ListRuntimesReply{}
+	for _, runtime := range s.svc.SubstrateRuntimeList() {
+		reply.List = append(reply.List, &v1.Runtime{SpecVersion: int32(runtime.SpecVersion), Modules: runtime.Modules, BlockNum: uint64(runtime.BlockNum)})
+	}
+	return reply, nil
+}
+
+func (s *scanServer) GetRuntimeMetadata(_ context.Context, req *v1.GetRuntimeMetadataRequest) (*v1.GetRuntimeMetadataReply, error) {
+	info := s.svc.SubstrateRuntimeInfo(int(req.Spec))
+	if info == nil {
+		return nil, errNotFound
+	}
+	reply := &v1.GetRuntimeMetadataReply{}
+	for i := range info.Metadata.Modules {
+		reply.Modules = append(reply.Modules, runtimeModuleToProto(&info.Metadata.Modules[i]))
+	}
+	return reply, nil
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/itering/subscan/api/scan/v1"
+	"github.com/itering/subscan/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxStreamReplayBlocks blocks before the latest finalized block a stream can start from,
+// streams take one token when opened, so replay of the whole chain is not allowed
+const maxStreamReplayBlocks = 14400
+
+// streamStart first block of stream, the latest finalized block if from is nil
+func streamStart(from *uint64, finalized uint64) (uint, error) {
+	if from == nil {
+		return uint(finalized), nil
+	}
+	if finalized > maxStreamReplayBlocks && *from < finalized-maxStreamReplayBlocks {
+		return 0, status.Error(codes.InvalidArgument, fmt.Sprintf("from_block must be within %d blocks before the latest finalized block %d", maxStreamReplayBlocks, finalized))
+	}
+	return uint(*from), nil
+}
+
+// followFinalized poll indexed finalized block num, call fn with every new block in order until ctx done or fn error
+// block is retried at next poll if fn return false, start with the latest finalized block if from is nil
+func (s *scanServer) followFinalized(ctx context.Context, from *uint64, fn func(blockNum uint) (bool, error)) error {
+	finalized, err := s.svc.GetFillFinalizedBlockNum(ctx)
+	if err != nil {
+		return err
+	}
+	next, err := streamStart(from, uint64(finalized))
+	if err != nil {
+		return err
+	}
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for {
+		if finalized, err := s.svc.GetFillFinalizedBlockNum(ctx); err == nil {
+			for ; next <= uint(finalized); next++ {
+				ok, err := fn(next)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *scanServer) SubscribeFinalizedBlocks(req *v1.SubscribeBlocksRequest, stream v1.Scan_SubscribeFinalizedBlocksServer) error {
+	ctx := stream.Context()
+	return s.followFinalized(ctx, req.FromBlock, func(blockNum uint) (bool, error) {
+		block := s.svc.GetBlockByNum(ctx, blockNum)
+		if block == nil {
+			return false, nil
+		}
+		return true, stream.Send(blockToProto(block))
+	})
+}
+
+func (s *scanServer) SubscribeEvents(req *v1.SubscribeEventsRequest, stream v1.Scan_SubscribeEventsServer) error {
+	ctx := stream.Context()
+	var where []model.Option
+	if req.Module != "" {
+		where = append(where, model.Where("module_id = ?", req.Module))
+	}
+	if req.Event != "" {
+		where = append(where, model.Where("event_id = ?", req.Event))
+	}
+	return s.followFinalized(ctx, req.FromBlock, func(blockNum uint) (bool, error) {
+		for _, event := range s.svc.BlockEvents(ctx, blockNum, where...) {
+			if err := stream.Send(eventToProto(&event)); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamStart(t *testing.T) {
+	from := func(n uint64) *uint64 { return &n }
+	start, err := streamStart(nil, 20000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(20000), start)
+
+	start, err = streamStart(from(20000-maxStreamReplayBlocks), 20000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(20000-maxStreamReplayBlocks), start)
+
+	// from block ahead of finalized block is waited
+	start, err = streamStart(from(30000), 20000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(30000), start)
+
+	_, err = streamStart(from(1), 20000)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// chain shorter than replay window
+	start, err = streamStart(from(0), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), start)
+}
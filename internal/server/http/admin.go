@@ -0,0 +1,174 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/itering/subscan/util"
+)
+
+// mqQueuesHandle handler worker queues status
+// @Summary Worker queues depth and dead letters count
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Success 200 {object} http.J{data=[]model.MQQueue}
+// @Router /api/admin/mq/queues [post]
+func mqQueuesHandle(c *gin.Context) {
+	list, err := svc.MQQueues(c.Request.Context())
+	toJson(c, list, err)
+}
+
+type deadLettersParams struct {
+	Queue string `json:"queue" binding:"required"`
+	Row   int    `json:"row" binding:"min=1,max=100"`
+	Page  int    `json:"page" binding:"min=0"`
+}
+
+// deadLettersHandle handler dead letters of queue
+// @Summary Dead letters of queue, jobs exceeded max retry attempts
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param params body deadLettersParams true "params"
+// @Success 200 {object} http.J{data=object{list=[]mq.Message,count=int}}
+// @Router /api/admin/mq/dead_letters [post]
+func deadLettersHandle(c *gin.Context) {
+	p := new(deadLettersParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	list, count, err := svc.DeadLetters(c.Request.Context(), p.Queue, p.Page, p.Row)
+	toJson(c, map[string]interface{}{"list": list, "count": count}, err)
+}
+
+type deadLettersOpParams struct {
+	Queue string   `json:"queue" binding:"required"`
+	Ids   []string `json:"ids"`
+}
+
+// replayDeadLettersHandle handler replay dead letters
+// @Summary Publish dead letters to queue again, all dead letters of queue replayed if ids empty
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param params body deadLettersOpParams true "params"
+// @Success 200 {object} http.J{data=object{replayed=int}}
+// @Router /api/admin/mq/dead_letters/replay [post]
+func replayDeadLettersHandle(c *gin.Context) {
+	p := new(deadLettersOpParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	replayed, err := svc.ReplayDeadLetters(c.Request.Context(), p.Queue, p.Ids)
+	toJson(c, map[string]int{"replayed": replayed}, err)
+}
+
+// deleteDeadLettersHandle handler delete dead letters
+// @Summary Delete dead letters
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param params body deadLettersOpParams true "params"
+// @Success 200 {object} http.J
+// @Router /api/admin/mq/dead_letters/delete [post]
+func deleteDeadLettersHandle(c *gin.Context) {
+	p := new(deadLettersOpParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	if len(p.Ids) == 0 {
+		toJson(c, nil, util.ParamsError)
+		return
+	}
+	toJson(c, nil, svc.DeleteDeadLetters(c.Request.Context(), p.Queue, p.Ids))
+}
+
+// apiKeysHandle handler api keys and tiers
+// @Summary Issued api keys and rate limit of tiers
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Success 200 {object} http.J{data=object{list=[]model.ApiKey,tiers=map[string]model.ApiKeyTier}}
+// @Router /api/admin/api_keys [post]
+func apiKeysHandle(c *gin.Context) {
+	toJson(c, map[string]interface{}{"list": svc.ApiKeyList(c.Request.Context()), "tiers": svc.ApiKeyTiers()}, nil)
+}
+
+type createApiKeyParams struct {
+	Name string `json:"name" binding:"required,max=100"`
+	Tier string `json:"tier" binding:"required"`
+}
+
+// createApiKeyHandle handler issue api key
+// @Summary Issue api key of tier, the key is only returned once
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param params body createApiKeyParams true "params"
+// @Success 200 {object} http.J{data=object{key=string,api_key=model.ApiKey}}
+// @Router /api/admin/api_keys/create [post]
+func createApiKeyHandle(c *gin.Context) {
+	p := new(createApiKeyParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	key, record, err := svc.CreateApiKey(c.Request.Context(), p.Name, p.Tier)
+	if err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	toJson(c, map[string]interface{}{"key": key, "api_key": record}, nil)
+}
+
+type apiKeyParams struct {
+	Id uint `json:"id" binding:"required"`
+}
+
+// revokeApiKeyHandle handler revoke api key
+// @Summary Revoke api key
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param params body apiKeyParams true "params"
+// @Success 200 {object} http.J
+// @Router /api/admin/api_keys/revoke [post]
+func revokeApiKeyHandle(c *gin.Context) {
+	p := new(apiKeyParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	toJson(c, nil, svc.RevokeApiKey(c.Request.Context(), p.Id))
+}
+
+type apiKeyUsageParams struct {
+	Id   uint `json:"id" binding:"required"`
+	Days int  `json:"days" binding:"omitempty,min=1,max=31"`
+}
+
+// apiKeyUsageHandle handler daily usage of api key
+// @Summary Daily request count of api key, latest first
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param params body apiKeyUsageParams true "params"
+// @Success 200 {object} http.J{data=object{list=[]model.ApiKeyUsage}}
+// @Router /api/admin/api_keys/usage [post]
+func apiKeyUsageHandle(c *gin.Context) {
+	p := new(apiKeyUsageParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	toJson(c, map[string]interface{}{"list": svc.ApiKeyUsage(c.Request.Context(), p.Id, p.Days)}, nil)
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/gin-gonic/gin"
+	"github.com/itering/subscan/model"
 )
 
 func ping(ctx *gin.Context) {
@@ -23,6 +24,23 @@ func now(c *gin.Context) {
 func livenessProbe(c *gin.Context) {
 	c.String(http.StatusOK, "OK")
 }
+
+// readinessProbe fail when db/redis down or indexer lag exceeds threshold, checks are cached for a few seconds
 func readinessProbe(c *gin.Context) {
+	if h := svc.Readiness(c); h.Status == model.HealthDown {
+		c.String(http.StatusServiceUnavailable, h.Status)
+		return
+	}
 	c.String(http.StatusOK, "OK")
 }
+
+// health status of every component, 503 when service is down,
+// public route shares the cached result of readiness so requests never run checks more often than probes
+func health(c *gin.Context) {
+	h := svc.Readiness(c)
+	code := http.StatusOK
+	if h.Status == model.HealthDown {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, h)
+}
@@ -33,6 +33,11 @@ func NewHTTPServer(c *configs.Server, s *service.Service) *http.Server {
 	engine := http.NewServer(opts...)
 
 	e := gin.New()
+	// client ip of rate limit, X-Forwarded-For is only trusted from configured proxies
+	e.TrustedPlatform = c.Http.TrustedPlatform
+	if err := e.SetTrustedProxies(c.Http.TrustedProxies); err != nil {
+		panic(err)
+	}
 	e.Use(gin.Recovery())
 	defer engine.HandlePrefix("/", e)
 	initRouter(e)
@@ -46,9 +51,10 @@ func initRouter(e *gin.Engine) {
 	e.GET("ping", ping)
 	e.GET("healthz", livenessProbe)
 	e.GET("readiness", readinessProbe)
+	e.GET("health", health)
 	customValidator.RegisterCustomValidator()
 	// internal
-	g := e.Group("/api")
+	g := e.Group("/api", middlewares.RateLimit(svc), middlewares.ResponseCache(svc, configs.Boot.Cache))
 	{
 		g.POST("/now", now)
 		s := g.Group("/scan")
@@ -59,6 +65,12 @@ func initRouter(e *gin.Engine) {
 			// Block
 			s.POST("blocks", blocksHandle)
 			s.POST("block", blockHandle)
+			s.POST("block/weight", blockWeightHandle)
+			s.POST("block/weights", blockWeightsHandle)
+			s.POST("validator/blocks", validatorBlocksHandle)
+			// Epoch
+			s.POST("epochs", epochsHandle)
+			s.POST("epoch", epochHandle)
 
 			// Extrinsic
 			s.POST("extrinsics", extrinsicsHandle)
@@ -75,8 +87,30 @@ func initRouter(e *gin.Engine) {
 			s.POST("runtime/metadata", runtimeMetadataHandle)
 			s.POST("runtime/list", runtimeListHandler)
 
+			// Analytics
+			s.POST("analytics/stats", analyticsStatsHandle)
+
+			// Storage
+			s.POST("storage", storageHandle)
+
+			// Tools
+			s.POST("tools/decode", decodeHandle)
+			s.POST("tools/estimate_fee", estimateFeeHandle)
+
 		}
 		pluginRouter(g)
+
+		a := g.Group("/admin", middlewares.AdminAuth())
+		{
+			a.POST("mq/queues", mqQueuesHandle)
+			a.POST("mq/dead_letters", deadLettersHandle)
+			a.POST("mq/dead_letters/replay", replayDeadLettersHandle)
+			a.POST("mq/dead_letters/delete", deleteDeadLettersHandle)
+			a.POST("api_keys", apiKeysHandle)
+			a.POST("api_keys/create", createApiKeyHandle)
+			a.POST("api_keys/revoke", revokeApiKeyHandle)
+			a.POST("api_keys/usage", apiKeyUsageHandle)
+		}
 	}
 }
 
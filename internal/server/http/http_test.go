@@ -37,6 +37,10 @@ var testCases = []struct {
 	{"/api/scan/metadata", nil, "POST"},
 	{"/api/scan/blocks", strings.NewReader(`{"row": 10, "page": 0}`), "POST"},
 	{"/api/scan/block", strings.NewReader(`{"block_hash": "0xbadc6963e1add4d7a588e350d837579491d08bb270f02c56b3dd5f17018dee0c"}`), "POST"},
+	{"/api/scan/block/weight", strings.NewReader(`{"block_num": 1}`), "POST"},
+	{"/api/scan/validator/blocks", strings.NewReader(`{"epoch": 1}`), "POST"},
+	{"/api/scan/epochs", strings.NewReader(`{"row": 10}`), "POST"},
+	{"/api/scan/epoch", strings.NewReader(`{"epoch": 1}`), "POST"},
 	{"/api/scan/extrinsics", strings.NewReader(`{"row": 10, "page": 0}`), "POST"},
 	{"/api/scan/extrinsic", strings.NewReader(`{"hash": "0xbadc6963e1add4d7a588e350d837579491d08bb270f02c56b3dd5f17018dee0c"}`), "POST"},
 	{"/api/scan/events", strings.NewReader(`{"row": 10, "page": 0}`), "POST"},
@@ -2,7 +2,10 @@ package http
 
 import (
 	"errors"
+	"fmt"
+	"github.com/itering/subscan/internal/service"
 	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/analytics"
 	"github.com/itering/subscan/share/token"
 	"github.com/itering/subscan/util/address"
 
@@ -86,6 +89,127 @@ func blockHandle(c *gin.Context) {
 	}
 }
 
+type blockWeightParams struct {
+	BlockNum uint `json:"block_num" binding:"min=0"`
+}
+
+// @Summary Get block weight and length utilisation
+// @Tags block
+// @Accept json
+// @Produce json
+// @Param params body blockWeightParams true "params"
+// @Success 200 {object} http.J{data=model.ChainBlockWeight}
+// @Router /api/scan/block/weight [post]
+func blockWeightHandle(c *gin.Context) {
+	p := new(blockWeightParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	toJson(c, svc.GetBlockWeight(c.Request.Context(), p.BlockNum), nil)
+}
+
+const (
+	// maxBlockWeightsRange max seconds between start and end of block weight series, 31 days
+	maxBlockWeightsRange = 31 * 86400
+	// maxBlockWeightsBuckets max points of block weight series
+	maxBlockWeightsBuckets = 1000
+)
+
+type blockWeightsParams struct {
+	Start    int `json:"start" binding:"min=0"`
+	End      int `json:"end" binding:"gtfield=Start"`
+	Interval int `json:"interval" binding:"min=6"` // bucket size, seconds
+}
+
+// @Summary Block weight utilisation time series
+// @Tags block
+// @Accept json
+// @Produce json
+// @Param params body blockWeightsParams true "params"
+// @Success 200 {object} http.J{data=object{list=[]model.BlockWeightPoint}}
+// @Router /api/scan/block/weights [post]
+func blockWeightsHandle(c *gin.Context) {
+	p := new(blockWeightsParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	if p.End-p.Start > maxBlockWeightsRange {
+		toJson(c, nil, fmt.Errorf("range of start and end is more than %d seconds", maxBlockWeightsRange))
+		return
+	}
+	if (p.End-p.Start)/p.Interval > maxBlockWeightsBuckets {
+		toJson(c, nil, fmt.Errorf("interval is too small, more than %d points", maxBlockWeightsBuckets))
+		return
+	}
+	list := svc.GetBlockWeightSeries(c.Request.Context(), p.Start, p.End, p.Interval)
+	toJson(c, map[string]interface{}{"list": list}, nil)
+}
+
+type validatorBlocksParams struct {
+	Epoch *uint `json:"epoch" binding:"omitempty,min=0"`
+}
+
+// @Summary Authored and missed block count of each validator, missed slots are counted in deterministic author selection
+// @Tags block
+// @Accept json
+// @Produce json
+// @Param params body validatorBlocksParams true "params"
+// @Success 200 {object} http.J{data=object{list=[]model.ValidatorBlockStat}}
+// @Router /api/scan/validator/blocks [post]
+func validatorBlocksHandle(c *gin.Context) {
+	p := new(validatorBlocksParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	list := svc.GetValidatorBlockStats(c.Request.Context(), p.Epoch)
+	toJson(c, map[string]interface{}{"list": list}, nil)
+}
+
+type epochsParams struct {
+	Limit int   `json:"row" binding:"min=1,max=100"`
+	After *uint `json:"after" binding:"omitempty"` // end_cursor of the previous page
+}
+
+// @Summary Epoch/session list with validator set changes, latest first
+// @Tags epoch
+// @Accept json
+// @Produce json
+// @Param params body epochsParams true "params"
+// @Success 200 {object} http.J{data=object{list=[]model.ChainEpoch,pagination=service.CursorPage}}
+// @Router /api/scan/epochs [post]
+func epochsHandle(c *gin.Context) {
+	p := new(epochsParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	list, pageInfo := svc.GetEpochList(c.Request.Context(), p.Limit, p.After)
+	toJson(c, map[string]interface{}{"list": list, "pagination": pageInfo}, nil)
+}
+
+type epochParams struct {
+	Epoch uint `json:"epoch" binding:"min=0"`
+}
+
+// @Summary Epoch/session details with validator set
+// @Tags epoch
+// @Accept json
+// @Produce json
+// @Param params body epochParams true "params"
+// @Success 200 {object} http.J{data=model.ChainEpoch}
+// @Router /api/scan/epoch [post]
+func epochHandle(c *gin.Context) {
+	p := new(epochParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	toJson(c, svc.GetEpoch(c.Request.Context(), p.Epoch), nil)
+}
+
 type extrinsicsParams struct {
 	Limit        int    `json:"row" binding:"min=1,max=100"`
 	Before       uint   `json:"before" binding:"omitempty"`
@@ -116,11 +240,9 @@ func extrinsicsHandle(c *gin.Context) {
 	var query []model.Option
 	var fixedTableIndex = -1
 
-	if p.Module != "" {
-		query = append(query, model.Where("call_module = ?", p.Module))
-	}
-	if p.Call != "" {
-		query = append(query, model.Where("call_module_function = ?", p.Module))
+	if p.Module != "" || p.Call != "" {
+		// include extrinsics with nested call
+		query = append(query, model.WithCall(p.Module, p.Call))
 	}
 
 	if p.Signed == "signed" {
@@ -128,7 +250,7 @@ func extrinsicsHandle(c *gin.Context) {
 	}
 	if p.BlockNum > 0 {
 		query = append(query, model.Where("block_num = ?", p.BlockNum))
-		fixedTableIndex = int(p.BlockNum / model.SplitTableBlockNum)
+		fixedTableIndex = int(model.TableIndex(p.BlockNum))
 	}
 
 	if p.Address != "" {
@@ -222,7 +344,7 @@ func eventsHandle(c *gin.Context) {
 	}
 	if p.BlockNum > 0 {
 		query = append(query, model.Where("block_num = ?", p.BlockNum))
-		fixedTableIndex = int(p.BlockNum / model.SplitTableBlockNum)
+		fixedTableIndex = int(model.TableIndex(p.BlockNum))
 	}
 	if p.ExtrinsicIndex != "" {
 		query = append(query, model.Where("extrinsic_index = ?", p.ExtrinsicIndex))
@@ -231,7 +353,7 @@ func eventsHandle(c *gin.Context) {
 			toJson(c, nil, util.ParamsError)
 			return
 		}
-		fixedTableIndex = int(parseExtrinsic.BlockNum / model.SplitTableBlockNum)
+		fixedTableIndex = int(model.TableIndex(parseExtrinsic.BlockNum))
 	}
 	if p.HiddenParams {
 		query = append(query, model.Omit("params", "params_raw_bytes"))
@@ -355,3 +477,132 @@ func runtimeMetadataHandle(c *gin.Context) {
 
 	toJson(c, map[string]interface{}{"info": nil}, nil)
 }
+
+type analyticsStatsParams struct {
+	Table      string            `json:"table" binding:"required"`
+	GroupBy    string            `json:"group_by" binding:"required"`
+	Filters    map[string]string `json:"filters"`
+	BlockStart uint              `json:"block_start"`
+	BlockEnd   uint              `json:"block_end" binding:"omitempty,gtefield=BlockStart"`
+	TimeStart  uint              `json:"time_start"`
+	TimeEnd    uint              `json:"time_end" binding:"omitempty,gtefield=TimeStart"`
+	Limit      int               `json:"limit" binding:"omitempty,min=1,max=1000"`
+}
+
+// analyticsStatsHandle handler aggregate statistics from analytics sink
+// @Summary Aggregate statistics of extrinsics/events/logs/evm_transactions/token_transfers
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param params body analyticsStatsParams true "params"
+// @Success 200 {object} http.J{data=[]analytics.StatsRow}
+// @Router /api/scan/analytics/stats [post]
+func analyticsStatsHandle(c *gin.Context) {
+	p := new(analyticsStatsParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	list, err := svc.AnalyticsStats(c.Request.Context(), &analytics.StatsParams{
+		Table:      p.Table,
+		GroupBy:    p.GroupBy,
+		Filters:    p.Filters,
+		BlockStart: p.BlockStart,
+		BlockEnd:   p.BlockEnd,
+		TimeStart:  p.TimeStart,
+		TimeEnd:    p.TimeEnd,
+		Limit:      p.Limit,
+	})
+	toJson(c, list, err)
+}
+
+type storageParams struct {
+	Module   string        `json:"module" binding:"required"`
+	Method   string        `json:"method" binding:"required"`
+	Keys     []interface{} `json:"keys"`
+	BlockNum *uint         `json:"block_num" binding:"omitempty"`
+	Hash     string        `json:"hash" binding:"omitempty"`
+	Row      int           `json:"row" binding:"omitempty,min=1,max=100"`
+	After    string        `json:"after" binding:"omitempty"` // end_cursor key of the previous page
+}
+
+// storageHandle handler read storage item at block, map entries are paged if keys are partial
+// @Summary Historical storage query
+// @Tags storage
+// @Accept json
+// @Produce json
+// @Param params body storageParams true "params"
+// @Success 200 {object} http.J{data=model.StorageQuery}
+// @Router /api/scan/storage [post]
+func storageHandle(c *gin.Context) {
+	p := new(storageParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	r, err := svc.QueryStorage(c.Request.Context(), &service.StorageQueryParams{
+		Module:   p.Module,
+		Method:   p.Method,
+		Keys:     p.Keys,
+		BlockNum: p.BlockNum,
+		Hash:     p.Hash,
+		Row:      p.Row,
+		After:    p.After,
+	})
+	toJson(c, r, err)
+}
+
+type decodeParams struct {
+	Type string `json:"type" binding:"oneof=extrinsic call event events storage"`
+	Raw  string `json:"raw" binding:"required_unless=Type storage"`
+	Key  string `json:"key" binding:"required_if=Type storage"` // storage key
+	Spec int    `json:"spec" binding:"omitempty,min=0"`
+}
+
+// decodeHandle handler decode hex extrinsic, call, event record or storage key/value with the metadata of spec
+// @Summary Offline decoder
+// @Tags tools
+// @Accept json
+// @Produce json
+// @Param params body decodeParams true "params"
+// @Success 200 {object} http.J{data=service.DecodeResult}
+// @Router /api/scan/tools/decode [post]
+func decodeHandle(c *gin.Context) {
+	p := new(decodeParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	r, err := svc.Decode(c.Request.Context(), &service.DecodeParams{Type: p.Type, Raw: p.Raw, Key: p.Key, Spec: p.Spec})
+	toJson(c, r, err)
+}
+
+type estimateFeeParams struct {
+	Extrinsic string `json:"extrinsic" binding:"required_without=Call"` // signed or unsigned extrinsic
+	Call      string `json:"call" binding:"required_without=Extrinsic"`
+	BlockNum  *uint  `json:"block_num" binding:"omitempty"`
+	Hash      string `json:"hash" binding:"omitempty"`
+}
+
+// estimateFeeHandle handler fee details of payload at block
+// @Summary Estimate fee of extrinsic or call
+// @Tags tools
+// @Accept json
+// @Produce json
+// @Param params body estimateFeeParams true "params"
+// @Success 200 {object} http.J{data=service.EstimateFeeResult}
+// @Router /api/scan/tools/estimate_fee [post]
+func estimateFeeHandle(c *gin.Context) {
+	p := new(estimateFeeParams)
+	if err := c.MustBindWith(p, binding.JSON); err != nil {
+		toJson(c, nil, err)
+		return
+	}
+	r, err := svc.EstimateFee(c.Request.Context(), &service.EstimateFeeParams{
+		Extrinsic: p.Extrinsic,
+		Call:      p.Call,
+		BlockNum:  p.BlockNum,
+		Hash:      p.Hash,
+	})
+	toJson(c, r, err)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/analytics"
+)
+
+func (s *Service) initAnalytics() {
+	analytics.Init(configs.Boot.Analytics)
+	analytics.RegisterBackfill("substrate", s.backfillAnalytics)
+}
+
+// sinkBlock push committed block data to analytics sink
+func (s *Service) sinkBlock(block *model.ChainBlock, extrinsics []model.ChainExtrinsic, events []model.ChainEvent, logs []model.ChainLog) {
+	if !analytics.Enabled() {
+		return
+	}
+	for i := range extrinsics {
+		extrinsics[i].BlockNum = block.BlockNum
+	}
+	rows := make([]model.ChainEvent, len(events))
+	for i, e := range events {
+		e.BlockNum = block.BlockNum
+		e.ModuleId = strings.ToLower(e.ModuleId)
+		rows[i] = e
+	}
+	pushAnalytics(analytics.Instant, map[uint]int{block.BlockNum: block.BlockTimestamp}, extrinsics, rows, logs)
+}
+
+func pushAnalytics(sink *analytics.Sink, timestamps map[uint]int, extrinsics []model.ChainExtrinsic, events []model.ChainEvent, logs []model.ChainLog) {
+	var rows []interface{}
+	for _, e := range extrinsics {
+		rows = append(rows, analytics.Extrinsic{
+			BlockNum:           e.BlockNum,
+			BlockTimestamp:     timestamps[e.BlockNum],
+			ExtrinsicIndex:     e.ExtrinsicIndex,
+			ExtrinsicHash:      e.ExtrinsicHash,
+			CallModule:         e.CallModule,
+			CallModuleFunction: e.CallModuleFunction,
+			AccountId:          e.AccountId,
+			IsSigned:           e.IsSigned,
+			Success:            e.Success,
+			Fee:                e.Fee,
+			UsedFee:            e.UsedFee,
+		})
+	}
+	sink.Push(analytics.TableExtrinsics, rows...)
+
+	rows = nil
+	for _, e := range events {
+		params, _ := json.Marshal(e.Params)
+		rows = append(rows, analytics.Event{
+			BlockNum:       e.BlockNum,
+			BlockTimestamp: timestamps[e.BlockNum],
+			EventIndex:     e.EventIndex(),
+			ExtrinsicIndex: fmt.Sprintf("%d-%d", e.BlockNum, e.ExtrinsicIdx),
+			ModuleId:       e.ModuleId,
+			EventId:        e.EventId,
+			Phase:          e.Phase,
+			Params:         string(params),
+		})
+	}
+	sink.Push(analytics.TableEvents, rows...)
+
+	rows = nil
+	for _, l := range logs {
+		rows = append(rows, analytics.Log{
+			BlockNum:       l.BlockNum,
+			BlockTimestamp: timestamps[l.BlockNum],
+			LogIndex:       l.LogIndex,
+			LogType:        l.LogType,
+			Data:           string(l.Data.Bytes()),
+		})
+	}
+	sink.Push(analytics.TableLogs, rows...)
+}
+
+// backfillAnalytics push indexed substrate data of block range to analytics sink
+func (s *Service) backfillAnalytics(ctx context.Context, sink *analytics.Sink, start, end uint) error {
+	data, err := s.dao.GetBlockRangeData(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	timestamps := make(map[uint]int)
+	for _, block := range data.Blocks {
+		timestamps[block.BlockNum] = block.BlockTimestamp
+	}
+	pushAnalytics(sink, timestamps, data.Extrinsics, data.Events, data.Logs)
+	return nil
+}
+
+// AnalyticsStats aggregate statistics from analytics sink
+func (s *Service) AnalyticsStats(ctx context.Context, p *analytics.StatsParams) ([]analytics.StatsRow, error) {
+	if !analytics.Enabled() {
+		return nil, analytics.ErrDisabled
+	}
+	return analytics.Instant.Client().Stats(ctx, p)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/itering/subscan/configs"
 	"github.com/itering/subscan/model"
 	"github.com/itering/subscan/plugins"
+	"github.com/itering/subscan/share/substrate"
 	"github.com/itering/subscan/util"
 	"github.com/itering/subscan/util/address"
 	"github.com/itering/substrate-api-rpc/metadata"
@@ -88,6 +89,11 @@ func (s *Service) GetFinalizedBlock(c context.Context) (uint64, error) {
 	return s.dao.GetFinalizedBlockNum(c)
 }
 
+// GetFillFinalizedBlockNum the latest finalized block num already indexed
+func (s *Service) GetFillFinalizedBlockNum(c context.Context) (int, error) {
+	return s.dao.GetFillFinalizedBlockNum(c)
+}
+
 type CursorPage struct {
 	StartCursor     *uint `json:"start_cursor,omitempty"`
 	EndCursor       *uint `json:"end_cursor,omitempty"`
@@ -110,11 +116,70 @@ func (s *Service) GetExtrinsicList(ctx context.Context, limit int, fixedTableInd
 }
 
 func (s *Service) GetExtrinsicByIndex(ctx context.Context, index string) *model.ExtrinsicDetail {
-	return s.dao.GetExtrinsicsDetailByIndex(ctx, index)
+	return s.fillExtrinsicCalls(s.fillExtrinsicErrors(s.dao.GetExtrinsicsDetailByIndex(ctx, index)))
 }
 
 func (s *Service) GetExtrinsicDetailByHash(ctx context.Context, hash string) *model.ExtrinsicDetail {
-	return s.dao.GetExtrinsicsDetailByHash(ctx, hash)
+	return s.fillExtrinsicCalls(s.fillExtrinsicErrors(s.dao.GetExtrinsicsDetailByHash(ctx, hash)))
+}
+
+// fillExtrinsicCalls nested call tree with attributed events
+func (s *Service) fillExtrinsicCalls(detail *model.ExtrinsicDetail) *model.ExtrinsicDetail {
+	if detail == nil {
+		return nil
+	}
+	tree := substrate.ParseCallTree(detail.CallModule, detail.CallModuleFunction, detail.Params)
+	if len(tree.Calls) == 0 {
+		return detail
+	}
+	var events []substrate.CallEvent
+	for _, event := range s.dao.GetEventsByIndex(detail.ExtrinsicIndex) {
+		callEvent := substrate.CallEvent{EventIndex: event.EventIndex(), EventIdx: int(event.EventIdx), ModuleId: event.ModuleId, EventId: event.EventId}
+		for _, param := range event.Params {
+			callEvent.Params = append(callEvent.Params, param.Value)
+		}
+		events = append(events, callEvent)
+	}
+	tree.AttributeEvents(events)
+	detail.Calls = extrinsicCalls(tree.Calls)
+	return detail
+}
+
+func extrinsicCalls(calls []*substrate.Call) []model.ExtrinsicCall {
+	var list []model.ExtrinsicCall
+	for _, call := range calls {
+		item := model.ExtrinsicCall{
+			Path:               call.Path,
+			CallModule:         call.CallModule,
+			CallModuleFunction: call.CallModuleFunction,
+			Events:             call.Events,
+			Success:            call.Success,
+			Calls:              extrinsicCalls(call.Calls),
+		}
+		for _, param := range call.Params {
+			item.Params = append(item.Params, model.ExtrinsicParam{Name: param.Name, Type: param.Type, Value: param.Value, TypeName: param.TypeName})
+		}
+		if call.Error != nil {
+			item.Error = &model.ExtrinsicCallError{Source: call.Error.Source, Value: call.Error.Value}
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
+type extrinsicErrorResolver interface {
+	ExtrinsicErrors(extrinsicIndex string) []model.ExtrinsicErrorJson
+}
+
+// fillExtrinsicErrors dispatch errors decoded by system plugin
+func (s *Service) fillExtrinsicErrors(detail *model.ExtrinsicDetail) *model.ExtrinsicDetail {
+	if detail == nil {
+		return nil
+	}
+	if resolver, ok := plugins.RegisteredPlugins["system"].(extrinsicErrorResolver); ok {
+		detail.Errors = resolver.ExtrinsicErrors(detail.ExtrinsicIndex)
+	}
+	return detail
 }
 
 func (s *Service) EventsList(ctx context.Context, limit int, fixedTableIndex int, beforeId uint, afterId uint, where ...model.Option) ([]model.ChainEventJson, CursorPage) {
@@ -199,3 +264,24 @@ func (s *Service) SubstrateRuntimeInfo(spec int) *metadata.Instant {
 func (s *Service) LogsList(ctx context.Context, blockNum uint) []model.ChainLogJson {
 	return s.dao.GetLogByBlockNum(ctx, blockNum)
 }
+
+// BlockEvents all events of block in event_idx order
+func (s *Service) BlockEvents(ctx context.Context, blockNum uint, where ...model.Option) []model.ChainEventJson {
+	var (
+		events []model.ChainEventJson
+		after  uint
+	)
+	where = append(where, model.Where("block_num = ?", blockNum))
+	for {
+		list, page := s.EventsList(ctx, 100, int(model.TableIndex(blockNum)), 0, after, where...)
+		events = append(events, list...)
+		if !page.HasNextPage || page.EndCursor == nil {
+			break
+		}
+		after = *page.EndCursor
+	}
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events
+}
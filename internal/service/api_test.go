@@ -0,0 +1,23 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/itering/subscan/share/substrate"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_extrinsicCalls(t *testing.T) {
+	tree := &substrate.Call{CallModule: "utility", CallModuleFunction: "batch", Calls: []*substrate.Call{
+		{Path: "0", CallModule: "balances", CallModuleFunction: "transfer", Success: true},
+		{Path: "1", CallModule: "proxy", CallModuleFunction: "proxy", Error: &substrate.CallError{Source: "proxy.ProxyExecuted", Value: "BadOrigin"}, Calls: []*substrate.Call{
+			{Path: "1.0", CallModule: "balances", CallModuleFunction: "transfer"},
+		}},
+	}}
+	calls := extrinsicCalls(tree.Calls)
+	assert.Len(t, calls, 2)
+	assert.True(t, calls[0].Success)
+	assert.Nil(t, calls[0].Error)
+	assert.Equal(t, "proxy.ProxyExecuted", calls[1].Error.Source)
+	assert.Equal(t, "1.0", calls[1].Calls[0].Path)
+}
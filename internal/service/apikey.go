@@ -0,0 +1,282 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/metrics"
+	"github.com/itering/subscan/util"
+)
+
+const (
+	apiKeyCacheTTL     = time.Minute
+	apiKeyCacheSize    = 10000
+	apiKeyUsageMaxDays = 31
+)
+
+// apiKeyTiers token bucket of tiers, overridden by env API_TIER_{NAME}=rate,burst,daily_quota
+var apiKeyTiers = loadApiKeyTiers(map[string]model.ApiKeyTier{
+	model.ApiKeyTierAnonymous: {Rate: 2, Burst: 10},
+	model.ApiKeyTierFree:      {Rate: 5, Burst: 20, DailyQuota: 100000},
+	model.ApiKeyTierStandard:  {Rate: 20, Burst: 50, DailyQuota: 1000000},
+	model.ApiKeyTierPro:       {Rate: 100, Burst: 200},
+})
+
+func loadApiKeyTiers(defaults map[string]model.ApiKeyTier) map[string]model.ApiKeyTier {
+	tiers := make(map[string]model.ApiKeyTier, len(defaults))
+	for name, tier := range defaults {
+		tier.Name = name
+		if fields := strings.Split(util.GetEnv("API_TIER_"+strings.ToUpper(name), ""), ","); len(fields) == 3 {
+			rate, _ := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64)
+			burst := util.StringToInt(strings.TrimSpace(fields[1]))
+			quota, _ := strconv.ParseInt(strings.TrimSpace(fields[2]), 10, 64)
+			if rate > 0 && burst > 0 {
+				tier.Rate, tier.Burst, tier.DailyQuota = rate, burst, quota
+			}
+		}
+		tiers[name] = tier
+	}
+	return tiers
+}
+
+// apiRouteLimits extra token bucket of route per api key or client ip, taken after the bucket of api key or client ip,
+// env API_ROUTE_LIMITS=route=rate,burst;route=rate,burst
+var apiRouteLimits = loadApiRouteLimits(util.GetEnv("API_ROUTE_LIMITS", ""))
+
+func loadApiRouteLimits(env string) map[string]model.ApiKeyTier {
+	limits := make(map[string]model.ApiKeyTier)
+	for _, item := range strings.Split(env, ";") {
+		route, limit, ok := strings.Cut(strings.TrimSpace(item), "=")
+		fields := strings.Split(limit, ",")
+		if !ok || route == "" || len(fields) != 2 {
+			continue
+		}
+		rate, _ := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64)
+		burst := util.StringToInt(strings.TrimSpace(fields[1]))
+		if rate > 0 && burst > 0 {
+			limits[strings.TrimSpace(route)] = model.ApiKeyTier{Name: route, Rate: rate, Burst: burst}
+		}
+	}
+	return limits
+}
+
+// ApiKeyTiers tiers of api key
+func (s *Service) ApiKeyTiers() map[string]model.ApiKeyTier {
+	return apiKeyTiers
+}
+
+func hashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateApiKey issue api key of tier, key is only returned here
+func (s *Service) CreateApiKey(ctx context.Context, name, tier string) (string, *model.ApiKey, error) {
+	if _, ok := apiKeyTiers[tier]; !ok || tier == model.ApiKeyTierAnonymous {
+		return "", nil, fmt.Errorf("unknown api key tier %s", tier)
+	}
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, err
+	}
+	key := hex.EncodeToString(raw)
+	record := model.ApiKey{
+		Name:      name,
+		Prefix:    key[:8],
+		KeyHash:   hashApiKey(key),
+		Tier:      tier,
+		CreatedAt: time.Now().Unix(),
+	}
+	if err := s.dao.CreateApiKey(ctx, &record); err != nil {
+		return "", nil, err
+	}
+	return key, &record, nil
+}
+
+func (s *Service) RevokeApiKey(ctx context.Context, id uint) error {
+	if err := s.dao.RevokeApiKey(ctx, id); err != nil {
+		return err
+	}
+	apiKeys.purge()
+	return nil
+}
+
+func (s *Service) ApiKeyList(ctx context.Context) []model.ApiKey {
+	return s.dao.GetApiKeyList(ctx)
+}
+
+// ApiKeyUsage daily request count of api key in recent days, latest first
+func (s *Service) ApiKeyUsage(ctx context.Context, id uint, days int) []model.ApiKeyUsage {
+	if days < 1 || days > apiKeyUsageMaxDays {
+		days = apiKeyUsageMaxDays
+	}
+	now := time.Now().UTC()
+	dates := make([]string, days)
+	for i := range dates {
+		dates[i] = now.AddDate(0, 0, -i).Format(time.DateOnly)
+	}
+	return s.dao.GetApiKeyUsage(ctx, apiKeyIdentity(id), dates)
+}
+
+func apiKeyIdentity(id uint) string {
+	return fmt.Sprintf("key:%d", id)
+}
+
+// apiKeyCache lru cache of api key of hash, unknown keys are cached as nil,
+// cached for apiKeyCacheTTL, revoked keys may be accepted by other instances until expired
+type apiKeyCache struct {
+	sync.Mutex
+	keys map[string]*list.Element
+	lru  *list.List // front is the most recently used
+}
+
+type apiKeyCacheItem struct {
+	keyHash string
+	key     *model.ApiKey
+	expired time.Time
+}
+
+var apiKeys = newApiKeyCache()
+
+func newApiKeyCache() *apiKeyCache {
+	return &apiKeyCache{keys: make(map[string]*list.Element), lru: list.New()}
+}
+
+// get cached api key of hash, false if not cached or expired
+func (c *apiKeyCache) get(keyHash string) (*model.ApiKey, bool) {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.keys[keyHash]
+	if !ok {
+		return nil, false
+	}
+	item := e.Value.(*apiKeyCacheItem)
+	if time.Now().After(item.expired) {
+		c.lru.Remove(e)
+		delete(c.keys, keyHash)
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return item.key, true
+}
+
+// put cache api key of hash, the least recently used key is evicted if full
+func (c *apiKeyCache) put(keyHash string, key *model.ApiKey) {
+	c.Lock()
+	defer c.Unlock()
+	item := &apiKeyCacheItem{keyHash: keyHash, key: key, expired: time.Now().Add(apiKeyCacheTTL)}
+	if e, ok := c.keys[keyHash]; ok {
+		e.Value = item
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.keys[keyHash] = c.lru.PushFront(item)
+	for c.lru.Len() > apiKeyCacheSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.keys, oldest.Value.(*apiKeyCacheItem).keyHash)
+	}
+}
+
+func (c *apiKeyCache) purge() {
+	c.Lock()
+	defer c.Unlock()
+	c.keys = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// Allow take a token of bucket of api key or client ip, and of its bucket on route if the route has a limit,
+// and count daily usage of api key.
+// api key not cached is looked up after a token of bucket of client ip is taken, so unknown keys can not flood the database.
+// util.Unauthorized is returned if api key is unknown or revoked, request is allowed if redis is unavailable,
+// or as the client ip if api key can not be looked up
+func (s *Service) Allow(ctx context.Context, apiKey, clientIp, route string) (*model.RateLimitState, error) {
+	tier := apiKeyTiers[model.ApiKeyTierAnonymous]
+	identity := "ip:" + clientIp
+	if apiKey != "" {
+		keyHash := hashApiKey(apiKey)
+		key, ok := apiKeys.get(keyHash)
+		if !ok {
+			state := &model.RateLimitState{Allowed: true, Tier: tier.Name, Limit: tier.Burst, Remaining: tier.Burst}
+			if allowed, err := s.takeRateLimitToken(ctx, state, identity, tier); err != nil {
+				util.Logger().Error(fmt.Errorf("rate limit of %s error %v", identity, err))
+			} else if !allowed {
+				metrics.ApiRequests.WithLabelValues(tier.Name, route, "limited").Inc()
+				return state, nil
+			}
+			var err error
+			if key, err = s.dao.GetApiKeyByHash(ctx, keyHash); err != nil {
+				// not cached, allowed as the client ip whose token is taken
+				util.Logger().Error(fmt.Errorf("get api key error %v", err))
+				metrics.ApiRequests.WithLabelValues(tier.Name, route, "allowed").Inc()
+				return state, nil
+			}
+			apiKeys.put(keyHash, key)
+		}
+		if key == nil || key.RevokedAt > 0 {
+			metrics.ApiRequests.WithLabelValues(model.ApiKeyTierAnonymous, route, "unauthorized").Inc()
+			return nil, util.Unauthorized
+		}
+		if t, ok := apiKeyTiers[key.Tier]; ok {
+			tier = t
+		}
+		identity = apiKeyIdentity(key.Id)
+	}
+	state := &model.RateLimitState{Allowed: true, Tier: tier.Name, Limit: tier.Burst, Remaining: tier.Burst, QuotaLimit: tier.DailyQuota}
+	// one bucket of api key or client ip whatever the route, so a client can not multiply its rate by spreading over routes
+	allowed, err := s.takeRateLimitToken(ctx, state, identity, tier)
+	if err != nil {
+		util.Logger().Error(fmt.Errorf("rate limit of %s error %v", identity, err))
+		return state, nil
+	}
+	if limit, ok := apiRouteLimits[route]; ok && allowed {
+		routeState := &model.RateLimitState{Limit: limit.Burst}
+		if allowed, err = s.takeRateLimitToken(ctx, routeState, identity+":"+route, limit); err != nil {
+			util.Logger().Error(fmt.Errorf("rate limit of %s on %s error %v", identity, route, err))
+			allowed = true
+		} else if !allowed || routeState.Remaining < state.Remaining {
+			// headers of the tighter bucket
+			state.Allowed, state.Limit, state.Remaining, state.Reset, state.RetryAfter =
+				routeState.Allowed, routeState.Limit, routeState.Remaining, routeState.Reset, routeState.RetryAfter
+		}
+	}
+	result := "allowed"
+	if state.Allowed && apiKey != "" {
+		used, err := s.dao.IncrApiKeyUsage(ctx, identity, time.Now().UTC().Format(time.DateOnly))
+		if err == nil && tier.DailyQuota > 0 {
+			state.QuotaRemaining = max(tier.DailyQuota-used, 0)
+			if used > tier.DailyQuota {
+				// retry at the next day
+				state.Allowed = false
+				state.RetryAfter = int(time.Until(time.Now().UTC().Truncate(24*time.Hour).Add(24*time.Hour)).Seconds()) + 1
+				result = "quota_exceeded"
+			}
+		}
+	}
+	if !allowed {
+		result = "limited"
+	}
+	metrics.ApiRequests.WithLabelValues(tier.Name, route, result).Inc()
+	return state, nil
+}
+
+// takeRateLimitToken take a token of bucket, fill allowed, remaining, reset and retry after of state
+func (s *Service) takeRateLimitToken(ctx context.Context, state *model.RateLimitState, bucket string, limit model.ApiKeyTier) (bool, error) {
+	allowed, remaining, retryMs, resetMs, err := s.dao.TakeRateLimitToken(ctx, bucket, limit.Rate, limit.Burst)
+	if err != nil {
+		return true, err
+	}
+	state.Allowed, state.Remaining = allowed, remaining
+	state.Reset = int((resetMs + 999) / 1000)
+	state.RetryAfter = int((retryMs + 999) / 1000)
+	return allowed, nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testApiKey        = "test-api-key"
+	testRevokedApiKey = "revoked-api-key"
+	// api key looked up with db error
+	testUnavailableApiKey = "unavailable-api-key"
+)
+
+func Test_loadApiKeyTiers(t *testing.T) {
+	t.Setenv("API_TIER_FREE", "1.5, 3, 10")
+	t.Setenv("API_TIER_PRO", "invalid")
+	tiers := loadApiKeyTiers(map[string]model.ApiKeyTier{
+		model.ApiKeyTierFree: {Rate: 5, Burst: 20, DailyQuota: 100},
+		model.ApiKeyTierPro:  {Rate: 100, Burst: 200},
+	})
+	assert.Equal(t, model.ApiKeyTier{Name: model.ApiKeyTierFree, Rate: 1.5, Burst: 3, DailyQuota: 10}, tiers[model.ApiKeyTierFree])
+	assert.Equal(t, model.ApiKeyTier{Name: model.ApiKeyTierPro, Rate: 100, Burst: 200}, tiers[model.ApiKeyTierPro])
+}
+
+func TestService_CreateApiKey(t *testing.T) {
+	ctx := context.Background()
+	key, record, err := testSrv.CreateApiKey(ctx, "explorer", model.ApiKeyTierStandard)
+	assert.NoError(t, err)
+	assert.Len(t, key, 48)
+	assert.Equal(t, key[:8], record.Prefix)
+	assert.Equal(t, hashApiKey(key), record.KeyHash)
+
+	_, _, err = testSrv.CreateApiKey(ctx, "explorer", model.ApiKeyTierAnonymous)
+	assert.Error(t, err)
+	_, _, err = testSrv.CreateApiKey(ctx, "explorer", "unknown")
+	assert.Error(t, err)
+
+	assert.NoError(t, testSrv.RevokeApiKey(ctx, 1))
+	assert.ErrorIs(t, testSrv.RevokeApiKey(ctx, 3), util.RecordNotFound)
+	assert.Len(t, testSrv.ApiKeyUsage(ctx, 1, 7), 7)
+	assert.Len(t, testSrv.ApiKeyUsage(ctx, 1, 0), apiKeyUsageMaxDays)
+}
+
+func TestService_Allow(t *testing.T) {
+	ctx := context.Background()
+	apiKeys.purge()
+	free := apiKeyTiers[model.ApiKeyTierFree]
+	anonymous := apiKeyTiers[model.ApiKeyTierAnonymous]
+	today := time.Now().UTC().Format(time.DateOnly)
+
+	d := &MockDao{}
+	d.On("TakeRateLimitToken", ctx, "ip:127.0.0.1", anonymous.Rate, anonymous.Burst).Return(false, 0, int64(400), int64(5000), nil)
+	d.On("TakeRateLimitToken", ctx, "key:1", free.Rate, free.Burst).Return(true, 9, int64(0), int64(2100), nil).Once()
+	d.On("IncrApiKeyUsage", ctx, "key:1", today).Return(int64(10), nil).Once()
+	s := &Service{dao: d}
+
+	state, err := s.Allow(ctx, "", "127.0.0.1", "/api/scan/blocks")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.RateLimitState{Tier: model.ApiKeyTierAnonymous, Limit: anonymous.Burst, Reset: 5, RetryAfter: 1}, state)
+
+	// api key not cached is not looked up if the bucket of client ip is empty
+	state, err = s.Allow(ctx, "unknown", "127.0.0.1", "/api/scan/blocks")
+	assert.NoError(t, err)
+	assert.False(t, state.Allowed)
+	_, cached := apiKeys.get(hashApiKey("unknown"))
+	assert.False(t, cached)
+
+	// a token of client ip is taken for every lookup of testApiKey, testRevokedApiKey and unknown
+	d.On("TakeRateLimitToken", ctx, "ip:127.0.0.4", anonymous.Rate, anonymous.Burst).Return(true, 9, int64(0), int64(0), nil).Times(3)
+	state, err = s.Allow(ctx, testApiKey, "127.0.0.4", "/api/scan/blocks")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.RateLimitState{
+		Allowed: true, Tier: model.ApiKeyTierFree, Limit: free.Burst, Remaining: 9, Reset: 3,
+		QuotaLimit: free.DailyQuota, QuotaRemaining: free.DailyQuota - 10,
+	}, state)
+
+	// daily quota exceeded
+	d.On("TakeRateLimitToken", ctx, "key:1", free.Rate, free.Burst).Return(true, 9, int64(0), int64(2100), nil).Once()
+	d.On("IncrApiKeyUsage", ctx, "key:1", today).Return(free.DailyQuota+1, nil).Once()
+	state, err = s.Allow(ctx, testApiKey, "127.0.0.4", "/api/scan/blocks")
+	assert.NoError(t, err)
+	assert.False(t, state.Allowed)
+	assert.Equal(t, int64(0), state.QuotaRemaining)
+	assert.Greater(t, state.RetryAfter, 0)
+
+	_, err = s.Allow(ctx, testRevokedApiKey, "127.0.0.4", "/api/scan/blocks")
+	assert.ErrorIs(t, err, util.Unauthorized)
+	_, err = s.Allow(ctx, "unknown", "127.0.0.4", "/api/scan/blocks")
+	assert.ErrorIs(t, err, util.Unauthorized)
+	// unknown key is cached
+	_, err = s.Allow(ctx, "unknown", "127.0.0.4", "/api/scan/blocks")
+	assert.ErrorIs(t, err, util.Unauthorized)
+
+	// allowed as client ip if api key can not be looked up, and looked up again
+	d.On("TakeRateLimitToken", ctx, "ip:127.0.0.5", anonymous.Rate, anonymous.Burst).Return(true, 9, int64(0), int64(0), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		state, err = s.Allow(ctx, testUnavailableApiKey, "127.0.0.5", "/api/scan/blocks")
+		assert.NoError(t, err)
+		assert.True(t, state.Allowed)
+		assert.Equal(t, model.ApiKeyTierAnonymous, state.Tier)
+	}
+	_, cached = apiKeys.get(hashApiKey(testUnavailableApiKey))
+	assert.False(t, cached)
+
+	// allowed if redis is unavailable
+	d.On("TakeRateLimitToken", ctx, "ip:127.0.0.2", anonymous.Rate, anonymous.Burst).Return(false, 0, int64(0), int64(0), errors.New("redis down"))
+	state, err = s.Allow(ctx, "", "127.0.0.2", "/api/scan/blocks")
+	assert.NoError(t, err)
+	assert.True(t, state.Allowed)
+	d.AssertExpectations(t)
+}
+
+func Test_apiKeyCache(t *testing.T) {
+	c := newApiKeyCache()
+	for i := 0; i < apiKeyCacheSize; i++ {
+		c.put(fmt.Sprint(i), &model.ApiKey{Id: uint(i)})
+	}
+	// 0 is used, 1 is the least recently used
+	key, ok := c.get("0")
+	assert.True(t, ok)
+	assert.Equal(t, uint(0), key.Id)
+	c.put("unknown", nil)
+	_, ok = c.get("1")
+	assert.False(t, ok)
+	_, ok = c.get("0")
+	assert.True(t, ok)
+	key, ok = c.get("unknown")
+	assert.True(t, ok)
+	assert.Nil(t, key)
+	assert.Equal(t, apiKeyCacheSize, c.lru.Len())
+
+	c.purge()
+	_, ok = c.get("0")
+	assert.False(t, ok)
+}
+
+func Test_loadApiRouteLimits(t *testing.T) {
+	assert.Equal(t, map[string]model.ApiKeyTier{
+		"/api/plugin/evm/rpc": {Name: "/api/plugin/evm/rpc", Rate: 1, Burst: 5},
+	}, loadApiRouteLimits(" /api/plugin/evm/rpc=1,5;/api/scan/blocks=0,5;/api/scan/events=2;invalid"))
+	assert.Empty(t, loadApiRouteLimits(""))
+}
+
+func TestService_AllowRouteLimit(t *testing.T) {
+	ctx := context.Background()
+	anonymous := apiKeyTiers[model.ApiKeyTierAnonymous]
+	routeLimits := apiRouteLimits
+	defer func() { apiRouteLimits = routeLimits }()
+	apiRouteLimits = loadApiRouteLimits("/api/plugin/evm/rpc=0.5,2")
+
+	d := &MockDao{}
+	d.On("TakeRateLimitToken", ctx, "ip:127.0.0.3", anonymous.Rate, anonymous.Burst).Return(true, 8, int64(0), int64(1000), nil)
+	d.On("TakeRateLimitToken", ctx, "ip:127.0.0.3:/api/plugin/evm/rpc", 0.5, 2).Return(false, 0, int64(2000), int64(4000), nil).Once()
+	s := &Service{dao: d}
+
+	// route bucket is empty while the bucket of client ip is not
+	state, err := s.Allow(ctx, "", "127.0.0.3", "/api/plugin/evm/rpc")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.RateLimitState{Tier: model.ApiKeyTierAnonymous, Limit: 2, Reset: 4, RetryAfter: 2}, state)
+
+	// routes without limit only take the bucket of client ip
+	state, err = s.Allow(ctx, "", "127.0.0.3", "/api/scan/blocks")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.RateLimitState{Allowed: true, Tier: model.ApiKeyTierAnonymous, Limit: anonymous.Burst, Remaining: 8, Reset: 1}, state)
+	d.AssertExpectations(t)
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/itering/subscan/internal/cbc"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/address"
+	"github.com/itering/substrate-api-rpc"
+	rpcModel "github.com/itering/substrate-api-rpc/model"
+	"github.com/itering/substrate-api-rpc/rpc"
+	"github.com/itering/substrate-api-rpc/websocket"
+)
+
+// maxMissedSlots cap of missed slots recorded between two blocks, a larger gap is a halt rather than misses
+const maxMissedSlots = 1000
+
+// AuthorBlock block data used to resolve author
+type AuthorBlock struct {
+	BlockNum       uint
+	ParentHash     string
+	Events         []model.ChainEvent
+	RuntimeLogData []byte
+	SessionIndex   uint
+}
+
+// AuthorResolver resolve author of block, nil if the digest is not handled by the resolver
+type AuthorResolver interface {
+	Resolve(ctx context.Context, s *Service, b *AuthorBlock) (*model.ChainBlockAuthor, []model.ChainMissedSlot)
+}
+
+var (
+	authorResolversLock sync.RWMutex
+	authorResolvers     = map[string]AuthorResolver{}
+)
+
+// RegisterAuthorResolver resolver of network, tried before the Session validator resolver
+func RegisterAuthorResolver(network string, resolver AuthorResolver) {
+	authorResolversLock.Lock()
+	defer authorResolversLock.Unlock()
+	authorResolvers[strings.ToLower(network)] = resolver
+}
+
+func authorResolverOf(network string) AuthorResolver {
+	authorResolversLock.RLock()
+	defer authorResolversLock.RUnlock()
+	if resolver, ok := authorResolvers[strings.ToLower(network)]; ok {
+		return resolver
+	}
+	// CBC author parameters are configured by env, checked and logged when CBC chain is initialized
+	if cbc.IsCBCNetwork(network) && cbc.CheckAuthorConfig() == nil {
+		return cbcAuthorResolver{}
+	}
+	return nil
+}
+
+// blockAuthor author of block by resolver of network, fallback to Aura/Babe digest with Session validators
+func (s *Service) blockAuthor(ctx context.Context, b *AuthorBlock) (*model.ChainBlockAuthor, []model.ChainMissedSlot) {
+	if len(b.RuntimeLogData) == 0 {
+		return nil, nil
+	}
+	if resolver := authorResolverOf(util.NetworkNode); resolver != nil {
+		if author, missed := resolver.Resolve(ctx, s, b); author != nil {
+			return author, missed
+		}
+	}
+	return sessionAuthorResolver{}.Resolve(ctx, s, b)
+}
+
+// sessionAuthorResolver author of Aura/Babe PreRuntime digest in Session.Validators
+type sessionAuthorResolver struct{}
+
+func (sessionAuthorResolver) Resolve(ctx context.Context, s *Service, b *AuthorBlock) (*model.ChainBlockAuthor, []model.ChainMissedSlot) {
+	// check new session
+	var validatorList []string
+	newSession := false
+	for _, e := range b.Events {
+		if strings.EqualFold(e.ModuleId, "Session") && strings.EqualFold(e.EventId, "NewSession") {
+			newSession = true
+		}
+	}
+	// newSession use new validator list
+	if newSession || b.SessionIndex == 0 {
+		validatorList = s.ValidatorsList(b.ParentHash)
+	} else {
+		validatorList = s.dao.GetSessionValidatorsById(ctx, b.SessionIndex)
+		// if db not found, use on-chain query
+		if len(validatorList) == 0 {
+			validatorList = s.ValidatorsList(b.ParentHash)
+			// save to db
+			_ = s.dao.CreateNewSession(ctx, b.SessionIndex, validatorList)
+		}
+	}
+	return &model.ChainBlockAuthor{
+		BlockNum:      b.BlockNum,
+		Epoch:         b.SessionIndex,
+		Validator:     address.Format(substrate.ExtractAuthor(b.RuntimeLogData, validatorList)),
+		SelectionMode: preRuntimeEngine(b.RuntimeLogData),
+	}, nil
+}
+
+func preRuntimeEngine(runtimeLogData []byte) string {
+	var p substrate.PreRuntime
+	if json.Unmarshal(runtimeLogData, &p) != nil {
+		return ""
+	}
+	switch p.Engine {
+	case substrate.CidAura:
+		return "Aura"
+	case substrate.CidBabe:
+		return "Babe"
+	}
+	return ""
+}
+
+// cbcAuthorResolver author of CBC consensus digest in the epoch validator set
+type cbcAuthorResolver struct{}
+
+func (cbcAuthorResolver) Resolve(ctx context.Context, s *Service, b *AuthorBlock) (*model.ChainBlockAuthor, []model.ChainMissedSlot) {
+	digest, err := cbc.DecodePreDigest(b.RuntimeLogData)
+	if err != nil {
+		if err != cbc.ErrNotCBCDigest {
+			util.Logger().Error(fmt.Errorf("block %d decode CBC digest error %v", b.BlockNum, err))
+		}
+		return nil, nil
+	}
+	validators := s.epochValidators(ctx, b.ParentHash, digest.Epoch)
+	author := &model.ChainBlockAuthor{
+		BlockNum:      b.BlockNum,
+		Epoch:         uint(digest.Epoch),
+		Slot:          digest.Slot,
+		Validator:     address.Format(digest.Author(validators)),
+		SelectionMode: digest.SelectionMode,
+	}
+	if author.Validator == "" {
+		return nil, nil
+	}
+	if b.BlockNum == 0 {
+		return author, nil
+	}
+	// slots between parent and this block were missed by their scheduled authors, only known if the mode has a schedule
+	var missed []model.ChainMissedSlot
+	if cbc.ExpectedAuthor(digest.SelectionMode, digest.Slot, validators) == "" {
+		return author, nil
+	}
+	if parentSlot, err := s.cbcParentSlot(ctx, b); err != nil {
+		util.Logger().Error(fmt.Errorf("block %d read CBC parent slot error %v", b.BlockNum, err))
+	} else if digest.Slot > parentSlot+1 && digest.Slot-parentSlot <= maxMissedSlots {
+		for slot := parentSlot + 1; slot < digest.Slot; slot++ {
+			if expected := cbc.ExpectedAuthor(digest.SelectionMode, slot, validators); expected != "" {
+				missed = append(missed, model.ChainMissedSlot{Slot: slot, Epoch: author.Epoch, Validator: address.Format(expected), BlockNum: b.BlockNum})
+			}
+		}
+	}
+	return author, missed
+}
+
+// cbcParentSlot slot of parent block, author row of parent is only a cache, parent may not be processed yet
+// when blocks are processed concurrently, then slot is read from CBC digest of parent header
+func (s *Service) cbcParentSlot(ctx context.Context, b *AuthorBlock) (uint64, error) {
+	if parent := s.dao.GetBlockAuthor(ctx, b.BlockNum-1); parent != nil {
+		return parent.Slot, nil
+	}
+	logs, err := headerLogs(b.ParentHash)
+	if err != nil {
+		return 0, err
+	}
+	decoded, err := substrate.DecodeLogDigest(logs)
+	if err != nil {
+		return 0, err
+	}
+	for _, chainLog := range chainLogs(b.BlockNum-1, decoded, true) {
+		if !strings.EqualFold(chainLog.LogType, "PreRuntime") {
+			continue
+		}
+		if digest, err := cbc.DecodePreDigest(chainLog.Data.Bytes()); err == nil {
+			return digest.Slot, nil
+		}
+	}
+	return 0, fmt.Errorf("no CBC digest in header %s", b.ParentHash)
+}
+
+// headerLogs digest logs of block header
+var headerLogs = func(hash string) ([]string, error) {
+	v := &rpcModel.JsonRpcResult{}
+	if err := websocket.SendWsRequest(nil, v, rpcRequest("chain_getHeader", hash)); err != nil {
+		return nil, err
+	}
+	head := v.ToNewHead()
+	if head == nil {
+		return nil, errors.New("invalid chain_getHeader result")
+	}
+	return head.Digest.Logs, nil
+}
+
+// epochValidators validator set of CBC epoch, cached in session table by epoch index, CBC has no Session pallet
+func (s *Service) epochValidators(ctx context.Context, parentHash string, epoch uint32) []string {
+	if validators := s.dao.GetSessionValidatorsById(ctx, uint(epoch)); len(validators) > 0 {
+		return validators
+	}
+	raw, err := rpc.ReadStorage(nil, cbc.EpochValidatorsStorage[0], cbc.EpochValidatorsStorage[1], parentHash, cbc.EncodeEpoch(epoch))
+	if err != nil {
+		util.Logger().Error(fmt.Errorf("read CBC epoch %d validators of %s.%s error %v", epoch, cbc.EpochValidatorsStorage[0], cbc.EpochValidatorsStorage[1], err))
+	}
+	if raw == "" && cbc.ActiveValidatorsStorage[0] != "" {
+		if raw, err = rpc.ReadStorage(nil, cbc.ActiveValidatorsStorage[0], cbc.ActiveValidatorsStorage[1], parentHash); err != nil {
+			util.Logger().Error(fmt.Errorf("read CBC active validators of %s.%s error %v", cbc.ActiveValidatorsStorage[0], cbc.ActiveValidatorsStorage[1], err))
+		}
+	}
+	var validators []string
+	for _, addr := range raw.ToStringSlice() {
+		validators = append(validators, util.TrimHex(addr))
+	}
+	if len(validators) > 0 {
+		_ = s.dao.CreateNewSession(ctx, uint(epoch), validators)
+	}
+	return validators
+}
+
+func (s *Service) GetValidatorBlockStats(ctx context.Context, epoch *uint) []model.ValidatorBlockStat {
+	return s.dao.GetValidatorBlockStats(ctx, epoch)
+}
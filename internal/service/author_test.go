@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/itering/subscan/internal/cbc"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCBCEpoch = 7
+
+var testCBCValidators = []string{
+	"d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+	"8eaf04151687736326c9fea17e25fc5287613693c912909cb226aa4794f26a48",
+	"90b5ab205c6974c9ea841be688864633dc9ca8a357843eeacf2314649965fe22",
+}
+
+func init() {
+	cbc.ConsensusEngineId = "dcf0"
+	cbc.PreDigestLayout = cbc.ParseDigestLayout("epoch:u32,slot:u64,author_index:u32,selection_mode:u8")
+	cbc.EpochValidatorsStorage = cbc.ParseStorage("Dcf.EpochValidators")
+	cbc.RegisterDigestDecoder()
+}
+
+// cbcDigestData hex data of CBC digest
+func cbcDigestData(epoch uint32, slot uint64, authorIndex uint32, mode byte) string {
+	return cbc.EncodeEpoch(epoch) + util.BytesToHex([]byte{
+		byte(slot), byte(slot >> 8), byte(slot >> 16), byte(slot >> 24), byte(slot >> 32), byte(slot >> 40), byte(slot >> 48), byte(slot >> 56),
+		byte(authorIndex), byte(authorIndex >> 8), byte(authorIndex >> 16), byte(authorIndex >> 24),
+		mode,
+	})
+}
+
+// cbcRuntimeLog PreRuntime log data of CBC digest
+func cbcRuntimeLog(epoch uint32, slot uint64, authorIndex uint32, mode byte) []byte {
+	return []byte(fmt.Sprintf(`{"engine":%d,"data":"0x%s"}`, 0x30666364, cbcDigestData(epoch, slot, authorIndex, mode)))
+}
+
+func TestCbcAuthorResolver(t *testing.T) {
+	ctx := context.Background()
+	author, missed := cbcAuthorResolver{}.Resolve(ctx, &testSrv, &AuthorBlock{BlockNum: 10, RuntimeLogData: cbcRuntimeLog(testCBCEpoch, 13, 1, 0)})
+	assert.Equal(t, &model.ChainBlockAuthor{BlockNum: 10, Epoch: testCBCEpoch, Slot: 13, Validator: testCBCValidators[1], SelectionMode: cbc.AuthorSelectionRoundRobin}, author)
+	// slot 11 and 12 of parent slot 10
+	assert.Equal(t, []model.ChainMissedSlot{
+		{Slot: 11, Epoch: testCBCEpoch, Validator: testCBCValidators[2], BlockNum: 10},
+		{Slot: 12, Epoch: testCBCEpoch, Validator: testCBCValidators[0], BlockNum: 10},
+	}, missed)
+
+	// misses are not deterministic in stake weighted mode
+	author, missed = cbcAuthorResolver{}.Resolve(ctx, &testSrv, &AuthorBlock{BlockNum: 10, RuntimeLogData: cbcRuntimeLog(testCBCEpoch, 13, 2, 1)})
+	assert.Equal(t, testCBCValidators[2], author.Validator)
+	assert.Equal(t, cbc.AuthorSelectionStakeWeighted, author.SelectionMode)
+	assert.Nil(t, missed)
+
+	// author index out of validator set
+	author, _ = cbcAuthorResolver{}.Resolve(ctx, &testSrv, &AuthorBlock{BlockNum: 10, RuntimeLogData: cbcRuntimeLog(testCBCEpoch, 13, 5, 0)})
+	assert.Nil(t, author)
+
+	// parent author is not processed yet, slot of parent is read from parent header
+	fetchHeaderLogs := headerLogs
+	defer func() { headerLogs = fetchHeaderLogs }()
+	headerLogs = func(hash string) ([]string, error) {
+		// PreRuntime digest item, engine id and compact length prefixed data
+		data := cbcDigestData(testCBCEpoch, 40, 0, 0)
+		return []string{"0x06" + util.BytesToHex([]byte("dcf0")) + util.BytesToHex([]byte{byte(len(data) / 2 << 2)}) + data}, nil
+	}
+	author, missed = cbcAuthorResolver{}.Resolve(ctx, &testSrv, &AuthorBlock{BlockNum: 30, ParentHash: "0x01", RuntimeLogData: cbcRuntimeLog(testCBCEpoch, 42, 0, 0)})
+	assert.Equal(t, uint64(42), author.Slot)
+	assert.Equal(t, []model.ChainMissedSlot{{Slot: 41, Epoch: testCBCEpoch, Validator: testCBCValidators[2], BlockNum: 30}}, missed)
+
+	// babe digest is left to session resolver
+	author, _ = cbcAuthorResolver{}.Resolve(ctx, &testSrv, &AuthorBlock{BlockNum: 10, RuntimeLogData: []byte(`{"engine":1161969986,"data":"0x00"}`)})
+	assert.Nil(t, author)
+}
+
+func TestAuthorResolverOf(t *testing.T) {
+	assert.Nil(t, authorResolverOf("polkadot"))
+	assert.IsType(t, cbcAuthorResolver{}, authorResolverOf("cbc-chain"))
+
+	RegisterAuthorResolver("Custom", sessionAuthorResolver{})
+	defer func() {
+		authorResolversLock.Lock()
+		delete(authorResolvers, "custom")
+		authorResolversLock.Unlock()
+	}()
+	assert.IsType(t, sessionAuthorResolver{}, authorResolverOf("custom"))
+}
+
+func TestService_blockAuthorOfCBC(t *testing.T) {
+	network := util.NetworkNode
+	util.NetworkNode = "cbc"
+	defer func() { util.NetworkNode = network }()
+	author, _ := testSrv.blockAuthor(context.Background(), &AuthorBlock{BlockNum: 20, RuntimeLogData: cbcRuntimeLog(testCBCEpoch, 30, 0, 3)})
+	assert.Equal(t, testCBCValidators[0], author.Validator)
+	assert.Equal(t, cbc.AuthorSelectionHybrid, author.SelectionMode)
+	assert.Equal(t, uint64(30), author.Slot)
+
+	assert.Equal(t, []model.ValidatorBlockStat{{Validator: testCBCValidators[0], Authored: 2, Missed: 1}}, testSrv.GetValidatorBlockStats(context.Background(), nil))
+}
+
+func Test_preRuntimeEngine(t *testing.T) {
+	assert.Equal(t, "Babe", preRuntimeEngine([]byte(`{"engine":1161969986,"data":"0x00"}`)))
+	assert.Equal(t, "Aura", preRuntimeEngine([]byte(`{"engine":1634891105,"data":"0x00"}`)))
+	assert.Equal(t, "", preRuntimeEngine([]byte(`{"engine":1,"data":"0x00"}`)))
+}
@@ -5,13 +5,11 @@ import (
 	"fmt"
 	"github.com/itering/subscan/model"
 	"github.com/itering/subscan/util"
-	"github.com/itering/subscan/util/address"
 	"github.com/itering/substrate-api-rpc"
 	"github.com/itering/substrate-api-rpc/hasher"
 	smodel "github.com/itering/substrate-api-rpc/model"
 	"github.com/itering/substrate-api-rpc/rpc"
 	"github.com/itering/substrate-api-rpc/storage"
-	"strings"
 )
 
 func (s *Service) CreateChainBlock(ctx context.Context, hash string, block *smodel.Block, event string, spec int, sessionIndex uint) (err error) {
@@ -45,6 +43,12 @@ func (s *Service) CreateChainBlock(ctx context.Context, hash string, block *smod
 		util.Logger().Error(err)
 	}
 
+	// weight, read before the transaction holds connection
+	weight, weightErr := s.checkoutBlockWeight(ctx, blockNum, hash, spec, block.Extrinsics, metadataInstant)
+	if weightErr != nil {
+		util.Logger().Error(weightErr)
+	}
+
 	s.dao.SplitBlockTable(blockNum)
 
 	txn := s.dao.DbBegin()
@@ -84,28 +88,40 @@ func (s *Service) CreateChainBlock(ctx context.Context, hash string, block *smod
 		return err
 	}
 
-	cb.Validator = s.blockAuthor(ctx, cb.ParentHash, events, runtimeLogData, sessionIndex)
+	author, missed := s.blockAuthor(ctx, &AuthorBlock{
+		BlockNum:       blockNum,
+		ParentHash:     cb.ParentHash,
+		Events:         events,
+		RuntimeLogData: runtimeLogData,
+		SessionIndex:   sessionIndex,
+	})
+	if author != nil {
+		cb.Validator = author.Validator
+		cb.AuthorSelectionMode = author.SelectionMode
+		if err = s.dao.CreateBlockAuthor(txn, author, missed); err != nil {
+			return err
+		}
+		if err = s.trackEpoch(ctx, txn, &cb, author); err != nil {
+			return err
+		}
+	}
 	cb.CodecError = codecErr != nil
 	cb.ExtrinsicsCount = len(extrinsics)
 	cb.EventCount = len(events)
 
+	if weight != nil {
+		weight.BlockTimestamp = cb.BlockTimestamp
+		if err = s.dao.CreateBlockWeight(txn, weight); err != nil {
+			return err
+		}
+	}
+
 	if err = s.dao.CreateBlock(ctx, txn, &cb); err == nil {
 		s.dao.DbCommit(txn)
-		// emit extrinsic/event process after commit
-		for index := range events {
-			e := events[index]
-			e.BlockNum = blockNum
-			if err = s.emitEvent(&e); err != nil {
-				return err
-			}
-		}
-		for index := range extrinsics {
-			e := extrinsics[index]
-			if err = s.emitExtrinsic(ctx, &e); err != nil {
-				return err
-			}
-		}
-		return s.emitBlock(ctx, &cb)
+		// head responses of api, like latest blocks, are stale
+		_ = s.dao.IncrResponseCacheHead(ctx)
+		s.sinkBlock(&cb, extrinsics, events, chainLogs(blockNum, logs, true))
+		return nil
 	}
 	return err
 }
@@ -160,30 +176,3 @@ func (s *Service) fillExtrinsicHash(blockNum uint, extrinsicList []model.ChainEx
 	}
 	return extrinsicList
 }
-
-func (s *Service) blockAuthor(ctx context.Context, parentHash string, events []model.ChainEvent, runtimeLogData []byte, sessionIndex uint) string {
-	if len(runtimeLogData) > 0 {
-		// check new session
-		var validatorList []string
-		newSession := false
-		for _, e := range events {
-			if strings.EqualFold(e.ModuleId, "Session") && strings.EqualFold(e.EventId, "NewSession") {
-				newSession = true
-			}
-		}
-		// newSession use new validator list
-		if newSession || sessionIndex == 0 {
-			validatorList = s.ValidatorsList(parentHash)
-		} else {
-			validatorList = s.dao.GetSessionValidatorsById(ctx, sessionIndex)
-			// if db not found, use on-chain query
-			if len(validatorList) == 0 {
-				validatorList = s.ValidatorsList(parentHash)
-				// save to db
-				_ = s.dao.CreateNewSession(ctx, sessionIndex, validatorList)
-			}
-		}
-		return address.Format(substrate.ExtractAuthor(runtimeLogData, validatorList))
-	}
-	return ""
-}
@@ -0,0 +1,22 @@
+package service
+
+import "context"
+
+// GetResponseCache cached api response of middlewares.ResponseCache
+func (s *Service) GetResponseCache(ctx context.Context, key string) []byte {
+	return s.dao.GetResponseCache(ctx, key)
+}
+
+func (s *Service) SetResponseCache(ctx context.Context, key string, value []byte, ttl int) error {
+	return s.dao.SetResponseCache(ctx, key, value, ttl)
+}
+
+// ResponseCacheHead generation of head responses, moved when a finalized block is indexed
+func (s *Service) ResponseCacheHead(ctx context.Context) int64 {
+	return s.dao.GetResponseCacheHead(ctx)
+}
+
+// ResponseCacheGeneration generation of all responses, moved when blocks are re-processed or plugin data is reset
+func (s *Service) ResponseCacheGeneration(ctx context.Context) int64 {
+	return s.dao.GetResponseCacheGeneration(ctx)
+}
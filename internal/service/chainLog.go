@@ -4,12 +4,25 @@ import (
 	"fmt"
 	"github.com/itering/subscan/internal/dao"
 	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
 	"github.com/itering/substrate-api-rpc/storage"
 	"strings"
 )
 
 func (s *Service) EmitLog(txn *dao.GormDB, blockNum uint, l []storage.DecoderLog, finalized bool) (runtimeLogData []byte, err error) {
-	var logs []model.ChainLog
+	logs := chainLogs(blockNum, l, finalized)
+	for _, ce := range logs {
+		if strings.EqualFold(ce.LogType, "PreRuntime") {
+			runtimeLogData = ce.Data.Bytes()
+		}
+	}
+	err = s.dao.CreateLog(txn, logs)
+	return
+}
+
+// chainLogs decoded digest logs as chain logs
+func chainLogs(blockNum uint, l []storage.DecoderLog, finalized bool) (logs []model.ChainLog) {
 	for index, logData := range l {
 		var jsonRaw model.LogData
 		switch v := logData.Value.(type) {
@@ -18,7 +31,6 @@ func (s *Service) EmitLog(txn *dao.GormDB, blockNum uint, l []storage.DecoderLog
 		default:
 			jsonRaw = map[string]interface{}{"data": v}
 		}
-
 		ce := model.ChainLog{
 			LogIndex:  fmt.Sprintf("%d-%d", blockNum, index),
 			BlockNum:  blockNum,
@@ -26,12 +38,14 @@ func (s *Service) EmitLog(txn *dao.GormDB, blockNum uint, l []storage.DecoderLog
 			Data:      jsonRaw,
 			Finalized: finalized,
 		}
-		ce.ID = ce.Id()
-		if strings.EqualFold(ce.LogType, "PreRuntime") {
-			runtimeLogData = jsonRaw.Bytes()
+		if item, err := substrate.DecodeDigest(logData.Type, logData.Value); item != nil {
+			if err != nil {
+				util.Logger().Error(fmt.Errorf("block %d log %d %v", blockNum, index, err))
+			}
+			ce.Engine, ce.Kind, ce.Slot, ce.Decoded = item.Engine, item.Kind, item.Slot, item.Decoded
 		}
+		ce.ID = ce.Id()
 		logs = append(logs, ce)
 	}
-	err = s.dao.CreateLog(txn, logs)
 	return
 }
@@ -18,4 +18,11 @@ func TestService_EmitLog(t *testing.T) {
 	assert.NoError(t, err)
 	_, err = testSrv.EmitLog(txn, 300000, logs, true)
 	assert.NoError(t, err)
+
+	chainLogs := chainLogs(300000, logs, true)
+	assert.Equal(t, "BABE", chainLogs[0].Engine)
+	assert.Equal(t, "Primary", chainLogs[0].Kind)
+	assert.Equal(t, uint64(265135855), chainLogs[0].Slot)
+	assert.Equal(t, "", chainLogs[1].Engine)
+	assert.Equal(t, "Seal", chainLogs[2].Kind)
 }
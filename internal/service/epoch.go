@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itering/subscan/internal/cbc"
+	"github.com/itering/subscan/internal/dao"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/address"
+	"github.com/itering/substrate-api-rpc/rpc"
+)
+
+// trackEpoch open epoch of the block author, the previous epoch is closed at the block before
+func (s *Service) trackEpoch(ctx context.Context, txn *dao.GormDB, cb *model.ChainBlock, author *model.ChainBlockAuthor) error {
+	if current := s.dao.GetEpoch(ctx, author.Epoch); current != nil && current.StartBlock <= cb.BlockNum {
+		return nil
+	}
+	epoch := model.ChainEpoch{
+		EpochIndex: author.Epoch,
+		StartBlock: cb.BlockNum,
+		StartAt:    cb.BlockTimestamp,
+		Validators: s.epochValidatorSet(ctx, cb, author.Epoch),
+	}
+	epoch.ValidatorCount = len(epoch.Validators)
+	if author.Epoch > 0 {
+		if previous := s.dao.GetEpoch(ctx, author.Epoch-1); previous != nil {
+			epoch.Joins, epoch.Leaves = validatorSetChanges(previous.Validators, epoch.Validators)
+			if cb.BlockNum > 0 {
+				if err := s.dao.CloseEpoch(txn, previous.EpochIndex, cb.BlockNum-1, cb.BlockTimestamp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	// blocks are not always indexed in order, the next epoch may be saved already,
+	// its changes were computed without this epoch
+	if next := s.dao.GetEpoch(ctx, author.Epoch+1); next != nil && next.StartBlock > cb.BlockNum {
+		epoch.EndBlock, epoch.EndAt = next.StartBlock-1, next.StartAt
+		joins, leaves := validatorSetChanges(epoch.Validators, next.Validators)
+		if err := s.dao.UpdateEpochChanges(txn, next.EpochIndex, joins, leaves); err != nil {
+			return err
+		}
+	}
+	if cbc.IsCBCNetwork(util.NetworkNode) {
+		epoch.BlocksPerEpoch, epoch.MaxValidators = cbcEpochConfig(cb.Hash)
+	}
+	return s.dao.CreateEpoch(txn, &epoch)
+}
+
+// epochValidatorSet validator set of epoch, CBC epoch set or Session.Validators of the block
+func (s *Service) epochValidatorSet(ctx context.Context, cb *model.ChainBlock, epoch uint) (validators []string) {
+	var list []string
+	if cbc.IsCBCNetwork(util.NetworkNode) {
+		list = s.epochValidators(ctx, cb.ParentHash, uint32(epoch))
+	} else if list = s.dao.GetSessionValidatorsById(ctx, epoch); len(list) == 0 {
+		list = s.ValidatorsList(cb.Hash)
+	}
+	for _, validator := range list {
+		if v := address.Format(validator); v != "" {
+			validators = append(validators, v)
+		}
+	}
+	return
+}
+
+// cbcEpochConfig blocks_per_epoch and max_validators of EpochConfig at block, storage of EpochConfig type of cbc.json is
+// configured by CBC_EPOCH_CONFIG_STORAGE, not saved if not configured
+func cbcEpochConfig(hash string) (blocksPerEpoch, maxValidators uint) {
+	if cbc.EpochConfigStorage[0] == "" {
+		return 0, 0
+	}
+	raw, err := rpc.ReadStorage(nil, cbc.EpochConfigStorage[0], cbc.EpochConfigStorage[1], hash)
+	if err != nil {
+		util.Logger().Error(fmt.Errorf("read CBC epoch config of %s.%s error %v", cbc.EpochConfigStorage[0], cbc.EpochConfigStorage[1], err))
+		return 0, 0
+	}
+	if raw == "" {
+		return 0, 0
+	}
+	config := raw.ToMapInterface()
+	if _, ok := config["blocks_per_epoch"]; !ok {
+		util.Logger().Error(fmt.Errorf("CBC epoch config %s.%s is not EpochConfig: %s", cbc.EpochConfigStorage[0], cbc.EpochConfigStorage[1], raw))
+		return 0, 0
+	}
+	return util.UIntFromInterface(config["blocks_per_epoch"]), util.UIntFromInterface(config["max_validators"])
+}
+
+// validatorSetChanges validators joined and left from previous set
+func validatorSetChanges(previous, current []string) (joins, leaves []string) {
+	inPrevious := make(map[string]bool, len(previous))
+	for _, v := range previous {
+		inPrevious[v] = true
+	}
+	inCurrent := make(map[string]bool, len(current))
+	for _, v := range current {
+		inCurrent[v] = true
+		if !inPrevious[v] {
+			joins = append(joins, v)
+		}
+	}
+	for _, v := range previous {
+		if !inCurrent[v] {
+			leaves = append(leaves, v)
+		}
+	}
+	return
+}
+
+func epochAsJson(epoch *model.ChainEpoch) *model.ChainEpoch {
+	encode := func(list model.SessionValidator) (encoded model.SessionValidator) {
+		for _, v := range list {
+			encoded = append(encoded, address.Encode(v))
+		}
+		return
+	}
+	epoch.Validators, epoch.Joins, epoch.Leaves = encode(epoch.Validators), encode(epoch.Joins), encode(epoch.Leaves)
+	return epoch
+}
+
+func (s *Service) GetEpochList(ctx context.Context, limit int, after *uint) ([]model.ChainEpoch, CursorPage) {
+	list, hasNext := s.dao.GetEpochList(ctx, limit, after)
+	page := CursorPage{HasNextPage: hasNext, HasPreviousPage: after != nil}
+	for i := range list {
+		list[i] = *epochAsJson(&list[i])
+	}
+	if len(list) > 0 {
+		start, end := list[0].EpochIndex, list[len(list)-1].EpochIndex
+		page.StartCursor, page.EndCursor = &start, &end
+	}
+	return list, page
+}
+
+func (s *Service) GetEpoch(ctx context.Context, epochIndex uint) *model.ChainEpoch {
+	epoch := s.dao.GetEpoch(ctx, epochIndex)
+	if epoch == nil {
+		return nil
+	}
+	return epochAsJson(epoch)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itering/subscan/internal/dao"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/util/address"
+	"github.com/stretchr/testify/assert"
+)
+
+// epochDao records saved epochs
+type epochDao struct {
+	*MockDao
+	created []model.ChainEpoch
+	closed  map[uint]uint
+	changes map[uint][2]model.SessionValidator
+}
+
+func (d *epochDao) CreateEpoch(txn *dao.GormDB, epoch *model.ChainEpoch) error {
+	d.created = append(d.created, *epoch)
+	return nil
+}
+
+func (d *epochDao) CloseEpoch(txn *dao.GormDB, epochIndex, endBlock uint, endAt int) error {
+	d.closed[epochIndex] = endBlock
+	return nil
+}
+
+func (d *epochDao) UpdateEpochChanges(txn *dao.GormDB, epochIndex uint, joins, leaves model.SessionValidator) error {
+	d.changes[epochIndex] = [2]model.SessionValidator{joins, leaves}
+	return nil
+}
+
+func (d *epochDao) GetSessionValidatorsById(ctx context.Context, sessionId uint) []string {
+	if sessionId == testCBCEpoch-2 {
+		return testCBCValidators[:2]
+	}
+	return d.MockDao.GetSessionValidatorsById(ctx, sessionId)
+}
+
+func Test_validatorSetChanges(t *testing.T) {
+	joins, leaves := validatorSetChanges([]string{"a", "b", "c"}, []string{"b", "c", "d"})
+	assert.Equal(t, []string{"d"}, joins)
+	assert.Equal(t, []string{"a"}, leaves)
+
+	joins, leaves = validatorSetChanges([]string{"a"}, []string{"a"})
+	assert.Nil(t, joins)
+	assert.Nil(t, leaves)
+}
+
+func TestService_trackEpoch(t *testing.T) {
+	ctx := context.Background()
+	d := &epochDao{MockDao: &MockDao{}, closed: map[uint]uint{}, changes: map[uint][2]model.SessionValidator{}}
+	s := &Service{dao: d}
+	cb := &model.ChainBlock{BlockNum: 100, BlockTimestamp: 1700000000}
+
+	assert.NoError(t, s.trackEpoch(ctx, nil, cb, &model.ChainBlockAuthor{BlockNum: 100, Epoch: testCBCEpoch}))
+	assert.Equal(t, []model.ChainEpoch{{
+		EpochIndex:     testCBCEpoch,
+		StartBlock:     100,
+		StartAt:        1700000000,
+		ValidatorCount: 3,
+		Validators:     testCBCValidators,
+		Joins:          model.SessionValidator{testCBCValidators[0]},
+	}}, d.created)
+	assert.Equal(t, map[uint]uint{testCBCEpoch - 1: 99}, d.closed)
+
+	// epoch already started at an earlier block
+	d.created = nil
+	assert.NoError(t, s.trackEpoch(ctx, nil, &model.ChainBlock{BlockNum: 5}, &model.ChainBlockAuthor{Epoch: testCBCEpoch - 1}))
+	assert.Nil(t, d.created)
+
+	// previous epoch saved after the epoch, changes of the epoch are recomputed
+	assert.NoError(t, s.trackEpoch(ctx, nil, &model.ChainBlock{BlockNum: 0}, &model.ChainBlockAuthor{Epoch: testCBCEpoch - 2}))
+	assert.Equal(t, map[uint][2]model.SessionValidator{
+		testCBCEpoch - 1: {{testCBCValidators[2]}, {testCBCValidators[0]}},
+	}, d.changes)
+}
+
+func TestService_GetEpochList(t *testing.T) {
+	list, page := testSrv.GetEpochList(context.Background(), 10, nil)
+	assert.Len(t, list, 1)
+	assert.Equal(t, uint(testCBCEpoch-1), *page.EndCursor)
+	assert.False(t, page.HasNextPage)
+
+	epoch := testSrv.GetEpoch(context.Background(), testCBCEpoch-1)
+	assert.Equal(t, address.Encode(testCBCValidators[1]), epoch.Validators[0])
+	assert.Nil(t, testSrv.GetEpoch(context.Background(), 1))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/itering/subscan/internal/dao"
 	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/substrate"
 	"github.com/itering/subscan/util"
 	"github.com/itering/subscan/util/address"
 )
@@ -19,7 +20,10 @@ func (s *Service) createExtrinsic(ctx context.Context,
 	eventMap map[string][]model.ChainEvent,
 ) (err error) {
 
-	var countSignedExtrinsic int
+	var (
+		countSignedExtrinsic int
+		nestedCalls          []model.ChainExtrinsicCall
+	)
 
 	for index, extrinsic := range extrinsics {
 		extrinsics[index].BlockNum = block.BlockNum
@@ -40,11 +44,28 @@ func (s *Service) createExtrinsic(ctx context.Context,
 			}
 		}
 		extrinsics[index].ID = extrinsics[index].Id()
+		nestedCalls = append(nestedCalls, extrinsicNestedCalls(&extrinsics[index], extrinsic.Params)...)
 	}
 	if err = s.dao.CreateExtrinsic(ctx, txn, extrinsics, countSignedExtrinsic); err != nil {
 		return err
 	}
-	return nil
+	return s.dao.CreateExtrinsicCalls(txn, nestedCalls)
+}
+
+// extrinsicNestedCalls index sub calls of utility batch/proxy/multisig/sudo extrinsic
+func extrinsicNestedCalls(extrinsic *model.ChainExtrinsic, params model.ExtrinsicParams) (calls []model.ChainExtrinsicCall) {
+	tree := substrate.ParseCallTree(extrinsic.CallModule, extrinsic.CallModuleFunction, params)
+	for _, call := range tree.SubCalls() {
+		calls = append(calls, model.ChainExtrinsicCall{
+			ExtrinsicId:        extrinsic.ID,
+			ExtrinsicIndex:     extrinsic.ExtrinsicIndex,
+			BlockNum:           extrinsic.BlockNum,
+			CallPath:           call.Path,
+			CallModule:         call.CallModule,
+			CallModuleFunction: call.CallModuleFunction,
+		})
+	}
+	return
 }
 
 func (s *Service) ExtrinsicsAsJson(e *model.ChainExtrinsic) *model.ChainExtrinsicJson {
@@ -11,6 +11,7 @@ import (
 	"github.com/itering/substrate-api-rpc/storage"
 	"github.com/itering/substrate-api-rpc/websocket"
 	"github.com/shopspring/decimal"
+	"math/big"
 	"math/rand"
 	"strings"
 )
@@ -157,3 +158,70 @@ func GetExtrinsicFee(ctx context.Context, encodeExtrinsic, hash string, spec int
 	}
 	return finalFee, actualFeeByEvent, nil
 }
+
+// GetCallFeeDetails fee details of call at block hash, by runtime api TransactionPaymentCallApi
+func GetCallFeeDetails(_ context.Context, encodedCall, hash string) (*PaymentQueryFeeDetails, error) {
+	return stateCallFeeDetails("TransactionPaymentCallApi_query_call_fee_details", encodedCall, hash)
+}
+
+// GetExtrinsicFeeDetails fee details of signed or unsigned extrinsic at block hash
+// runtime api TransactionPaymentApi is called if rpc payment_queryFeeDetails unavailable
+func GetExtrinsicFeeDetails(ctx context.Context, encodedExtrinsic, hash string) (*PaymentQueryFeeDetails, error) {
+	if feeDetails, err := GetPaymentQueryFeeDetails(ctx, encodedExtrinsic, hash); err == nil && feeDetails != nil {
+		return feeDetails, nil
+	}
+	return stateCallFeeDetails("TransactionPaymentApi_query_fee_details", encodedExtrinsic, hash)
+}
+
+func stateCallFeeDetails(method, encoded, hash string) (*PaymentQueryFeeDetails, error) {
+	// runtime api args: encoded, u32 length of encoded
+	data := util.AddHex(encoded) + types.Encode("U32", len(util.HexToBytes(encoded)))
+	v := &model.JsonRpcResult{}
+	if err := websocket.SendWsRequest(nil, v, StateCall(rand.Intn(10000), method, data, hash)); err != nil {
+		return nil, err
+	}
+	if err := v.CheckErr(); err != nil {
+		return nil, err
+	}
+	raw, _ := v.ToString()
+	return decodeFeeDetails(raw)
+}
+
+// StateCall rpc state_call of runtime api method
+func StateCall(id int, method, data, hash string) []byte {
+	params := []string{method, data}
+	if hash != "" {
+		params = append(params, hash)
+	}
+	p := rpc.Param{Id: id, Method: "state_call", Params: params}
+	p.JsonRpc = "2.0"
+	b, _ := json.Marshal(p)
+	return b
+}
+
+// decodeFeeDetails scale FeeDetails{inclusion_fee: Option<InclusionFee>, tip}, balances are u128
+func decodeFeeDetails(raw string) (*PaymentQueryFeeDetails, error) {
+	const balanceSize = 16
+	data := util.HexToBytes(raw)
+	if len(data) == 0 {
+		return nil, InvalidValue
+	}
+	feeDetails := new(PaymentQueryFeeDetails)
+	if data[0] == 0 {
+		return feeDetails, nil
+	}
+	if len(data) < 1+3*balanceSize {
+		return nil, InvalidValue
+	}
+	var fees [3]decimal.Decimal
+	for i := range fees {
+		le := data[1+i*balanceSize : 1+(i+1)*balanceSize]
+		be := make([]byte, balanceSize)
+		for j := range le {
+			be[balanceSize-1-j] = le[j]
+		}
+		fees[i] = decimal.NewFromBigInt(new(big.Int).SetBytes(be), 0)
+	}
+	feeDetails.InclusionFee = &inclusionFee{BaseFee: fees[0], LenFee: fees[1], AdjustedWeightFee: fees[2]}
+	return feeDetails, nil
+}
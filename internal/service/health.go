@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/plugins"
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/mq"
+	rpcModel "github.com/itering/substrate-api-rpc/model"
+	"github.com/itering/substrate-api-rpc/rpc"
+	"github.com/itering/substrate-api-rpc/websocket"
+)
+
+const (
+	healthCheckTimeout = 3 * time.Second
+	readinessCacheTTL  = 5 * time.Second
+)
+
+// nodeFinalizedHead node health and finalized block num, replaceable in test
+var nodeFinalizedHead = func(_ context.Context) (*rpcModel.HealthResult, uint64, error) {
+	v := &rpcModel.JsonRpcResult{}
+	if err := websocket.SendWsRequest(nil, v, rpc.SystemHealth(rand.Intn(10000))); err != nil {
+		return nil, 0, err
+	}
+	health := v.ToSysHealth()
+	if health == nil {
+		return nil, 0, errors.New("invalid system_health result")
+	}
+	if err := websocket.SendWsRequest(nil, v, rpcRequest("chain_getFinalizedHead")); err != nil {
+		return health, 0, err
+	}
+	hash, err := v.ToString()
+	if err != nil {
+		return health, 0, err
+	}
+	if err = websocket.SendWsRequest(nil, v, rpcRequest("chain_getHeader", hash)); err != nil {
+		return health, 0, err
+	}
+	head := v.ToNewHead()
+	if head == nil {
+		return health, 0, errors.New("invalid chain_getHeader result")
+	}
+	return health, uint64(util.U256(head.Number).Int64()), nil
+}
+
+func rpcRequest(method string, params ...string) []byte {
+	p := rpc.Param{Id: rand.Intn(10000), Method: method, Params: append([]string{}, params...)}
+	p.JsonRpc = "2.0"
+	b, _ := json.Marshal(p)
+	return b
+}
+
+// healthThreshold max block lag and max replica delay seconds
+func healthThreshold() (maxBlockLag, maxReplicaDelay int) {
+	maxBlockLag, maxReplicaDelay = 100, 60
+	if h := configs.Boot.Health; h != nil {
+		if h.MaxBlockLag != nil {
+			maxBlockLag = *h.MaxBlockLag
+		}
+		if h.MaxReplicaDelay != nil {
+			maxReplicaDelay = *h.MaxReplicaDelay
+		}
+	}
+	return
+}
+
+type nodeHealth struct {
+	*rpcModel.HealthResult
+	FinalizedHead uint64 `json:"finalized_head"`
+}
+
+type checkResult struct {
+	detail interface{}
+	err    error
+}
+
+// runCheck run fn with context deadline, fill status, latency and detail of check
+func runCheck(ctx context.Context, check *model.HealthCheck, fn func() (interface{}, error)) {
+	start := time.Now()
+	done := make(chan checkResult, 1)
+	go func() {
+		detail, err := fn()
+		done <- checkResult{detail: detail, err: err}
+	}()
+	var result checkResult
+	select {
+	case result = <-done:
+	case <-ctx.Done():
+		result.err = ctx.Err()
+	}
+	check.Latency = time.Since(start).Milliseconds()
+	check.Status, check.Detail = model.HealthUp, result.detail
+	if result.err != nil {
+		check.Status, check.Error = model.HealthDown, result.err.Error()
+	}
+}
+
+// Health check db, redis, node rpc, indexer lag, worker queues and plugins
+// service is down when any critical check down, degraded when other check down
+func (s *Service) Health(ctx context.Context) *model.Health {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	checks := map[string]*model.HealthCheck{
+		"db":      {Critical: true},
+		"redis":   {Critical: true},
+		"rpc":     {},
+		"queue":   {},
+		"replica": {},
+	}
+	fns := map[string]func() (interface{}, error){
+		"db":    func() (interface{}, error) { return s.checkDb(ctx) },
+		"redis": func() (interface{}, error) { return nil, s.dao.Ping(ctx) },
+		"rpc": func() (interface{}, error) {
+			health, head, err := nodeFinalizedHead(ctx)
+			return &nodeHealth{HealthResult: health, FinalizedHead: head}, err
+		},
+		"queue":   func() (interface{}, error) { return s.checkQueue(ctx) },
+		"replica": func() (interface{}, error) { return s.checkReplica(ctx) },
+	}
+	var wg sync.WaitGroup
+	for name, fn := range fns {
+		wg.Add(1)
+		go func(check *model.HealthCheck, fn func() (interface{}, error)) {
+			defer wg.Done()
+			runCheck(ctx, check, fn)
+		}(checks[name], fn)
+	}
+	wg.Wait()
+
+	// prefer finalized head from node, the head in redis stop updating when subscribe process down
+	var head uint64
+	if node, ok := checks["rpc"].Detail.(*nodeHealth); ok && checks["rpc"].Status == model.HealthUp {
+		head = node.FinalizedHead
+	}
+	checks["lag"] = &model.HealthCheck{Critical: true}
+	runCheck(ctx, checks["lag"], func() (interface{}, error) { return s.checkLag(ctx, head) })
+	var finalized int
+	if lag, ok := checks["lag"].Detail.(*model.HealthLag); ok && lag != nil {
+		finalized = lag.Finalized
+	}
+	checks["plugins"] = &model.HealthCheck{}
+	runCheck(ctx, checks["plugins"], func() (interface{}, error) { return s.checkPlugins(ctx, finalized) })
+
+	health := &model.Health{Status: model.HealthUp, Checks: checks}
+	for _, check := range checks {
+		if check.Status == model.HealthUp {
+			continue
+		}
+		if check.Critical {
+			health.Status = model.HealthDown
+			break
+		}
+		health.Status = model.HealthDegraded
+	}
+	return health
+}
+
+// readiness last Health, shared by probes within readinessCacheTTL
+var readiness struct {
+	sync.Mutex
+	health    *model.Health
+	checkedAt time.Time
+}
+
+// Readiness cached Health, probes and /health of every pod run all checks (rpc included) at most once per readinessCacheTTL
+func (s *Service) Readiness(ctx context.Context) *model.Health {
+	readiness.Lock()
+	defer readiness.Unlock()
+	if readiness.health != nil && time.Since(readiness.checkedAt) < readinessCacheTTL {
+		return readiness.health
+	}
+	readiness.health, readiness.checkedAt = s.Health(ctx), time.Now()
+	return readiness.health
+}
+
+func (s *Service) checkDb(ctx context.Context) (interface{}, error) {
+	if err := s.dao.PingDb(ctx); err != nil {
+		return nil, err
+	}
+	delay, replica, err := s.dao.GetReplicaDelay(ctx)
+	if err != nil || !replica {
+		return nil, err
+	}
+	_, maxDelay := healthThreshold()
+	detail := map[string]int{"replica_delay": delay, "max_replica_delay": maxDelay}
+	if delay > maxDelay {
+		return detail, fmt.Errorf("replica delay %ds exceeds %ds", delay, maxDelay)
+	}
+	return detail, nil
+}
+
+// checkReplica replica out of rotation not break api, reads fallback to other replicas or primary
+func (s *Service) checkReplica(ctx context.Context) ([]model.DbReplica, error) {
+	replicas := s.dao.GetReplicas(ctx)
+	var evicted []string
+	for _, replica := range replicas {
+		if !replica.Healthy {
+			evicted = append(evicted, replica.Name)
+		}
+	}
+	if len(evicted) > 0 {
+		return replicas, fmt.Errorf("replica %s out of rotation", strings.Join(evicted, ","))
+	}
+	return replicas, nil
+}
+
+func (s *Service) checkLag(ctx context.Context, head uint64) (*model.HealthLag, error) {
+	if head == 0 {
+		var err error
+		if head, err = s.dao.GetFinalizedBlockNum(ctx); err != nil {
+			return nil, err
+		}
+	}
+	finalized, err := s.dao.GetFillFinalizedBlockNum(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxLag, _ := healthThreshold()
+	lag := &model.HealthLag{Head: head, Finalized: finalized, Lag: int(head) - finalized, MaxLag: maxLag}
+	if lag.Lag > lag.MaxLag {
+		return lag, fmt.Errorf("indexer is %d blocks behind finalized head", lag.Lag)
+	}
+	return lag, nil
+}
+
+func (s *Service) checkQueue(ctx context.Context) (map[string]int, error) {
+	if mq.Instant == nil {
+		return nil, nil
+	}
+	depth := make(map[string]int)
+	for _, queue := range workerQueues() {
+		n, err := mq.Instant.QueueDepth(ctx, queue)
+		if err != nil {
+			return depth, err
+		}
+		depth[queue] = n
+	}
+	return depth, nil
+}
+
+func (s *Service) checkPlugins(ctx context.Context, finalized int) (map[string]model.HealthPlugin, error) {
+	var names []string
+	for name := range plugins.RegisteredPlugins {
+		names = append(names, name)
+	}
+	checkpoints, err := s.dao.GetPluginCheckpoints(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	detail := make(map[string]model.HealthPlugin)
+	var unhealthy []string
+	for name, plugin := range plugins.RegisteredPlugins {
+		p := model.HealthPlugin{Version: plugin.Version()}
+		if next := checkpoints[name]; next > 0 {
+			p.LastBlock = int(next) - 1
+		}
+		if p.LastBlock > 0 && finalized > p.LastBlock {
+			p.Lag = finalized - p.LastBlock
+		}
+		if reporter, ok := plugin.(plugins.HealthReporter); ok {
+			if err = reporter.Health(ctx); err != nil {
+				p.Error = err.Error()
+				unhealthy = append(unhealthy, name)
+			}
+		}
+		detail[name] = p
+	}
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		return detail, fmt.Errorf("plugin %s unhealthy", strings.Join(unhealthy, ","))
+	}
+	return detail, nil
+}
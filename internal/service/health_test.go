@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/model"
+	rpcModel "github.com/itering/substrate-api-rpc/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Health(t *testing.T) {
+	origin := nodeFinalizedHead
+	defer func() { nodeFinalizedHead = origin }()
+
+	newHealthSrv := func(filled int, replicaDelay int) *Service {
+		d := &MockDao{}
+		d.On("GetReplicaDelay", mock.Anything).Return(replicaDelay, replicaDelay > 0, nil)
+		d.On("GetFinalizedBlockNum", mock.Anything).Return(uint64(1000), nil)
+		d.On("GetFillFinalizedBlockNum", mock.Anything).Return(filled, nil)
+		d.On("GetPluginCheckpoints", mock.Anything, mock.Anything).Return(map[string]uint{}, nil)
+		d.On("GetReplicas", mock.Anything).Return([]model.DbReplica{{Name: "replica-0", Delay: replicaDelay, Healthy: replicaDelay <= 60}})
+		return &Service{dao: d}
+	}
+
+	// node head
+	nodeFinalizedHead = func(context.Context) (*rpcModel.HealthResult, uint64, error) {
+		return &rpcModel.HealthResult{Peers: 10}, 1010, nil
+	}
+	h := newHealthSrv(1000, 0).Health(context.TODO())
+	assert.Equal(t, model.HealthUp, h.Status)
+	assert.Equal(t, &model.HealthLag{Head: 1010, Finalized: 1000, Lag: 10, MaxLag: 100}, h.Checks["lag"].Detail)
+
+	// indexer lag exceeds
+	h = newHealthSrv(800, 0).Health(context.TODO())
+	assert.Equal(t, model.HealthDown, h.Status)
+	assert.Equal(t, model.HealthDown, h.Checks["lag"].Status)
+
+	// replica delay exceeds
+	h = newHealthSrv(1000, 120).Health(context.TODO())
+	assert.Equal(t, model.HealthDown, h.Checks["db"].Status)
+	assert.Equal(t, model.HealthDown, h.Checks["replica"].Status)
+
+	// rpc down, fallback to head in redis
+	nodeFinalizedHead = func(context.Context) (*rpcModel.HealthResult, uint64, error) {
+		return nil, 0, errors.New("dial error")
+	}
+	h = newHealthSrv(1000, 0).Health(context.TODO())
+	assert.Equal(t, model.HealthDegraded, h.Status)
+	assert.Equal(t, uint64(1000), h.Checks["lag"].Detail.(*model.HealthLag).Head)
+}
+
+func TestService_Readiness(t *testing.T) {
+	origin, originConf := nodeFinalizedHead, configs.Boot.Health
+	defer func() { nodeFinalizedHead, configs.Boot.Health = origin, originConf }()
+	calls := 0
+	nodeFinalizedHead = func(context.Context) (*rpcModel.HealthResult, uint64, error) {
+		calls++
+		return &rpcModel.HealthResult{Peers: 10}, 1001, nil
+	}
+	// threshold 0 is kept, indexer must be at finalized head
+	zero := 0
+	configs.Boot.Health = &configs.Health{MaxBlockLag: &zero, MaxReplicaDelay: &zero}
+
+	d := &MockDao{}
+	d.On("GetReplicaDelay", mock.Anything).Return(0, false, nil)
+	d.On("GetFillFinalizedBlockNum", mock.Anything).Return(1000, nil)
+	d.On("GetPluginCheckpoints", mock.Anything, mock.Anything).Return(map[string]uint{}, nil)
+	d.On("GetReplicas", mock.Anything).Return([]model.DbReplica{})
+	s := &Service{dao: d}
+
+	h := s.Readiness(context.TODO())
+	assert.Equal(t, model.HealthDown, h.Status)
+	assert.Equal(t, 0, h.Checks["lag"].Detail.(*model.HealthLag).MaxLag)
+	assert.Same(t, h, s.Readiness(context.TODO()))
+	assert.Equal(t, 1, calls)
+}
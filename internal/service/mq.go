@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/plugins"
+	"github.com/itering/subscan/util/mq"
+)
+
+// workerQueues core queues and queues consumed by plugins
+func workerQueues() []string {
+	queues := append([]string{}, mq.CoreQueues...)
+	for _, plugin := range plugins.RegisteredPlugins {
+		queues = append(queues, plugin.ConsumptionQueue()...)
+	}
+	return queues
+}
+
+// MQQueues depth and dead letters count of worker queues
+func (s *Service) MQQueues(ctx context.Context) ([]model.MQQueue, error) {
+	dead, err := mq.DeadLetters.Queues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queues := make(map[string]*model.MQQueue)
+	for _, queue := range workerQueues() {
+		queues[queue] = &model.MQQueue{Queue: queue}
+	}
+	for queue, count := range dead {
+		if queues[queue] == nil {
+			queues[queue] = &model.MQQueue{Queue: queue}
+		}
+		queues[queue].DeadLetters = count
+	}
+	var list []model.MQQueue
+	for _, q := range queues {
+		if mq.Instant != nil {
+			if q.Depth, err = mq.Instant.QueueDepth(ctx, q.Queue); err != nil {
+				return nil, err
+			}
+		}
+		list = append(list, *q)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Queue < list[j].Queue })
+	return list, nil
+}
+
+func (s *Service) DeadLetters(ctx context.Context, queue string, page, row int) ([]mq.Message, int, error) {
+	return mq.DeadLetters.List(ctx, queue, page, row)
+}
+
+// ReplayDeadLetters publish dead letters to queue again, replay all dead letters of queue if ids empty
+func (s *Service) ReplayDeadLetters(ctx context.Context, queue string, ids []string) (replayed int, err error) {
+	if len(ids) == 0 {
+		if ids, err = s.deadLetterIds(ctx, queue); err != nil {
+			return 0, err
+		}
+	}
+	for _, id := range ids {
+		if err = mq.Replay(ctx, queue, id); err != nil {
+			return replayed, err
+		}
+		replayed++
+	}
+	return replayed, nil
+}
+
+func (s *Service) DeleteDeadLetters(ctx context.Context, queue string, ids []string) error {
+	for _, id := range ids {
+		if err := mq.DeadLetters.Remove(ctx, queue, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) deadLetterIds(ctx context.Context, queue string) (ids []string, err error) {
+	const row = 1000
+	for page := 0; ; page++ {
+		list, count, err := mq.DeadLetters.List(ctx, queue, page, row)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range list {
+			ids = append(ids, msg.Id)
+		}
+		if (page+1)*row >= count {
+			return ids, nil
+		}
+	}
+}
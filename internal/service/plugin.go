@@ -3,13 +3,20 @@ package service
 import (
 	"context"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/storage"
 	"github.com/itering/subscan/internal/dao"
-	"github.com/itering/subscan/model"
 	"github.com/itering/subscan/plugins"
+	"github.com/itering/subscan/share/metrics"
 	redisDao "github.com/itering/subscan/share/redis"
 	"github.com/itering/subscan/util"
-	"github.com/itering/subscan/util/mq"
-	"strings"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -20,9 +27,7 @@ var (
 // registered storage
 func pluginRegister(ds *dao.DbStorage, pool *redisDao.Dao) {
 	for name, plugin := range plugins.RegisteredPlugins {
-		db := *ds
-		db.Prefix = name
-		plugin.InitDao(&db)
+		plugin.InitDao(ds.ForPlugin(name))
 		plugin.SetRedisPool(pool)
 		for _, moduleId := range plugin.SubscribeExtrinsic() {
 			subscribeExtrinsic[moduleId] = append(subscribeExtrinsic[moduleId], name)
@@ -35,43 +40,188 @@ func pluginRegister(ds *dao.DbStorage, pool *redisDao.Dao) {
 
 var ignoreEvent = []string{"system.ExtrinsicSuccess"}
 
-// after event created, emit event data to subscribe plugins
-func (s *Service) emitEvent(event *model.ChainEvent) (err error) {
-	// ignore some event
-	if util.StringInSliceFold(fmt.Sprintf("%s.%s", event.ModuleId, event.EventId), ignoreEvent) {
-		return
+const (
+	pluginLockTTL      = 60 // seconds
+	pluginBatchBlocks  = 100
+	pluginIdleInterval = 3 * time.Second
+)
+
+// RunPluginCheckpoints deliver finalized blocks to every enabled plugin in block order from plugin checkpoint,
+// only one process deliver blocks to a plugin at the same time, return after ctx done
+func (s *Service) RunPluginCheckpoints(ctx context.Context) {
+	hostname, _ := os.Hostname()
+	token := fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	var wg sync.WaitGroup
+	for name, plugin := range plugins.RegisteredPlugins {
+		if !plugin.Enable() {
+			continue
+		}
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			s.runPluginCheckpoint(ctx, name, token)
+		}(name)
 	}
-	for _, pluginName := range subscribeEvent[strings.ToLower(event.ModuleId)] {
-		if plugins.RegisteredPlugins[pluginName].Enable() {
-			if err = mq.Instant.Publish("plugin-event", "process", map[string]interface{}{"event_index": event.EventIndex(), "plugin_name": pluginName}); err != nil {
-				return err
-			}
+	wg.Wait()
+}
+
+func (s *Service) runPluginCheckpoint(ctx context.Context, name, token string) {
+	lock := pluginLockKey(name)
+	defer s.dao.ReleaseLock(context.Background(), lock, token)
+	for {
+		wait := pluginIdleInterval
+		processed, err := s.processPluginBlocks(ctx, name, token, pluginBatchBlocks)
+		if err != nil {
+			util.Logger().Error(fmt.Errorf("plugin %s process blocks error: %v", name, err))
+		} else if processed == pluginBatchBlocks {
+			wait = 0
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
 		}
 	}
-	return nil
 }
 
-func (s *Service) emitBlock(_ context.Context, block *model.ChainBlock) (err error) {
-	for name, plugin := range plugins.RegisteredPlugins {
-		if plugin.Enable() {
-			if mq.Instant != nil {
-				if err = mq.Instant.Publish("plugin-block", "process", map[string]interface{}{"block_num": block.BlockNum, "plugin_name": name}); err != nil {
-					return err
-				}
-			}
+func pluginLockKey(name string) string {
+	return "plugin:lock:" + name
+}
+
+// processPluginBlocks deliver at most limit blocks from checkpoint, checkpoint is advanced after every block delivered
+func (s *Service) processPluginBlocks(ctx context.Context, name, token string, limit int) (processed int, err error) {
+	checkpoint, err := s.dao.GetPluginCheckpoint(ctx, name)
+	if err != nil {
+		return
+	}
+	next := checkpoint.Next
+	finalized, err := s.dao.GetFillFinalizedBlockNum(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		metrics.PluginCheckpoint.WithLabelValues(name).Set(float64(next))
+		metrics.PluginLag.WithLabelValues(name).Set(float64(max(finalized+1-int(next), 0)))
+	}()
+	interrupted := checkpoint.Delivering
+	for ; processed < limit && int(next) <= finalized && ctx.Err() == nil; processed++ {
+		// lock extended every block, lost lock means other process is delivering
+		if !s.dao.AcquireLock(ctx, pluginLockKey(name), token, pluginLockTTL) {
+			return
 		}
+		var advanced bool
+		if advanced, err = s.deliverPluginBlock(ctx, name, next, interrupted); err != nil || !advanced {
+			// checkpoint reset, reload at next round
+			return
+		}
+		interrupted = false
+		next++
 	}
 	return
 }
 
-// after extrinsic created, emit extrinsic data to subscribe plugins
-func (s *Service) emitExtrinsic(_ context.Context, extrinsic *model.ChainExtrinsic) (err error) {
-	for _, pluginName := range subscribeExtrinsic[extrinsic.CallModule] {
-		if plugins.RegisteredPlugins[pluginName].Enable() {
-			if err = mq.Instant.Publish("plugin-extrinsic", "process", map[string]interface{}{"extrinsic_index": extrinsic.ExtrinsicIndex, "plugin_name": pluginName}); err != nil {
-				return err
-			}
+// deliverPluginBlock deliver block exactly once and advance checkpoint, false if checkpoint is reset by others.
+// Plugin writing through its storage is delivered in the transaction advancing checkpoint, other plugin must implement
+// plugins.Rollbacker, data of block interrupted by last delivery is rolled back before delivered again
+func (s *Service) deliverPluginBlock(ctx context.Context, name string, blockNum uint, interrupted bool) (bool, error) {
+	plugin, ok := plugins.RegisteredPlugins[name]
+	if !ok {
+		return false, fmt.Errorf("plugin %s not registered", name)
+	}
+	advanced, err := s.deliverPluginCheckpoint(ctx, name, plugin, blockNum, interrupted)
+	if committer, ok := plugin.(plugins.BlockCommitter); ok && err == nil && advanced {
+		committer.CommitBlock(ctx, blockNum)
+	}
+	return advanced, err
+}
+
+func (s *Service) deliverPluginCheckpoint(ctx context.Context, name string, plugin subscan_plugin.Plugin, blockNum uint, interrupted bool) (bool, error) {
+	if deliverer, ok := plugin.(plugins.TxDeliverer); ok {
+		return s.dao.DeliverPluginBlock(ctx, name, blockNum, func(d storage.Dao) error {
+			return deliverer.DeliverTx(d, func(p subscan_plugin.Plugin) error {
+				return s.processPluginBlock(ctx, name, p, blockNum)
+			})
+		})
+	}
+	rollbacker, ok := plugin.(plugins.Rollbacker)
+	if !ok {
+		return false, fmt.Errorf("plugin %s supports neither transactional delivery nor rollback", name)
+	}
+	if interrupted {
+		if err := rollbacker.Rollback(ctx, blockNum); err != nil {
+			return false, fmt.Errorf("plugin %s rollback interrupted block %d: %v", name, blockNum, err)
+		}
+	}
+	if marked, err := s.dao.MarkPluginDelivering(ctx, name, blockNum); err != nil || !marked {
+		return false, err
+	}
+	if err := s.processPluginBlock(ctx, name, plugin, blockNum); err != nil {
+		return false, err
+	}
+	return s.dao.AdvancePluginCheckpoint(ctx, name, blockNum, blockNum+1)
+}
+
+// processPluginBlock deliver subscribed events, subscribed extrinsics with their events and then block to plugin
+func (s *Service) processPluginBlock(ctx context.Context, name string, plugin subscan_plugin.Plugin, blockNum uint) error {
+	data, err := s.dao.GetBlockRangeData(ctx, blockNum, blockNum)
+	if err != nil {
+		return err
+	}
+	if len(data.Blocks) == 0 {
+		return fmt.Errorf("block %d not found", blockNum)
+	}
+	block := data.Blocks[0].AsPlugin()
+	sort.Slice(data.Events, func(i, j int) bool { return data.Events[i].EventIdx < data.Events[j].EventIdx })
+	sort.Slice(data.Extrinsics, func(i, j int) bool { return data.Extrinsics[i].ID < data.Extrinsics[j].ID })
+
+	for index := range data.Events {
+		event := data.Events[index]
+		// ignore some event
+		if util.StringInSliceFold(fmt.Sprintf("%s.%s", event.ModuleId, event.EventId), ignoreEvent) {
+			continue
+		}
+		if !util.StringInSlice(name, subscribeEvent[strings.ToLower(event.ModuleId)]) {
+			continue
+		}
+		if err = plugin.ProcessEvent(block, event.AsPlugin(), decimal.Zero); err != nil {
+			return err
 		}
 	}
-	return nil
+
+	extrinsicEvents := s.checkoutExtrinsicEvents(data.Events, blockNum)
+	for index := range data.Extrinsics {
+		extrinsic := data.Extrinsics[index]
+		if !util.StringInSlice(name, subscribeExtrinsic[strings.ToLower(extrinsic.CallModule)]) {
+			continue
+		}
+		var events []storage.Event
+		for _, event := range extrinsicEvents[extrinsic.ExtrinsicIndex] {
+			events = append(events, *event.AsPlugin())
+		}
+		if err = plugin.ProcessExtrinsic(block, extrinsic.AsPlugin(), events); err != nil {
+			return err
+		}
+	}
+	return plugin.ProcessBlock(ctx, block)
+}
+
+// ResetPluginCheckpoint blocks from block num delivered to plugin again, data of plugin from block num is deleted first
+func (s *Service) ResetPluginCheckpoint(ctx context.Context, name string, from uint) error {
+	plugin, ok := plugins.RegisteredPlugins[name]
+	if !ok {
+		return fmt.Errorf("plugin %s not registered", name)
+	}
+	rollbacker, ok := plugin.(plugins.Rollbacker)
+	if !ok {
+		// blocks delivered again on kept data would be duplicated
+		return fmt.Errorf("plugin %s does not support rollback, checkpoint can not be reset", name)
+	}
+	if err := rollbacker.Rollback(ctx, from); err != nil {
+		return fmt.Errorf("plugin %s rollback from %d: %v", name, from, err)
+	}
+	if err := s.dao.ResetPluginCheckpoint(ctx, name, from); err != nil {
+		return err
+	}
+	// cached responses of plugin data from block num are stale
+	return s.dao.IncrResponseCacheGeneration(ctx)
 }
@@ -2,14 +2,257 @@ package service
 
 import (
 	"context"
-	"github.com/stretchr/testify/assert"
+	"errors"
+	"fmt"
 	"testing"
+
+	"github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/router"
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/plugins"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/urfave/cli"
 )
 
-func Test_emitEvent(t *testing.T) {
-	assert.NoError(t, testSrv.emitEvent(&testEvent))
+// recordPlugin record data delivered in order
+type recordPlugin struct {
+	delivered []string
+	failBlock int
+}
+
+func (p *recordPlugin) Commands() []cli.Command { return nil }
+
+func (p *recordPlugin) ProcessBlock(_ context.Context, block *storage.Block) error {
+	if block.BlockNum == p.failBlock {
+		return errors.New("process block failed")
+	}
+	p.delivered = append(p.delivered, fmt.Sprintf("block:%d", block.BlockNum))
+	return nil
+}
+
+func (p *recordPlugin) SetRedisPool(subscan_plugin.RedisPool) {}
+
+func (p *recordPlugin) Enable() bool { return true }
+
+func (p *recordPlugin) ConsumptionQueue() []string { return nil }
+
+func (p *recordPlugin) ExecWorker(context.Context, string, string, interface{}) error { return nil }
+
+func (p *recordPlugin) InitDao(storage.Dao) {}
+
+func (p *recordPlugin) InitHttp() []router.Http { return nil }
+
+func (p *recordPlugin) ProcessExtrinsic(_ *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) error {
+	p.delivered = append(p.delivered, fmt.Sprintf("extrinsic:%s:%d", extrinsic.ExtrinsicIndex, len(events)))
+	return nil
+}
+
+func (p *recordPlugin) ProcessEvent(_ *storage.Block, event *storage.Event, _ decimal.Decimal) error {
+	p.delivered = append(p.delivered, fmt.Sprintf("event:%d-%d", event.BlockNum, event.EventIdx))
+	return nil
+}
+
+func (p *recordPlugin) Migrate() {}
+
+func (p *recordPlugin) Version() string { return "0.1" }
+
+func (p *recordPlugin) SubscribeExtrinsic() []string { return []string{"balances"} }
+
+func (p *recordPlugin) SubscribeEvent() []string { return []string{"balances", "system"} }
+
+// txRecordPlugin record plugin delivered in transaction
+type txRecordPlugin struct {
+	recordPlugin
+	txs int
+}
+
+func (p *txRecordPlugin) DeliverTx(_ storage.Dao, deliver func(subscan_plugin.Plugin) error) error {
+	p.txs++
+	return deliver(p)
+}
+
+func registerRecordPlugin(t *testing.T) *txRecordPlugin {
+	p := &txRecordPlugin{}
+	plugins.RegisteredPlugins["record"] = p
+	subscribeEvent["balances"] = append(subscribeEvent["balances"], "record")
+	subscribeEvent["system"] = append(subscribeEvent["system"], "record")
+	subscribeExtrinsic["balances"] = append(subscribeExtrinsic["balances"], "record")
+	t.Cleanup(func() {
+		delete(plugins.RegisteredPlugins, "record")
+		subscribeEvent["balances"] = subscribeEvent["balances"][:len(subscribeEvent["balances"])-1]
+		subscribeEvent["system"] = subscribeEvent["system"][:len(subscribeEvent["system"])-1]
+		subscribeExtrinsic["balances"] = subscribeExtrinsic["balances"][:len(subscribeExtrinsic["balances"])-1]
+	})
+	return p
+}
+
+func pluginBlockData(blockNum uint) *model.BlockRangeData {
+	return &model.BlockRangeData{
+		Blocks: []model.ChainBlock{{BlockNum: blockNum}},
+		Extrinsics: []model.ChainExtrinsic{
+			{ID: blockNum*100000 + 1, ExtrinsicIndex: fmt.Sprintf("%d-1", blockNum), BlockNum: blockNum, CallModule: "balances"},
+			{ID: blockNum * 100000, ExtrinsicIndex: fmt.Sprintf("%d-0", blockNum), BlockNum: blockNum, CallModule: "timestamp"},
+		},
+		Events: []model.ChainEvent{
+			{BlockNum: blockNum, EventIdx: 2, ExtrinsicIdx: 1, ModuleId: "system", EventId: "ExtrinsicSuccess"},
+			{BlockNum: blockNum, EventIdx: 1, ExtrinsicIdx: 1, ModuleId: "balances", EventId: "Transfer"},
+			{BlockNum: blockNum, EventIdx: 0, ExtrinsicIdx: 0, ModuleId: "system", EventId: "ExtrinsicSuccess"},
+		},
+	}
+}
+
+func Test_processPluginBlock(t *testing.T) {
+	p := registerRecordPlugin(t)
+	d := &MockDao{}
+	d.On("GetBlockRangeData", mock.Anything, uint(10), uint(10)).Return(pluginBlockData(10), nil)
+	d.On("GetBlockRangeData", mock.Anything, uint(11), uint(11)).Return(&model.BlockRangeData{}, nil)
+	s := &Service{dao: d}
+
+	assert.NoError(t, s.processPluginBlock(context.TODO(), "record", p, 10))
+	assert.Equal(t, []string{"event:10-1", "extrinsic:10-1:2", "block:10"}, p.delivered)
+
+	assert.EqualError(t, s.processPluginBlock(context.TODO(), "record", p, 11), "block 11 not found")
+}
+
+func Test_processPluginBlocks(t *testing.T) {
+	ctx := context.TODO()
+	p := registerRecordPlugin(t)
+	p.failBlock = 12
+	d := &MockDao{}
+	d.On("GetPluginCheckpoint", ctx, "record").Return(&model.PluginCheckpoint{Plugin: "record", Next: 10}, nil)
+	d.On("GetFillFinalizedBlockNum", ctx).Return(13, nil)
+	d.On("AcquireLock", ctx, pluginLockKey("record"), "token", pluginLockTTL).Return(true)
+	for _, blockNum := range []uint{10, 11, 12} {
+		d.On("GetBlockRangeData", ctx, blockNum, blockNum).Return(&model.BlockRangeData{Blocks: []model.ChainBlock{{BlockNum: blockNum}}}, nil)
+		d.On("DeliverPluginBlock", ctx, "record", blockNum).Return(true, nil)
+	}
+	s := &Service{dao: d}
+
+	// stopped at failed block, every block delivered in its own transaction
+	processed, err := s.processPluginBlocks(ctx, "record", "token", 10)
+	assert.EqualError(t, err, "process block failed")
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, []string{"block:10", "block:11"}, p.delivered)
+	assert.Equal(t, 3, p.txs)
+	d.AssertNotCalled(t, "AdvancePluginCheckpoint", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// limit
+	p.delivered = nil
+	processed, err = s.processPluginBlocks(ctx, "record", "token", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, []string{"block:10"}, p.delivered)
 }
 
-func Test_emitExtrinsic(t *testing.T) {
-	assert.NoError(t, testSrv.emitExtrinsic(context.TODO(), &testSignedExtrinsic))
+func Test_processPluginBlocksReset(t *testing.T) {
+	ctx := context.TODO()
+	p := registerRecordPlugin(t)
+	d := &MockDao{}
+	d.On("GetPluginCheckpoint", ctx, "record").Return(&model.PluginCheckpoint{Plugin: "record", Next: 10}, nil)
+	d.On("GetFillFinalizedBlockNum", ctx).Return(20, nil)
+	d.On("AcquireLock", ctx, pluginLockKey("record"), "token", pluginLockTTL).Return(true)
+	// checkpoint reset by others, nothing delivered
+	d.On("DeliverPluginBlock", ctx, "record", uint(10)).Return(false, nil)
+	s := &Service{dao: d}
+
+	processed, err := s.processPluginBlocks(ctx, "record", "token", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, processed)
+	assert.Empty(t, p.delivered)
+
+	// lock held by other process
+	lost := &MockDao{}
+	lost.On("GetPluginCheckpoint", ctx, "record").Return(&model.PluginCheckpoint{Plugin: "record", Next: 10}, nil)
+	lost.On("GetFillFinalizedBlockNum", ctx).Return(20, nil)
+	lost.On("AcquireLock", ctx, pluginLockKey("record"), "token", pluginLockTTL).Return(false)
+	processed, err = (&Service{dao: lost}).processPluginBlocks(ctx, "record", "token", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, processed)
+	lost.AssertNotCalled(t, "GetBlockRangeData", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_ResetPluginCheckpoint(t *testing.T) {
+	registerRecordPlugin(t)
+	d := &MockDao{}
+	s := &Service{dao: d}
+	// data of plugin without rollback would be delivered twice
+	assert.Error(t, s.ResetPluginCheckpoint(context.TODO(), "record", 100))
+	assert.Error(t, s.ResetPluginCheckpoint(context.TODO(), "not-exist", 100))
+	d.AssertNotCalled(t, "ResetPluginCheckpoint", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// rollbackPlugin record plugin delete data from block num
+type rollbackPlugin struct {
+	recordPlugin
+	from      []uint
+	committed []uint
+	err       error
+}
+
+func (p *rollbackPlugin) Rollback(_ context.Context, from uint) error {
+	p.from = append(p.from, from)
+	return p.err
+}
+
+func (p *rollbackPlugin) CommitBlock(_ context.Context, blockNum uint) {
+	p.committed = append(p.committed, blockNum)
+}
+
+func TestService_ResetPluginCheckpointRollback(t *testing.T) {
+	p := &rollbackPlugin{}
+	plugins.RegisteredPlugins["rollback"] = p
+	t.Cleanup(func() { delete(plugins.RegisteredPlugins, "rollback") })
+	d := &MockDao{}
+	d.On("ResetPluginCheckpoint", context.TODO(), "rollback", uint(100)).Return(nil)
+	s := &Service{dao: d}
+	assert.NoError(t, s.ResetPluginCheckpoint(context.TODO(), "rollback", 100))
+	assert.Equal(t, []uint{100}, p.from)
+
+	// checkpoint kept if rollback failed
+	p.err = errors.New("rollback failed")
+	assert.Error(t, s.ResetPluginCheckpoint(context.TODO(), "rollback", 50))
+	d.AssertNotCalled(t, "ResetPluginCheckpoint", context.TODO(), "rollback", uint(50))
+}
+
+func Test_processPluginBlocksRollback(t *testing.T) {
+	ctx := context.TODO()
+	p := &rollbackPlugin{}
+	plugins.RegisteredPlugins["rollback"] = p
+	t.Cleanup(func() { delete(plugins.RegisteredPlugins, "rollback") })
+	d := &MockDao{}
+	// last delivery of block 10 interrupted
+	d.On("GetPluginCheckpoint", ctx, "rollback").Return(&model.PluginCheckpoint{Plugin: "rollback", Next: 10, Delivering: true}, nil)
+	d.On("GetFillFinalizedBlockNum", ctx).Return(20, nil)
+	d.On("AcquireLock", ctx, pluginLockKey("rollback"), "token", pluginLockTTL).Return(true)
+	for _, blockNum := range []uint{10, 11} {
+		d.On("GetBlockRangeData", ctx, blockNum, blockNum).Return(&model.BlockRangeData{Blocks: []model.ChainBlock{{BlockNum: blockNum}}}, nil)
+		d.On("MarkPluginDelivering", ctx, "rollback", blockNum).Return(true, nil)
+		d.On("AdvancePluginCheckpoint", ctx, "rollback", blockNum, blockNum+1).Return(true, nil)
+	}
+	s := &Service{dao: d}
+
+	processed, err := s.processPluginBlocks(ctx, "rollback", "token", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, []uint{10}, p.from)
+	assert.Equal(t, []string{"block:10", "block:11"}, p.delivered)
+	assert.Equal(t, []uint{10, 11}, p.committed)
+
+	// block not committed if checkpoint reset by others
+	d.On("GetBlockRangeData", ctx, uint(12), uint(12)).Return(&model.BlockRangeData{Blocks: []model.ChainBlock{{BlockNum: 12}}}, nil)
+	d.On("MarkPluginDelivering", ctx, "rollback", uint(12)).Return(true, nil)
+	d.On("AdvancePluginCheckpoint", ctx, "rollback", uint(12), uint(13)).Return(false, nil)
+	advanced, err := s.deliverPluginBlock(ctx, "rollback", 12, false)
+	assert.NoError(t, err)
+	assert.False(t, advanced)
+	assert.Equal(t, []uint{10, 11}, p.committed)
+
+	// plugin supports neither transaction nor rollback
+	plugins.RegisteredPlugins["plain"] = &recordPlugin{}
+	t.Cleanup(func() { delete(plugins.RegisteredPlugins, "plain") })
+	_, err = s.deliverPluginBlock(ctx, "plain", 10, false)
+	assert.Error(t, err)
 }
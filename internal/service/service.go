@@ -8,8 +8,10 @@ import (
 	"os"
 	"strings"
 
+	"github.com/itering/subscan/configs"
 	"github.com/itering/subscan/internal/cbc"
 	"github.com/itering/subscan/internal/dao"
+	"github.com/itering/subscan/share/analytics"
 	"github.com/itering/subscan/util"
 	"github.com/itering/substrate-api-rpc"
 	"github.com/itering/substrate-api-rpc/metadata"
@@ -25,7 +27,7 @@ type Service struct {
 // New  a service and return.
 func New() (s *Service) {
 	websocket.SetEndpoint(util.WSEndPoint)
-	d, dbStorage, pool := dao.New()
+	d, dbStorage, pool := dao.New(configs.Boot.Database.Partition)
 	s = &Service{dao: d, dbStorage: dbStorage}
 	
 	// CBC Chain specific initialization MUST run BEFORE initSubRuntimeLatest
@@ -42,6 +44,7 @@ func New() (s *Service) {
 	
 	s.unknownToken()
 	pluginRegister(dbStorage, pool)
+	s.initAnalytics()
 	return s
 }
 
@@ -55,6 +58,7 @@ func (s *Service) GetDbStorage() *dao.DbStorage {
 
 // Close close the resource.
 func (s *Service) Close() {
+	analytics.Close()
 	s.dao.Close()
 }
 
@@ -71,6 +75,12 @@ func (s *Service) initCBCChain() error {
 	}
 	
 	util.Logger().Info(fmt.Sprintf("Detected CBC Chain network: %s", util.NetworkNode))
+	if err := cbc.CheckAuthorConfig(); err != nil {
+		util.Logger().Error(fmt.Errorf("CBC block author and epoch tracking disabled: %w", err))
+	}
+	if cbc.EpochConfigStorage[0] == "" {
+		util.Logger().Warning("CBC_EPOCH_CONFIG_STORAGE is not set, blocks_per_epoch and max_validators of epochs are not saved")
+	}
 	
 	// Create CBC initializer
 	cbcInit := cbc.NewCBCInitializer(s.dao, util.WSEndPoint)
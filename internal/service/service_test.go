@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 
+	"github.com/itering/subscan-plugin/storage"
 	"github.com/itering/subscan/internal/dao"
 	"github.com/itering/subscan/model"
 	"github.com/itering/subscan/util"
@@ -74,6 +76,9 @@ func (m *MockDao) GetExtrinsicCount(ctx context.Context, queryWhere ...model.Opt
 }
 
 func (m *MockDao) GetSessionValidatorsById(ctx context.Context, sessionId uint) []string {
+	if sessionId == testCBCEpoch {
+		return testCBCValidators
+	}
 	return nil
 }
 
@@ -81,8 +86,146 @@ func (m *MockDao) CreateNewSession(ctx context.Context, sessionId uint, validato
 	return nil
 }
 
+func (m *MockDao) CreateBlockAuthor(txn *dao.GormDB, author *model.ChainBlockAuthor, missed []model.ChainMissedSlot) error {
+	return nil
+}
+
+func (m *MockDao) GetBlockAuthor(ctx context.Context, blockNum uint) *model.ChainBlockAuthor {
+	if blockNum == 9 {
+		return &model.ChainBlockAuthor{BlockNum: 9, Epoch: testCBCEpoch, Slot: 10, SelectionMode: "RoundRobin"}
+	}
+	return nil
+}
+
+func (m *MockDao) GetValidatorBlockStats(ctx context.Context, epoch *uint) []model.ValidatorBlockStat {
+	return []model.ValidatorBlockStat{{Validator: testCBCValidators[0], Authored: 2, Missed: 1}}
+}
+
+func (m *MockDao) CreateEpoch(txn *dao.GormDB, epoch *model.ChainEpoch) error {
+	return nil
+}
+
+func (m *MockDao) CloseEpoch(txn *dao.GormDB, epochIndex, endBlock uint, endAt int) error {
+	return nil
+}
+
+func (m *MockDao) UpdateEpochChanges(txn *dao.GormDB, epochIndex uint, joins, leaves model.SessionValidator) error {
+	return nil
+}
+
+func (m *MockDao) GetEpoch(ctx context.Context, epochIndex uint) *model.ChainEpoch {
+	if epochIndex == testCBCEpoch-1 {
+		return &model.ChainEpoch{EpochIndex: epochIndex, StartBlock: 1, Validators: testCBCValidators[1:]}
+	}
+	return nil
+}
+
+func (m *MockDao) GetEpochList(ctx context.Context, limit int, after *uint) ([]model.ChainEpoch, bool) {
+	return []model.ChainEpoch{{EpochIndex: testCBCEpoch - 1, StartBlock: 1, ValidatorCount: 2}}, false
+}
+
+func (m *MockDao) CreateApiKey(ctx context.Context, key *model.ApiKey) error {
+	key.Id = 1
+	return nil
+}
+
+func (m *MockDao) GetApiKeyByHash(ctx context.Context, keyHash string) (*model.ApiKey, error) {
+	switch keyHash {
+	case hashApiKey(testApiKey):
+		return &model.ApiKey{Id: 1, Tier: model.ApiKeyTierFree}, nil
+	case hashApiKey(testRevokedApiKey):
+		return &model.ApiKey{Id: 2, Tier: model.ApiKeyTierFree, RevokedAt: 1}, nil
+	case hashApiKey(testUnavailableApiKey):
+		return nil, errors.New("db down")
+	}
+	return nil, nil
+}
+
+func (m *MockDao) GetApiKeyList(ctx context.Context) []model.ApiKey {
+	return []model.ApiKey{{Id: 1, Tier: model.ApiKeyTierFree}}
+}
+
+func (m *MockDao) RevokeApiKey(ctx context.Context, id uint) error {
+	if id != 1 {
+		return util.RecordNotFound
+	}
+	return nil
+}
+
+func (m *MockDao) TakeRateLimitToken(ctx context.Context, bucket string, rate float64, burst int) (bool, int, int64, int64, error) {
+	args := m.Called(ctx, bucket, rate, burst)
+	return args.Bool(0), args.Int(1), args.Get(2).(int64), args.Get(3).(int64), args.Error(4)
+}
+
+func (m *MockDao) IncrApiKeyUsage(ctx context.Context, identity, date string) (int64, error) {
+	args := m.Called(ctx, identity, date)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDao) GetApiKeyUsage(ctx context.Context, identity string, dates []string) []model.ApiKeyUsage {
+	usage := make([]model.ApiKeyUsage, len(dates))
+	for i, date := range dates {
+		usage[i] = model.ApiKeyUsage{Date: date}
+	}
+	return usage
+}
+
+func (m *MockDao) CreateBlockWeight(txn *dao.GormDB, weight *model.ChainBlockWeight) error {
+	return nil
+}
+
+func (m *MockDao) GetBlockWeight(ctx context.Context, blockNum uint) *model.ChainBlockWeight {
+	return nil
+}
+
+func (m *MockDao) GetBlockWeightSeries(ctx context.Context, start, end, interval int) []model.BlockWeightPoint {
+	return nil
+}
+
+func (m *MockDao) GetStorageCache(ctx context.Context, key string) []byte {
+	return nil
+}
+
+func (m *MockDao) SetStorageCache(ctx context.Context, key string, value interface{}, ttl int) error {
+	return nil
+}
+
+func (m *MockDao) GetResponseCache(ctx context.Context, key string) []byte {
+	return nil
+}
+
+func (m *MockDao) SetResponseCache(ctx context.Context, key string, value []byte, ttl int) error {
+	return nil
+}
+
+func (m *MockDao) GetResponseCacheHead(ctx context.Context) int64 {
+	return 0
+}
+
+func (m *MockDao) IncrResponseCacheHead(ctx context.Context) error {
+	return nil
+}
+
+func (m *MockDao) GetResponseCacheGeneration(ctx context.Context) int64 {
+	return 0
+}
+
+func (m *MockDao) IncrResponseCacheGeneration(ctx context.Context) error {
+	return nil
+}
+
 func (m *MockDao) SplitBlockTable(blockNum uint) {}
 
+func (m *MockDao) GetBlockRangeData(ctx context.Context, start, end uint) (*model.BlockRangeData, error) {
+	args := m.Called(ctx, start, end)
+	return args.Get(0).(*model.BlockRangeData), args.Error(1)
+}
+
+func (m *MockDao) MigratePartition(ctx context.Context, batch uint, dropLegacy bool) error {
+	args := m.Called(ctx, batch, dropLegacy)
+	return args.Error(0)
+}
+
 func (m *MockDao) GetBlockNumArr(ctx context.Context, start, end uint) []int {
 	return nil
 }
@@ -91,6 +234,64 @@ func (m *MockDao) Close() {}
 
 func (m *MockDao) Ping(ctx context.Context) (err error) { return nil }
 
+func (m *MockDao) PingDb(ctx context.Context) error { return nil }
+
+func (m *MockDao) GetReplicaDelay(ctx context.Context) (int, bool, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Bool(1), args.Error(2)
+}
+
+func (m *MockDao) GetReplicas(ctx context.Context) []model.DbReplica {
+	args := m.Called(ctx)
+	return args.Get(0).([]model.DbReplica)
+}
+
+func (m *MockDao) GetPluginCheckpoint(ctx context.Context, plugin string) (*model.PluginCheckpoint, error) {
+	args := m.Called(ctx, plugin)
+	return args.Get(0).(*model.PluginCheckpoint), args.Error(1)
+}
+
+// DeliverPluginBlock deliver with nil storage, nothing delivered if checkpoint is not next
+func (m *MockDao) DeliverPluginBlock(ctx context.Context, plugin string, next uint, deliver func(storage.Dao) error) (bool, error) {
+	args := m.Called(ctx, plugin, next)
+	if !args.Bool(0) || args.Error(1) != nil {
+		return args.Bool(0), args.Error(1)
+	}
+	if err := deliver(nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *MockDao) MarkPluginDelivering(ctx context.Context, plugin string, next uint) (bool, error) {
+	args := m.Called(ctx, plugin, next)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDao) AdvancePluginCheckpoint(ctx context.Context, plugin string, from, to uint) (bool, error) {
+	args := m.Called(ctx, plugin, from, to)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDao) ResetPluginCheckpoint(ctx context.Context, plugin string, next uint) error {
+	args := m.Called(ctx, plugin, next)
+	return args.Error(0)
+}
+
+func (m *MockDao) GetPluginCheckpoints(ctx context.Context, plugins []string) (map[string]uint, error) {
+	args := m.Called(ctx, plugins)
+	return args.Get(0).(map[string]uint), args.Error(1)
+}
+
+func (m *MockDao) AcquireLock(ctx context.Context, key, token string, ttl int) bool {
+	args := m.Called(ctx, key, token, ttl)
+	return args.Bool(0)
+}
+
+func (m *MockDao) ReleaseLock(ctx context.Context, key, token string) {
+	m.Called(ctx, key, token)
+}
+
 func (m *MockDao) SetHeartBeatNow(context.Context, string) error {
 	return nil
 }
@@ -193,6 +394,10 @@ func (m *MockDao) CreateExtrinsic(c context.Context, txn *dao.GormDB, extrinsic
 	return nil
 }
 
+func (m *MockDao) CreateExtrinsicCalls(txn *dao.GormDB, calls []model.ChainExtrinsicCall) error {
+	return nil
+}
+
 func (m *MockDao) DropExtrinsicNotFinalizedData(c context.Context, blockNum int, finalized bool) bool {
 	return true
 }
@@ -204,7 +409,7 @@ func (m *MockDao) GetExtrinsicsByBlockNum(blockNum uint) []model.ChainExtrinsicJ
 func (m *MockDao) GetExtrinsicList(c context.Context, page, row int, order string, fixedTableIndex int, afterId uint, queryWhere ...model.Option) ([]model.ChainExtrinsic, int) {
 	return nil, 0
 }
-func (m *MockDao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableIndex int, beforeId, afterId uint, queryWhere ...model.Option) ([]model.ChainExtrinsic, bool, bool) {
+func (m *MockDao) GetExtrinsicListCursor(c context.Context, limit int, fixedTableIndex int, beforeId, afterId uint, accountId string, queryWhere ...model.Option) ([]model.ChainExtrinsic, bool, bool) {
 	return []model.ChainExtrinsic{testSignedExtrinsic}, false, false
 }
 
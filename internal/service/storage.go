@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
+	"github.com/itering/substrate-api-rpc/metadata"
+	"github.com/itering/substrate-api-rpc/rpc"
+	"github.com/itering/substrate-api-rpc/storage"
+)
+
+// StorageQueryParams storage item, keys fewer than the item iterate entries of map after cursor key
+type StorageQueryParams struct {
+	Module   string        `json:"module"`
+	Method   string        `json:"method"`
+	Keys     []interface{} `json:"keys"`
+	BlockNum *uint         `json:"block_num"`
+	Hash     string        `json:"hash"`
+	Row      int           `json:"row"`
+	After    string        `json:"after"`
+}
+
+// QueryStorage read storage item at block through node, decoded with the metadata of block spec
+// items of configs storage.cache_items are cached in redis
+func (s *Service) QueryStorage(ctx context.Context, p *StorageQueryParams) (*model.StorageQuery, error) {
+	blockNum, hash, err := s.storageBlock(ctx, p.BlockNum, p.Hash)
+	if err != nil {
+		return nil, err
+	}
+	cached := storageCacheItem(p.Module, p.Method)
+	var cacheKey string
+	if cached {
+		b, _ := json.Marshal([]interface{}{hash, strings.ToLower(p.Module), strings.ToLower(p.Method), p.Keys, p.Row, p.After})
+		cacheKey = fmt.Sprintf("%x", sha256.Sum256(b))
+		if raw := s.dao.GetStorageCache(ctx, cacheKey); raw != nil {
+			r := new(model.StorageQuery)
+			if json.Unmarshal(raw, r) == nil {
+				return r, nil
+			}
+		}
+	}
+	r, err := s.queryStorage(ctx, blockNum, hash, p)
+	if err != nil {
+		return nil, err
+	}
+	if cached {
+		_ = s.dao.SetStorageCache(ctx, cacheKey, r, configs.Boot.Storage.CacheTTL)
+	}
+	return r, nil
+}
+
+func (s *Service) queryStorage(ctx context.Context, blockNum uint, hash string, p *StorageQueryParams) (*model.StorageQuery, error) {
+	runtime := rpc.GetStateGetRuntimeVersion(nil, hash)
+	if runtime == nil {
+		return nil, fmt.Errorf("runtime version of block %s not found", hash)
+	}
+	m := s.getMetadataInstant(runtime.SpecVersion, hash)
+	_, item := substrate.FindStorage(m, p.Module, p.Method)
+	if item == nil {
+		return nil, fmt.Errorf("storage %s.%s not found", p.Module, p.Method)
+	}
+	key, option, err := substrate.EncodeStorageKey(m, runtime.SpecVersion, p.Module, p.Method, p.Keys...)
+	if err != nil {
+		return nil, err
+	}
+	r := &model.StorageQuery{
+		BlockNum:    blockNum,
+		BlockHash:   hash,
+		SpecVersion: runtime.SpecVersion,
+		Module:      p.Module,
+		Method:      item.Name,
+		KeyTypes:    option.Keys,
+		ValueType:   option.Value,
+	}
+	if len(p.Keys) == len(option.Keys) {
+		raw, err := substrate.ReadStorageRaw(ctx, key, hash)
+		if err != nil {
+			return nil, err
+		}
+		if raw == "" && item.Modifier == "Default" {
+			raw = item.Fallback
+		}
+		entry, err := storageEntry(m, runtime.SpecVersion, option, key, raw)
+		if err != nil {
+			return nil, err
+		}
+		r.Entry = entry
+		return r, nil
+	}
+	var after string
+	if p.After != "" {
+		if after = util.AddHex(p.After); !strings.HasPrefix(after, key) {
+			return nil, errors.New("after key is not an entry of storage")
+		}
+	}
+	row := p.Row
+	if row <= 0 || row > 100 {
+		row = 100
+	}
+	keys, err := substrate.StorageKeysPaged(ctx, key, after, hash, row+1)
+	if err != nil {
+		return nil, err
+	}
+	if r.HasNextPage = len(keys) > row; r.HasNextPage {
+		keys = keys[:row]
+	}
+	if len(keys) == 0 {
+		return r, nil
+	}
+	values, err := substrate.QueryStorageAt(ctx, keys, hash)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		entry, err := storageEntry(m, runtime.SpecVersion, option, k, values[k])
+		if err != nil {
+			return nil, err
+		}
+		r.List = append(r.List, *entry)
+	}
+	r.EndCursor = keys[len(keys)-1]
+	return r, nil
+}
+
+// storageBlock block of hash or num, default the latest finalized block
+func (s *Service) storageBlock(ctx context.Context, blockNum *uint, hash string) (uint, string, error) {
+	if hash != "" {
+		if block := s.dao.GetBlockByHash(ctx, hash); block != nil {
+			return block.BlockNum, block.Hash, nil
+		}
+		// not indexed yet, read by hash only
+		return 0, util.AddHex(hash), nil
+	}
+	var num uint
+	if blockNum != nil {
+		num = *blockNum
+	} else {
+		finalized, err := s.dao.GetFinalizedBlockNum(ctx)
+		if err != nil {
+			return 0, "", err
+		}
+		num = uint(finalized)
+	}
+	if block := s.dao.GetBlockByNum(ctx, num); block != nil {
+		return block.BlockNum, block.Hash, nil
+	}
+	hash, err := rpc.GetChainGetBlockHash(nil, int(num))
+	if err != nil {
+		return 0, "", err
+	}
+	if hash == "" {
+		return 0, "", fmt.Errorf("block %d not found", num)
+	}
+	return num, hash, nil
+}
+
+// storageCacheItem item matches Pallet.Item or Item of configs storage.cache_items
+func storageCacheItem(module, method string) bool {
+	if configs.Boot.Storage == nil {
+		return false
+	}
+	for _, item := range configs.Boot.Storage.CacheItems {
+		if pallet, name, ok := strings.Cut(item, "."); ok {
+			if strings.EqualFold(pallet, module) && strings.EqualFold(name, method) {
+				return true
+			}
+		} else if strings.EqualFold(item, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func storageEntry(m *metadata.Instant, spec int, option *substrate.StorageOption, key, raw string) (*model.StorageEntry, error) {
+	keys, value, err := substrate.DecodeStorageEntry(m, spec, option, key, raw)
+	if err != nil {
+		return nil, err
+	}
+	entry := &model.StorageEntry{Key: key, Value: storageJson(value)}
+	for _, k := range keys {
+		entry.Keys = append(entry.Keys, storageJson(k))
+	}
+	return entry, nil
+}
+
+// storageJson decoded value as json, scalar value is quoted
+func storageJson(v storage.StateStorage) json.RawMessage {
+	if v == "" {
+		return json.RawMessage("null")
+	}
+	if json.Valid([]byte(v)) {
+		return json.RawMessage(v)
+	}
+	b, _ := json.Marshal(string(v))
+	return b
+}
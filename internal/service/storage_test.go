@@ -0,0 +1,26 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/itering/subscan/configs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageCacheItem(t *testing.T) {
+	defer func(storage *configs.Storage) { configs.Boot.Storage = storage }(configs.Boot.Storage)
+	configs.Boot.Storage = nil
+	assert.False(t, storageCacheItem("PalletCbcValidator", "ValidatorState"))
+
+	configs.Boot.Storage = &configs.Storage{CacheItems: []string{"ValidatorState", "System.Account"}}
+	assert.True(t, storageCacheItem("PalletCbcValidator", "validatorState"))
+	assert.True(t, storageCacheItem("system", "account"))
+	assert.False(t, storageCacheItem("Balances", "Account"))
+}
+
+func TestStorageJson(t *testing.T) {
+	assert.Equal(t, "null", string(storageJson("")))
+	assert.Equal(t, `{"free":1}`, string(storageJson(`{"free":1}`)))
+	assert.Equal(t, "10", string(storageJson("10")))
+	assert.Equal(t, `"Active"`, string(storageJson("Active")))
+}
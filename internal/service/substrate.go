@@ -185,6 +185,10 @@ func (s *Service) FillBlockData(ctx context.Context, blockNum uint, force bool)
 	}
 	// for Create
 	if err = s.CreateChainBlock(ctx, blockHash, &rpcBlock.Block, event, specVersion, sessionIndex); err == nil {
+		if block != nil {
+			// block is re-processed, cached responses of finalized data are stale
+			_ = s.dao.IncrResponseCacheGeneration(ctx)
+		}
 		_ = s.dao.SaveFillAlreadyBlockNum(ctx, int(blockNum))
 		util.Logger().Debug(fmt.Sprintf("Fill Block num %d hash %s use %d ms", blockNum, blockHash, time.Since(now).Milliseconds()))
 		setFinalized()
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
+	substrateRpc "github.com/itering/substrate-api-rpc"
+	"github.com/itering/substrate-api-rpc/metadata"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	DecodeExtrinsic = "extrinsic"
+	DecodeCall      = "call"
+	DecodeEvent     = "event"  // single event record
+	DecodeEvents    = "events" // Vec<EventRecord>, value of System.Events
+	DecodeStorage   = "storage"
+)
+
+// DecodeParams raw of extrinsic, call or event, storage key with optional raw value
+type DecodeParams struct {
+	Type string `json:"type"`
+	Raw  string `json:"raw"`
+	Key  string `json:"key"`
+	Spec int    `json:"spec"` // default the latest spec
+}
+
+type DecodeResult struct {
+	SpecVersion int         `json:"spec_version"`
+	Type        string      `json:"type"`
+	Result      interface{} `json:"result"`
+}
+
+// DecodeStorageResult decoded storage key and value
+type DecodeStorageResult struct {
+	Module    string      `json:"module"`
+	Method    string      `json:"method"`
+	KeyTypes  []string    `json:"key_types"`
+	ValueType string      `json:"value_type"`
+	Keys      interface{} `json:"keys"`
+	Value     interface{} `json:"value"`
+}
+
+// Decode decode raw data offline with the metadata of stored spec
+func (s *Service) Decode(_ context.Context, p *DecodeParams) (*DecodeResult, error) {
+	spec, m, err := s.specMetadata(p.Spec)
+	if err != nil {
+		return nil, err
+	}
+	r := &DecodeResult{SpecVersion: spec, Type: p.Type}
+	switch p.Type {
+	case DecodeExtrinsic:
+		list, err := substrateRpc.DecodeExtrinsic([]string{p.Raw}, m, spec)
+		if err != nil {
+			return nil, err
+		}
+		r.Result = list[0]
+	case DecodeCall:
+		r.Result, err = substrate.DecodeCall(p.Raw, m, spec)
+	case DecodeEvent:
+		r.Result, err = substrate.DecodeEventRecord(p.Raw, m, spec)
+	case DecodeEvents:
+		r.Result, err = substrateRpc.DecodeEvent(p.Raw, m, spec)
+	case DecodeStorage:
+		r.Result, err = decodeStorage(m, spec, p.Key, p.Raw)
+	default:
+		return nil, fmt.Errorf("unsupported decode type %s", p.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func decodeStorage(m *metadata.Instant, spec int, key, raw string) (*DecodeStorageResult, error) {
+	mm, item := substrate.FindStorageByKey(m, key)
+	if item == nil {
+		return nil, errors.New("storage of key not found")
+	}
+	option := substrate.CheckoutHasherAndType(&item.Type)
+	if len(option.Keys) == 0 {
+		option.Hasher = nil
+	}
+	keys, value, err := substrate.DecodeStorageEntry(m, spec, option, util.AddHex(key), raw)
+	if err != nil {
+		return nil, err
+	}
+	r := &DecodeStorageResult{Module: mm.Name, Method: item.Name, KeyTypes: option.Keys, ValueType: option.Value}
+	if keys != nil {
+		list := make([]interface{}, 0, len(keys))
+		for _, k := range keys {
+			list = append(list, storageJson(k))
+		}
+		r.Keys = list
+	}
+	if raw != "" {
+		r.Value = storageJson(value)
+	}
+	return r, nil
+}
+
+// specMetadata metadata of stored spec, the latest spec if spec is 0
+func (s *Service) specMetadata(spec int) (int, *metadata.Instant, error) {
+	if spec == 0 {
+		recent := s.dao.RuntimeVersionRecent()
+		if recent == nil {
+			return 0, nil, errors.New("runtime version not found")
+		}
+		spec = recent.SpecVersion
+	}
+	// getMetadataInstant reads metadata of unknown spec from chain, spec must be stored
+	if _, ok := metadata.RuntimeMetadata[spec]; !ok {
+		if raw := s.dao.RuntimeVersionRaw(spec); raw == nil || raw.Raw == "" {
+			return 0, nil, fmt.Errorf("spec %d not found", spec)
+		}
+	}
+	return spec, s.getMetadataInstant(spec, ""), nil
+}
+
+// EstimateFeeParams signed or unsigned extrinsic, or call
+type EstimateFeeParams struct {
+	Extrinsic string `json:"extrinsic"`
+	Call      string `json:"call"`
+	BlockNum  *uint  `json:"block_num"`
+	Hash      string `json:"hash"`
+}
+
+type EstimateFeeResult struct {
+	BlockNum    uint                    `json:"block_num"`
+	BlockHash   string                  `json:"block_hash"`
+	FeeDetails  *PaymentQueryFeeDetails `json:"fee_details"`
+	EstimateFee decimal.Decimal         `json:"estimate_fee"`
+}
+
+// EstimateFee fee details of payload at block, default the latest finalized block
+func (s *Service) EstimateFee(ctx context.Context, p *EstimateFeeParams) (*EstimateFeeResult, error) {
+	if (p.Extrinsic == "") == (p.Call == "") {
+		return nil, errors.New("one of extrinsic or call required")
+	}
+	blockNum, hash, err := s.storageBlock(ctx, p.BlockNum, p.Hash)
+	if err != nil {
+		return nil, err
+	}
+	var feeDetails *PaymentQueryFeeDetails
+	if p.Extrinsic != "" {
+		feeDetails, err = GetExtrinsicFeeDetails(ctx, p.Extrinsic, hash)
+	} else {
+		feeDetails, err = GetCallFeeDetails(ctx, p.Call, hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	r := &EstimateFeeResult{BlockNum: blockNum, BlockHash: hash, FeeDetails: feeDetails}
+	// unsigned extrinsic pays no inclusion fee
+	if feeDetails.InclusionFee != nil {
+		r.EstimateFee = feeDetails.EstimateFee()
+	}
+	return r, nil
+}
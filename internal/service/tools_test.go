@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
+	"github.com/itering/substrate-api-rpc/hasher"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccountId = "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+
+func TestService_Decode(t *testing.T) {
+	ctx := context.TODO()
+	r, err := testSrv.Decode(ctx, &DecodeParams{Type: DecodeCall, Raw: "0x00010c616263"})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, r.SpecVersion)
+	call := r.Result.(map[string]interface{})
+	assert.Equal(t, "System", call["call_module"])
+	assert.Equal(t, "remark", call["call_name"])
+
+	_, err = testSrv.Decode(ctx, &DecodeParams{Type: DecodeCall, Raw: "0x00010c61626364"})
+	assert.Error(t, err)
+	_, err = testSrv.Decode(ctx, &DecodeParams{Type: DecodeCall, Raw: "0xff01"})
+	assert.Error(t, err)
+
+	// System.NewAccount applied by extrinsic 0
+	r, err = testSrv.Decode(ctx, &DecodeParams{Type: DecodeEvent, Raw: "0x00000000000003" + testAccountId + "00", Spec: 4})
+	assert.NoError(t, err)
+	b, _ := json.Marshal(r.Result)
+	assert.Contains(t, string(b), `"event_id":"NewAccount"`)
+
+	key := substrate.PlainStorageKey("System", "Account") + util.BytesToHex(hasher.HashByCryptoName(util.HexToBytes(testAccountId), "Blake2_128Concat"))
+	r, err = testSrv.Decode(ctx, &DecodeParams{Type: DecodeStorage, Key: key})
+	assert.NoError(t, err)
+	s := r.Result.(*DecodeStorageResult)
+	assert.Equal(t, "Account", s.Method)
+	assert.Len(t, s.Keys, 1)
+	assert.Nil(t, s.Value)
+
+	r, err = testSrv.Decode(ctx, &DecodeParams{Type: DecodeStorage, Key: substrate.PlainStorageKey("System", "Number"), Raw: "0x0a000000"})
+	assert.NoError(t, err)
+	s = r.Result.(*DecodeStorageResult)
+	assert.Nil(t, s.Keys)
+	assert.Equal(t, "10", string(s.Value.(json.RawMessage)))
+
+	_, err = testSrv.Decode(ctx, &DecodeParams{Type: DecodeStorage, Key: "0x00"})
+	assert.Error(t, err)
+	_, err = testSrv.Decode(ctx, &DecodeParams{Type: "block"})
+	assert.Error(t, err)
+}
+
+func TestDecodeFeeDetails(t *testing.T) {
+	feeDetails, err := decodeFeeDetails("0x00" + "00000000000000000000000000000000")
+	assert.NoError(t, err)
+	assert.Nil(t, feeDetails.InclusionFee)
+
+	feeDetails, err = decodeFeeDetails("0x01" + "e8030000000000000000000000000000" + "0a000000000000000000000000000000" + "00000000000000000000000000000001" + "00000000000000000000000000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", feeDetails.InclusionFee.BaseFee.String())
+	assert.Equal(t, "10", feeDetails.InclusionFee.LenFee.String())
+	assert.Equal(t, "1329227995784915872903807060280344576", feeDetails.InclusionFee.AdjustedWeightFee.String())
+
+	_, err = decodeFeeDetails("0x0101")
+	assert.Error(t, err)
+}
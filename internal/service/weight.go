@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
+	"github.com/itering/substrate-api-rpc/metadata"
+)
+
+// checkoutBlockWeight read System.BlockWeight at block hash, measure with BlockWeights/BlockLength limits of block spec.
+// It calls rpc, so read it before the block transaction begins
+func (s *Service) checkoutBlockWeight(ctx context.Context, blockNum uint, hash string, spec int, encodeExtrinsics []string, m *metadata.Instant) (*model.ChainBlockWeight, error) {
+	consumed, err := substrate.ReadPlainStorage(ctx, m, spec, "System", "BlockWeight", hash)
+	if err != nil {
+		return nil, fmt.Errorf("block %d read System.BlockWeight error %v", blockNum, err)
+	}
+	if consumed == "" {
+		return nil, fmt.Errorf("block %d System.BlockWeight is empty", blockNum)
+	}
+	weight := model.ChainBlockWeight{
+		ID:          blockNum,
+		BlockNum:    blockNum,
+		SpecVersion: spec,
+	}
+	weight.SetConsumed(consumed.ToMapInterface())
+	for _, extrinsic := range encodeExtrinsics {
+		weight.Length += uint64(len(util.HexToBytes(extrinsic)))
+	}
+	blockWeights, _ := substrate.DecodeConstant(m, spec, "System", "BlockWeights")
+	blockLength, _ := substrate.DecodeConstant(m, spec, "System", "BlockLength")
+	weight.SetLimits(blockWeights.ToMapInterface(), blockLength.ToMapInterface())
+	return &weight, nil
+}
+
+func (s *Service) GetBlockWeight(ctx context.Context, blockNum uint) *model.ChainBlockWeight {
+	return s.dao.GetBlockWeight(ctx, blockNum)
+}
+
+func (s *Service) GetBlockWeightSeries(ctx context.Context, start, end, interval int) []model.BlockWeightPoint {
+	return s.dao.GetBlockWeightSeries(ctx, start, end, interval)
+}
@@ -0,0 +1,50 @@
+package model
+
+// ApiKey tiers, anonymous tier is used by requests without api key and limited per ip
+const (
+	ApiKeyTierAnonymous = "anonymous"
+	ApiKeyTierFree      = "free"
+	ApiKeyTierStandard  = "standard"
+	ApiKeyTierPro       = "pro"
+)
+
+// ApiKey issued api key, only the sha256 of key is saved, Prefix identifies the key in logs and lists
+type ApiKey struct {
+	Id        uint   `gorm:"primaryKey" json:"id"`
+	Name      string `json:"name" gorm:"size:100"`
+	Prefix    string `json:"prefix" gorm:"size:10"`
+	KeyHash   string `json:"-" gorm:"size:64;uniqueIndex:key_hash"`
+	Tier      string `json:"tier" gorm:"size:20"`
+	CreatedAt int64  `json:"created_at"`
+	RevokedAt int64  `json:"revoked_at"`
+}
+
+func (a ApiKey) TableName() string {
+	return "api_keys"
+}
+
+// ApiKeyTier token bucket of tier, Rate tokens are refilled per second up to Burst, DailyQuota 0 is unlimited
+type ApiKeyTier struct {
+	Name       string  `json:"name"`
+	Rate       float64 `json:"rate"`
+	Burst      int     `json:"burst"`
+	DailyQuota int64   `json:"daily_quota"`
+}
+
+// RateLimitState result of rate limit of request, sent as X-RateLimit-* headers
+type RateLimitState struct {
+	Allowed        bool
+	Tier           string
+	Limit          int
+	Remaining      int
+	Reset          int // seconds until bucket is full
+	RetryAfter     int // seconds until next token, set if not allowed
+	QuotaLimit     int64
+	QuotaRemaining int64
+}
+
+// ApiKeyUsage allowed request count of day
+type ApiKeyUsage struct {
+	Date     string `json:"date"`
+	Requests int64  `json:"requests"`
+}
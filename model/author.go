@@ -0,0 +1,33 @@
+package model
+
+// ChainBlockAuthor author of block with the epoch and slot it was produced in
+type ChainBlockAuthor struct {
+	BlockNum      uint   `gorm:"primaryKey;autoIncrement:false" json:"block_num"`
+	Epoch         uint   `json:"epoch" gorm:"index:epoch"`
+	Slot          uint64 `json:"slot"`
+	Validator     string `json:"validator" gorm:"size:100;index:validator"`
+	SelectionMode string `json:"selection_mode" gorm:"size:20"`
+}
+
+func (c ChainBlockAuthor) TableName() string {
+	return "chain_block_authors"
+}
+
+// ChainMissedSlot slot skipped between two blocks, Validator is the author scheduled for the slot
+type ChainMissedSlot struct {
+	Slot      uint64 `gorm:"primaryKey;autoIncrement:false" json:"slot"`
+	Epoch     uint   `json:"epoch" gorm:"index:epoch"`
+	Validator string `json:"validator" gorm:"size:100;index:validator"`
+	BlockNum  uint   `json:"block_num"`
+}
+
+func (c ChainMissedSlot) TableName() string {
+	return "chain_missed_slots"
+}
+
+// ValidatorBlockStat authored and missed block count of validator
+type ValidatorBlockStat struct {
+	Validator string `json:"validator"`
+	Authored  int    `json:"authored"`
+	Missed    int    `json:"missed"`
+}
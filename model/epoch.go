@@ -0,0 +1,21 @@
+package model
+
+// ChainEpoch epoch of CBC or session of Session pallet, EndBlock is 0 until the next epoch starts.
+// Joins and Leaves are the validator set changes from the previous epoch
+type ChainEpoch struct {
+	EpochIndex     uint             `gorm:"primaryKey;autoIncrement:false" json:"epoch_index"`
+	StartBlock     uint             `json:"start_block" gorm:"index:start_block"`
+	EndBlock       uint             `json:"end_block"`
+	StartAt        int              `json:"start_at"`
+	EndAt          int              `json:"end_at"`
+	ValidatorCount int              `json:"validator_count"`
+	Validators     SessionValidator `json:"validators,omitempty" gorm:"type:json"`
+	Joins          SessionValidator `json:"joins" gorm:"type:json"`
+	Leaves         SessionValidator `json:"leaves" gorm:"type:json"`
+	BlocksPerEpoch uint             `json:"blocks_per_epoch,omitempty"`
+	MaxValidators  uint             `json:"max_validators,omitempty"`
+}
+
+func (c ChainEpoch) TableName() string {
+	return "chain_epochs"
+}
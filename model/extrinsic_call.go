@@ -0,0 +1,45 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ChainExtrinsicCall nested call of utility batch/proxy/multisig/sudo extrinsic, indexed for call_module filtering
+type ChainExtrinsicCall struct {
+	ID                 uint   `gorm:"primary_key" json:"-"`
+	ExtrinsicId        uint   `json:"-" gorm:"index:extrinsic_id_call_path,unique"`
+	ExtrinsicIndex     string `json:"extrinsic_index" gorm:"size:100"`
+	BlockNum           uint   `json:"block_num"`
+	CallPath           string `json:"call_path" gorm:"size:100;index:extrinsic_id_call_path,unique"`
+	CallModule         string `json:"call_module" gorm:"size:100;index:nested_call_function"`
+	CallModuleFunction string `json:"call_module_function" gorm:"size:100;index:nested_call_function"`
+}
+
+func (c ChainExtrinsicCall) TableName() string {
+	return "chain_extrinsic_calls"
+}
+
+// WithCall filter extrinsic by call module and function, include nested calls
+func WithCall(module, function string) Option {
+	return func(tx *gorm.DB) *gorm.DB {
+		var conds []string
+		var params []interface{}
+		if module != "" {
+			conds = append(conds, "call_module = ?")
+			params = append(params, module)
+		}
+		if function != "" {
+			conds = append(conds, "call_module_function = ?")
+			params = append(params, function)
+		}
+		if len(conds) == 0 {
+			return tx
+		}
+		query := strings.Join(conds, " AND ")
+		nested := tx.Session(&gorm.Session{NewDB: true}).Model(&ChainExtrinsicCall{}).Select("extrinsic_id").Where(query, params...)
+		return tx.Where(fmt.Sprintf("(%s) OR id IN (?)", query), append(params, nested)...)
+	}
+}
@@ -0,0 +1,43 @@
+package model
+
+const (
+	HealthUp       = "up"
+	HealthDegraded = "degraded"
+	HealthDown     = "down"
+)
+
+// HealthCheck status of a component, critical component down make the whole service down
+type HealthCheck struct {
+	Status   string      `json:"status"`
+	Critical bool        `json:"critical"`
+	Latency  int64       `json:"latency_ms"`
+	Error    string      `json:"error,omitempty"`
+	Detail   interface{} `json:"detail,omitempty"`
+}
+
+type Health struct {
+	Status string                  `json:"status"`
+	Checks map[string]*HealthCheck `json:"checks"`
+}
+
+type HealthLag struct {
+	Head      uint64 `json:"head"`
+	Finalized int    `json:"finalized"`
+	Lag       int    `json:"lag"`
+	MaxLag    int    `json:"max_lag"`
+}
+
+type HealthPlugin struct {
+	LastBlock int    `json:"last_block"`
+	Lag       int    `json:"lag"`
+	Version   string `json:"version"`
+	Error     string `json:"error,omitempty"` // error reported by plugin, like external plugin process exited
+}
+
+// DbReplica read replica status, replica not healthy is out of rotation
+type DbReplica struct {
+	Name    string `json:"name"`
+	Delay   int    `json:"delay"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
@@ -37,6 +37,19 @@ func TestSplitTableName(t *testing.T) {
 	}
 }
 
+func TestNativePartition(t *testing.T) {
+	model.SetPartition(model.PartitionModeNative, 500000)
+	defer model.SetPartition(model.PartitionModeSplit, model.SplitTableBlockNum)
+
+	assert.Equal(t, "chain_blocks", model.ChainBlock{BlockNum: 1000000}.TableName())
+	assert.Equal(t, "chain_events", model.ChainEvent{BlockNum: 10000000}.TableName())
+	assert.Equal(t, "chain_extrinsics", model.ChainExtrinsic{BlockNum: 1999999}.TableName())
+	assert.Equal(t, "chain_logs", model.ChainLog{BlockNum: 1999999}.TableName())
+	assert.Equal(t, uint(0), model.TableIndex(1999999))
+	assert.Equal(t, uint(3), model.PartitionIndex(1999999))
+	assert.Equal(t, "chain_blocks_p3", model.PartitionName("chain_blocks", model.PartitionIndex(1999999)))
+}
+
 func TestModelPluginRender(t *testing.T) {
 	block := model.ChainBlock{BlockNum: 1, BlockTimestamp: 1, Hash: "0x0", SpecVersion: 1, Validator: "0x0", Finalized: true}
 	assert.Equal(t, &storage.Block{BlockNum: 1, BlockTimestamp: 1, Hash: "0x0", SpecVersion: 1, Validator: "0x0", Finalized: true}, block.AsPlugin())
@@ -48,3 +61,27 @@ func TestModelPluginRender(t *testing.T) {
 	assert.Equal(t, &storage.Extrinsic{ExtrinsicHash: "0x0", Params: ExtrinsicParams.Marshal(), Fee: decimal.New(1, 0)}, extrinsic.AsPlugin())
 
 }
+
+func TestBlockWeight(t *testing.T) {
+	refTime, proofSize := model.ParseWeight(map[string]interface{}{"ref_time": 100, "proof_size": 20})
+	assert.Equal(t, uint64(100), refTime)
+	assert.Equal(t, uint64(20), proofSize)
+	refTime, proofSize = model.ParseWeight(float64(300))
+	assert.Equal(t, uint64(300), refTime)
+	assert.Equal(t, uint64(0), proofSize)
+
+	w := model.ChainBlockWeight{Length: 100}
+	w.SetConsumed(map[string]interface{}{
+		"normal":    map[string]interface{}{"refTime": 200, "proofSize": 50},
+		"mandatory": map[string]interface{}{"ref_time": 50, "proof_size": 50},
+	})
+	w.SetLimits(
+		map[string]interface{}{"max_block": map[string]interface{}{"ref_time": 1000, "proof_size": 400}},
+		map[string]interface{}{"max": map[string]interface{}{"normal": 300, "operational": 400, "mandatory": 400}},
+	)
+	assert.Equal(t, uint64(1000), w.MaxRefTime)
+	assert.Equal(t, uint64(400), w.MaxLength)
+	assert.Equal(t, 0.25, w.RefTimeRatio)
+	assert.Equal(t, 0.25, w.ProofSizeRatio)
+	assert.Equal(t, 0.25, w.LengthRatio)
+}
@@ -0,0 +1,8 @@
+package model
+
+// MQQueue worker queue status, jobs waiting and dead letters
+type MQQueue struct {
+	Queue       string `json:"queue"`
+	Depth       int    `json:"depth"`
+	DeadLetters int    `json:"dead_letters"`
+}
@@ -115,6 +115,14 @@ func MetadataCacheKey() string {
 	return RedisKeyPrefix() + "metadata"
 }
 
+// ResponseCacheGenerationField field of ResponseCacheGenerationKey hash, moved by HINCRBY which plugins can call too
+const ResponseCacheGenerationField = "generation"
+
+// ResponseCacheGenerationKey generation of all cached api responses, moved when blocks are re-processed or repaired
+func ResponseCacheGenerationKey() string {
+	return RedisKeyPrefix() + "response_generation"
+}
+
 func AddOrUpdateItem(c context.Context, db *gorm.DB, item interface{}, keys []string, updates ...string) *gorm.DB {
 	var keyFields []clause.Column
 	for _, key := range keys {
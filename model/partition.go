@@ -0,0 +1,53 @@
+package model
+
+import "fmt"
+
+const (
+	PartitionModeSplit  = "split"  // split physical tables every SplitTableBlockNum blocks
+	PartitionModeNative = "native" // database declarative range partition on block_num
+)
+
+var (
+	// NativePartition block, event, extrinsic, log stored in one range partitioned table
+	NativePartition bool
+	// PartitionSize block range of every native partition
+	PartitionSize = SplitTableBlockNum
+)
+
+// SetPartition set partition mode and native partition size
+func SetPartition(mode string, size uint) {
+	NativePartition = mode == PartitionModeNative
+	if size > 0 {
+		PartitionSize = size
+	}
+}
+
+// TableIndex split table index of block num, always 0 with native partition
+func TableIndex(blockNum uint) uint {
+	if NativePartition {
+		return 0
+	}
+	return blockNum / SplitTableBlockNum
+}
+
+// TableBlockNum first block num of split table index
+func TableBlockNum(index uint) uint {
+	return index * SplitTableBlockNum
+}
+
+// PartitionIndex native partition index of block num
+func PartitionIndex(blockNum uint) uint {
+	return blockNum / PartitionSize
+}
+
+// PartitionName native partition name of table
+func PartitionName(table string, index uint) string {
+	return fmt.Sprintf("%s_p%d", table, index)
+}
+
+func splitTableName(table string, blockNum uint) string {
+	if index := TableIndex(blockNum); index > 0 {
+		return fmt.Sprintf("%s_%d", table, index)
+	}
+	return table
+}
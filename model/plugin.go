@@ -0,0 +1,10 @@
+package model
+
+// PluginCheckpoint next block num to be delivered to plugin, blocks are delivered in order from checkpoint
+// Delivering is set while next block is delivered outside a transaction, data of next block is rolled back before delivered again
+type PluginCheckpoint struct {
+	Plugin     string `gorm:"primaryKey;size:100" json:"plugin"`
+	Next       uint   `gorm:"not null;default:0" json:"next"`
+	Delivering bool   `gorm:"not null;default:false" json:"delivering"`
+	UpdatedAt  int64  `gorm:"autoUpdateTime" json:"updated_at"`
+}
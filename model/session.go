@@ -24,4 +24,13 @@ func (j SessionValidator) Marshal() []byte {
 	return b
 }
 
-func (j *SessionValidator) Scan(src interface{}) error { return json.Unmarshal(src.([]byte), j) }
+func (j *SessionValidator) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, j)
+	case string:
+		return json.Unmarshal([]byte(v), j)
+	}
+	// empty set is saved as null
+	return nil
+}
@@ -0,0 +1,25 @@
+package model
+
+import "encoding/json"
+
+// StorageEntry decoded entry of storage item, keys are nil if hashed without concat
+type StorageEntry struct {
+	Key   string            `json:"key"`
+	Keys  []json.RawMessage `json:"keys"`
+	Value json.RawMessage   `json:"value"`
+}
+
+// StorageQuery storage item read at block, entry of full keys or entries of map iteration
+type StorageQuery struct {
+	BlockNum    uint           `json:"block_num"`
+	BlockHash   string         `json:"block_hash"`
+	SpecVersion int            `json:"spec_version"`
+	Module      string         `json:"module"`
+	Method      string         `json:"method"`
+	KeyTypes    []string       `json:"key_types"`
+	ValueType   string         `json:"value_type"`
+	Entry       *StorageEntry  `json:"entry,omitempty"`
+	List        []StorageEntry `json:"list,omitempty"`
+	EndCursor   string         `json:"end_cursor,omitempty"`
+	HasNextPage bool           `json:"has_next_page"`
+}
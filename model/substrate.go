@@ -38,13 +38,12 @@ type ChainBlock struct {
 	Validator       string `json:"validator"`
 	CodecError      bool   `json:"codec_error"`
 	Finalized       bool   `json:"finalized"`
+	// AuthorSelectionMode how the validator was selected, CBC AuthorSelectionMode or Aura/Babe
+	AuthorSelectionMode string `json:"author_selection_mode" gorm:"default: null;size:20"`
 }
 
 func (c ChainBlock) TableName() string {
-	if c.BlockNum/SplitTableBlockNum == 0 {
-		return "chain_blocks"
-	}
-	return fmt.Sprintf("chain_blocks_%d", c.BlockNum/SplitTableBlockNum)
+	return splitTableName("chain_blocks", c.BlockNum)
 }
 
 func (c *ChainBlock) AsPlugin() *storage.Block {
@@ -74,10 +73,7 @@ type ChainEvent struct {
 }
 
 func (c ChainEvent) TableName() string {
-	if c.BlockNum/SplitTableBlockNum == 0 {
-		return "chain_events"
-	}
-	return fmt.Sprintf("chain_events_%d", c.BlockNum/SplitTableBlockNum)
+	return splitTableName("chain_events", c.BlockNum)
 }
 
 func (c ChainEvent) Id() uint {
@@ -300,14 +296,11 @@ func (c ChainExtrinsic) Id() uint {
 }
 
 func (c ChainExtrinsic) TableName() string {
-	if c.BlockNum/SplitTableBlockNum == 0 {
-		return "chain_extrinsics"
-	}
-	return fmt.Sprintf("chain_extrinsics_%d", c.BlockNum/SplitTableBlockNum)
+	return splitTableName("chain_extrinsics", c.BlockNum)
 }
 
 func ExtrinsicTableIndexByBlock(blockNum uint) int {
-	return int(blockNum / SplitTableBlockNum)
+	return int(TableIndex(blockNum))
 }
 
 func (c *ChainExtrinsic) AsPlugin() *storage.Extrinsic {
@@ -342,13 +335,14 @@ type ChainLog struct {
 	LogType   string  `json:"log_type" `
 	Data      LogData `json:"data" gorm:"type:json;"`
 	Finalized bool    `json:"finalized"`
+	Engine    string  `json:"engine" gorm:"size:10"`
+	Kind      string  `json:"kind" gorm:"size:40"`
+	Slot      uint64  `json:"slot"`
+	Decoded   LogData `json:"decoded" gorm:"type:json;"`
 }
 
 func (c ChainLog) TableName() string {
-	if c.BlockNum/SplitTableBlockNum == 0 {
-		return "chain_logs"
-	}
-	return fmt.Sprintf("chain_logs_%d", c.BlockNum/SplitTableBlockNum)
+	return splitTableName("chain_logs", c.BlockNum)
 }
 
 func (c ChainLog) Id() uint {
@@ -359,6 +353,9 @@ type LogData map[string]interface{}
 
 func (l *LogData) Scan(value interface{}) error {
 	switch v := value.(type) {
+	case nil:
+		// logs saved before decoded column
+		return nil
 	case []byte:
 		if len(v) == 0 {
 			return nil
@@ -556,3 +553,11 @@ func UpdateAccountExtrinsicMapping(tx *gorm.DB, accountId string, blockNum uint)
 	m.ExtrinsicTable = append(m.ExtrinsicTable, idx)
 	return tx.Model(&AccountExtrinsicMapping{}).Where("id = ?", m.Id).Update("extrinsic_table", m.ExtrinsicTable).Error
 }
+
+// BlockRangeData indexed data of a block range
+type BlockRangeData struct {
+	Blocks     []ChainBlock
+	Extrinsics []ChainExtrinsic
+	Events     []ChainEvent
+	Logs       []ChainLog
+}
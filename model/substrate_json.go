@@ -14,6 +14,8 @@ type ChainBlockJson struct {
 	SpecVersion     int    `json:"spec_version"`
 	Validator       string `json:"validator"`
 	Finalized       bool   `json:"finalized"`
+
+	AuthorSelectionMode string `json:"author_selection_mode,omitempty"`
 }
 
 type SampleBlockJson struct {
@@ -43,20 +45,49 @@ type ChainExtrinsicJson struct {
 }
 
 type ExtrinsicDetail struct {
-	BlockTimestamp     int             `json:"block_timestamp"`
-	BlockNum           uint            `json:"block_num"`
-	ExtrinsicIndex     string          `json:"extrinsic_index"`
-	CallModuleFunction string          `json:"call_module_function"`
-	CallModule         string          `json:"call_module"`
-	AccountId          string          `json:"account_id"`
-	Signature          string          `json:"signature"`
-	Nonce              int             `json:"nonce"`
-	ExtrinsicHash      string          `json:"extrinsic_hash"`
-	Success            bool            `json:"success"`
-	Params             ExtrinsicParams `json:"params"`
-	Fee                decimal.Decimal `json:"fee"`
-	Finalized          bool            `json:"finalized"`
-	Lifetime           *Lifetime       `json:"lifetime"`
+	BlockTimestamp     int                  `json:"block_timestamp"`
+	BlockNum           uint                 `json:"block_num"`
+	ExtrinsicIndex     string               `json:"extrinsic_index"`
+	CallModuleFunction string               `json:"call_module_function"`
+	CallModule         string               `json:"call_module"`
+	AccountId          string               `json:"account_id"`
+	Signature          string               `json:"signature"`
+	Nonce              int                  `json:"nonce"`
+	ExtrinsicHash      string               `json:"extrinsic_hash"`
+	Success            bool                 `json:"success"`
+	Params             ExtrinsicParams      `json:"params"`
+	Fee                decimal.Decimal      `json:"fee"`
+	Finalized          bool                 `json:"finalized"`
+	Lifetime           *Lifetime            `json:"lifetime"`
+	Errors             []ExtrinsicErrorJson `json:"errors,omitempty"`
+	Calls              []ExtrinsicCall      `json:"calls,omitempty"`
+}
+
+// ExtrinsicCall nested call of extrinsic call tree, path is the position in the tree, e.g. "0.2"
+type ExtrinsicCall struct {
+	Path               string              `json:"path"`
+	CallModule         string              `json:"call_module"`
+	CallModuleFunction string              `json:"call_module_function"`
+	Params             []ExtrinsicParam    `json:"params,omitempty"`
+	Events             []string            `json:"events,omitempty"`
+	Success            bool                `json:"success"`
+	Error              *ExtrinsicCallError `json:"error,omitempty"`
+	Calls              []ExtrinsicCall     `json:"calls,omitempty"`
+}
+
+// ExtrinsicCallError DispatchError of nested call and the event raising it
+type ExtrinsicCallError struct {
+	Source string      `json:"source"`
+	Value  interface{} `json:"value"`
+}
+
+// ExtrinsicErrorJson dispatch error of extrinsic, call_path is empty for extrinsic call, e.g. "0.2" for nested call
+type ExtrinsicErrorJson struct {
+	CallPath string `json:"call_path"`
+	Source   string `json:"source"`
+	Module   string `json:"module"`
+	Name     string `json:"name"`
+	Doc      string `json:"doc"`
 }
 
 type Lifetime struct {
@@ -78,10 +109,14 @@ type ChainEventJson struct {
 }
 
 type ChainLogJson struct {
-	BlockNum int    `json:"block_num"`
-	LogIndex string `json:"log_index" gorm:"default: null;size:100"`
-	LogType  string `json:"log_type"`
-	Data     string `json:"data"`
+	BlockNum int     `json:"block_num"`
+	LogIndex string  `json:"log_index" gorm:"default: null;size:100"`
+	LogType  string  `json:"log_type"`
+	Data     string  `json:"data"`
+	Engine   string  `json:"engine,omitempty"`
+	Kind     string  `json:"kind,omitempty"`
+	Slot     uint64  `json:"slot,omitempty"`
+	Decoded  LogData `json:"decoded,omitempty"`
 }
 
 type TransferJson struct {
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/itering/subscan/util"
+)
+
+// ChainBlockWeight block consumed weight and length, measured against the BlockWeights/BlockLength limits of block spec
+type ChainBlockWeight struct {
+	ID                   uint    `gorm:"primary_key;autoIncrement:false" json:"-"`
+	BlockNum             uint    `json:"block_num" gorm:"index:block_num,unique"`
+	BlockTimestamp       int     `json:"block_timestamp" gorm:"index:block_timestamp"`
+	SpecVersion          int     `json:"spec_version"`
+	NormalRefTime        uint64  `json:"normal_ref_time"`
+	NormalProofSize      uint64  `json:"normal_proof_size"`
+	OperationalRefTime   uint64  `json:"operational_ref_time"`
+	OperationalProofSize uint64  `json:"operational_proof_size"`
+	MandatoryRefTime     uint64  `json:"mandatory_ref_time"`
+	MandatoryProofSize   uint64  `json:"mandatory_proof_size"`
+	Length               uint64  `json:"length"`
+	MaxRefTime           uint64  `json:"max_ref_time"`
+	MaxProofSize         uint64  `json:"max_proof_size"`
+	MaxLength            uint64  `json:"max_length"`
+	RefTimeRatio         float64 `json:"ref_time_ratio"`
+	ProofSizeRatio       float64 `json:"proof_size_ratio"`
+	LengthRatio          float64 `json:"length_ratio"`
+}
+
+func (c ChainBlockWeight) TableName() string {
+	return "chain_block_weights"
+}
+
+// BlockWeightPoint time-series aggregation of ChainBlockWeight
+type BlockWeightPoint struct {
+	Time              int     `json:"time" gorm:"column:time_bucket"`
+	BlockCount        int     `json:"block_count"`
+	AvgRefTimeRatio   float64 `json:"avg_ref_time_ratio"`
+	MaxRefTimeRatio   float64 `json:"max_ref_time_ratio"`
+	AvgProofSizeRatio float64 `json:"avg_proof_size_ratio"`
+	MaxProofSizeRatio float64 `json:"max_proof_size_ratio"`
+	AvgLengthRatio    float64 `json:"avg_length_ratio"`
+}
+
+// ParseWeight parse decoded Weight, WeightV1 is u64, WeightV2 is {ref_time, proof_size}
+func ParseWeight(raw interface{}) (refTime, proofSize uint64) {
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			switch strings.ToLower(strings.ReplaceAll(key, "_", "")) {
+			case "reftime":
+				refTime = util.DecimalFromInterface(value).BigInt().Uint64()
+			case "proofsize":
+				proofSize = util.DecimalFromInterface(value).BigInt().Uint64()
+			}
+		}
+	case nil:
+	default:
+		refTime = util.DecimalFromInterface(v).BigInt().Uint64()
+	}
+	return
+}
+
+// SetConsumed fill consumed weight with decoded System.BlockWeight(PerDispatchClass<Weight>)
+func (c *ChainBlockWeight) SetConsumed(perClass map[string]interface{}) {
+	for class, weight := range perClass {
+		refTime, proofSize := ParseWeight(weight)
+		switch strings.ToLower(class) {
+		case "normal":
+			c.NormalRefTime, c.NormalProofSize = refTime, proofSize
+		case "operational":
+			c.OperationalRefTime, c.OperationalProofSize = refTime, proofSize
+		case "mandatory":
+			c.MandatoryRefTime, c.MandatoryProofSize = refTime, proofSize
+		}
+	}
+}
+
+// SetLimits fill limits with decoded System.BlockWeights and System.BlockLength constants, then compute ratios
+func (c *ChainBlockWeight) SetLimits(blockWeights, blockLength map[string]interface{}) {
+	c.MaxRefTime, c.MaxProofSize = ParseWeight(blockWeights["max_block"])
+	if perClass, ok := blockLength["max"].(map[string]interface{}); ok {
+		for _, value := range perClass {
+			if limit := util.DecimalFromInterface(value).BigInt().Uint64(); limit > c.MaxLength {
+				c.MaxLength = limit
+			}
+		}
+	}
+	c.RefTimeRatio = ratio(c.NormalRefTime+c.OperationalRefTime+c.MandatoryRefTime, c.MaxRefTime)
+	c.ProofSizeRatio = ratio(c.NormalProofSize+c.OperationalProofSize+c.MandatoryProofSize, c.MaxProofSize)
+	c.LengthRatio = ratio(c.Length, c.MaxLength)
+}
+
+func ratio(used, limit uint64) float64 {
+	if limit == 0 {
+		return 0
+	}
+	return float64(used) / float64(limit)
+}
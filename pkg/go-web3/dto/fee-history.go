@@ -0,0 +1,13 @@
+/**
+ * @file fee-history.go
+ */
+
+package dto
+
+// FeeHistory result of eth_feeHistory, quantities are hex
+type FeeHistory struct {
+	OldestBlock   string     `json:"oldestBlock"`
+	BaseFeePerGas []string   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64  `json:"gasUsedRatio"`
+	Reward        [][]string `json:"reward,omitempty"`
+}
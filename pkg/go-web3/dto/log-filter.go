@@ -0,0 +1,14 @@
+/**
+ * @file log-filter.go
+ */
+
+package dto
+
+// LogFilter filter of eth_getLogs, BlockHash excludes FromBlock and ToBlock
+type LogFilter struct {
+	FromBlock string        `json:"fromBlock,omitempty"`
+	ToBlock   string        `json:"toBlock,omitempty"`
+	Address   interface{}   `json:"address,omitempty"` // address or list of addresses
+	Topics    []interface{} `json:"topics,omitempty"`  // topic, list of topics(or) or nil(any) by position
+	BlockHash string        `json:"blockHash,omitempty"`
+}
@@ -48,6 +48,20 @@ type Error struct {
 	Data    interface{} `json:"data"`
 }
 
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// IsMethodNotFound error of method not supported by node
+func IsMethodNotFound(err error) bool {
+	var rpcErr *Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	// -32601 method not found
+	return rpcErr.Code == -32601 || strings.Contains(strings.ToLower(rpcErr.Message), "method not found")
+}
+
 func (pointer *RequestResult) ToStringArray() ([]string, error) {
 
 	if err := pointer.checkResponse(); err != nil {
@@ -280,6 +294,68 @@ func (pointer *RequestResult) ToBlock() (*Block, error) {
 
 }
 
+// ToTransactionReceipts receipts of eth_getBlockReceipts
+func (pointer *RequestResult) ToTransactionReceipts() ([]*TransactionReceipt, error) {
+
+	if err := pointer.checkResponse(); err != nil {
+		return nil, err
+	}
+
+	var receipts []*TransactionReceipt
+
+	marshal, err := json.Marshal(pointer.Result)
+
+	if err != nil {
+		return nil, customerror.UNPARSEABLEINTERFACE
+	}
+
+	err = json.Unmarshal(marshal, &receipts)
+
+	return receipts, err
+
+}
+
+// ToTransactionLogs logs of eth_getLogs
+func (pointer *RequestResult) ToTransactionLogs() ([]TransactionLogs, error) {
+
+	if err := pointer.checkResponse(); err != nil {
+		return nil, err
+	}
+
+	var logs []TransactionLogs
+
+	marshal, err := json.Marshal(pointer.Result)
+
+	if err != nil {
+		return nil, customerror.UNPARSEABLEINTERFACE
+	}
+
+	err = json.Unmarshal(marshal, &logs)
+
+	return logs, err
+
+}
+
+func (pointer *RequestResult) ToFeeHistory() (*FeeHistory, error) {
+
+	if err := pointer.checkResponse(); err != nil {
+		return nil, err
+	}
+
+	feeHistory := &FeeHistory{}
+
+	marshal, err := json.Marshal(pointer.Result)
+
+	if err != nil {
+		return nil, customerror.UNPARSEABLEINTERFACE
+	}
+
+	err = json.Unmarshal(marshal, feeHistory)
+
+	return feeHistory, err
+
+}
+
 func (pointer *RequestResult) ToSyncingResponse() (*SyncingResponse, error) {
 
 	if err := pointer.checkResponse(); err != nil {
@@ -319,7 +395,7 @@ func (pointer *RequestResult) ToSyncingResponse() (*SyncingResponse, error) {
 func (pointer *RequestResult) checkResponse() error {
 
 	if pointer.Error != nil {
-		return errors.New(pointer.Error.Message)
+		return pointer.Error
 	}
 
 	if pointer.Result == nil {
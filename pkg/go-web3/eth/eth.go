@@ -569,6 +569,30 @@ func (eth *Eth) Call(ctx context.Context, transaction *dto.TransactionParameters
 
 }
 
+// CallAt - Executes a new message call immediately without creating a transaction on the block chain, at the given block.
+// Parameters:
+//  1. Object - The transaction call object, see Call
+//  2. QUANTITY|TAG - integer block number, or the string "latest", "earliest" or "pending"
+//
+// Returns:
+//   - DATA - the return value of executed contract.
+func (eth *Eth) CallAt(ctx context.Context, transaction *dto.TransactionParameters, defaultBlockParameter string) (string, error) {
+
+	params := make([]interface{}, 2)
+	params[0] = transaction.Transform()
+	params[1] = defaultBlockParameter
+
+	pointer := &dto.RequestResult{}
+
+	err := eth.provider.SendRequest(ctx, pointer, "eth_call", params)
+
+	if err != nil {
+		return "", err
+	}
+
+	return pointer.ToString()
+}
+
 // CompileSolidity - Returns compiled solidity code.
 // Reference: https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_compilesolidity
 // Parameters:
@@ -856,3 +880,108 @@ func (eth *Eth) GetChainId(ctx context.Context) (*big.Int, error) {
 
 	return pointer.ToBigInt()
 }
+
+// GetBlockReceipts - Returns receipts of all transactions in a block.
+// Reference: https://ethereum.github.io/execution-apis/api-documentation/ eth_getBlockReceipts
+// Parameters:
+//   - number, QUANTITY - number of block
+//
+// Returns:
+//  1. Array - receipts of transactions in the block, see GetTransactionReceipt
+//  2. error, dto.IsMethodNotFound if the node lacks the method
+func (eth *Eth) GetBlockReceipts(ctx context.Context, number *big.Int) ([]*dto.TransactionReceipt, error) {
+
+	params := make([]string, 1)
+	params[0] = utils.IntToHex(number)
+
+	pointer := &dto.RequestResult{}
+
+	err := eth.provider.SendRequest(ctx, pointer, "eth_getBlockReceipts", params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.ToTransactionReceipts()
+}
+
+// GetTransactionReceipts - Returns receipts of transactions, requested in one JSON-RPC batch.
+// Parameters:
+//   - Array of DATA, 32 Bytes - hashes of transactions
+//
+// Returns:
+//  1. Array - receipts in the order of hashes, see GetTransactionReceipt
+//  2. error, of the batch or the first failed receipt
+func (eth *Eth) GetTransactionReceipts(ctx context.Context, hashes []string) ([]*dto.TransactionReceipt, error) {
+
+	batch := make([]providers.BatchElem, len(hashes))
+	for i, hash := range hashes {
+		batch[i] = providers.BatchElem{Method: "eth_getTransactionReceipt", Params: []string{hash}, Result: &dto.RequestResult{}}
+	}
+
+	if err := eth.provider.SendBatchRequest(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	receipts := make([]*dto.TransactionReceipt, len(hashes))
+	for i, elem := range batch {
+		receipt, err := elem.Result.(*dto.RequestResult).ToTransactionReceipt()
+		if err != nil {
+			return nil, err
+		}
+		receipts[i] = receipt
+	}
+	return receipts, nil
+}
+
+// GetLogs - Returns an array of all logs matching a given filter object.
+// Reference: https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
+// Parameters:
+//   - Object - the filter object, see dto.LogFilter
+//
+// Returns:
+//  1. Array - log objects
+//  2. error
+func (eth *Eth) GetLogs(ctx context.Context, filter *dto.LogFilter) ([]dto.TransactionLogs, error) {
+
+	params := make([]*dto.LogFilter, 1)
+	params[0] = filter
+
+	pointer := &dto.RequestResult{}
+
+	err := eth.provider.SendRequest(ctx, pointer, "eth_getLogs", params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.ToTransactionLogs()
+}
+
+// FeeHistory - Returns base fee per gas and transaction effective priority fee per gas history of the requested block range.
+// Reference: https://ethereum.github.io/execution-apis/api-documentation/ eth_feeHistory
+// Parameters:
+//   - blockCount, QUANTITY - number of blocks in the requested range
+//   - newestBlock, QUANTITY|TAG - highest block of the requested range
+//   - rewardPercentiles, Array of float - percentiles of effective priority fees, ascending
+//
+// Returns:
+//  1. Object - see dto.FeeHistory
+//  2. error
+func (eth *Eth) FeeHistory(ctx context.Context, blockCount uint64, newestBlock string, rewardPercentiles []float64) (*dto.FeeHistory, error) {
+
+	params := []interface{}{utils.IntToHex(new(big.Int).SetUint64(blockCount)), newestBlock}
+	if len(rewardPercentiles) > 0 {
+		params = append(params, rewardPercentiles)
+	}
+
+	pointer := &dto.RequestResult{}
+
+	err := eth.provider.SendRequest(ctx, pointer, "eth_feeHistory", params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.ToFeeHistory()
+}
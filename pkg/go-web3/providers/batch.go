@@ -0,0 +1,57 @@
+/**
+ * @file batch.go
+ */
+
+package providers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/itering/subscan/pkg/go-web3/providers/util"
+)
+
+// batchBody JSON-RPC batch request, id of request is the index in batch
+func batchBody(batch []BatchElem) ([]byte, error) {
+	requests := make([]util.JSONRPCObject, len(batch))
+	for i, elem := range batch {
+		requests[i] = util.JSONRPCObject{Version: "2.0", Method: elem.Method, Params: elem.Params, ID: i}
+	}
+	return json.Marshal(requests)
+}
+
+// dispatchBatch unmarshal responses to results of batch by id, responses may be out of order
+// node without batch support responds a single error object
+func dispatchBatch(batch []BatchElem, data []byte) error {
+	var responses []json.RawMessage
+	if err := json.Unmarshal(data, &responses); err != nil {
+		var single struct {
+			Error *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(data, &single) == nil && single.Error != nil {
+			return errors.New(single.Error.Message)
+		}
+		return err
+	}
+	if len(responses) != len(batch) {
+		return fmt.Errorf("batch responses %d, want %d", len(responses), len(batch))
+	}
+	for _, raw := range responses {
+		var r struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return err
+		}
+		if r.ID < 0 || r.ID >= len(batch) {
+			return fmt.Errorf("unexpected batch response id %d", r.ID)
+		}
+		if err := json.Unmarshal(raw, batch[r.ID].Result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
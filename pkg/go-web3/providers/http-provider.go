@@ -22,6 +22,7 @@
 package providers
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
@@ -99,4 +100,36 @@ func (provider HTTPProvider) SendRequest(ctx context.Context, v interface{}, met
 	return
 }
 
+// SendBatchRequest send requests of batch in one JSON-RPC batch
+func (provider HTTPProvider) SendBatchRequest(ctx context.Context, batch []BatchElem) (err error) {
+	if len(batch) == 0 {
+		return nil
+	}
+	var (
+		req  *http.Request
+		rsp  *http.Response
+		body []byte
+		data []byte
+	)
+	if body, err = batchBody(batch); err != nil {
+		return
+	}
+	if req, err = http.NewRequestWithContext(ctx, http.MethodPost, provider.address, bytes.NewReader(body)); err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json;charset=utf-8")
+	req.Header.Add("Accept", "application/json")
+	if rsp, err = provider.client.Do(req); err != nil {
+		return
+	}
+	defer rsp.Body.Close()
+	if data, err = io.ReadAll(rsp.Body); err != nil {
+		return
+	}
+	if rsp.StatusCode != 200 {
+		return errors.New(rsp.Status)
+	}
+	return dispatchBatch(batch, data)
+}
+
 func (provider HTTPProvider) Close() error { return nil }
@@ -0,0 +1,57 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPProvider_SendBatchRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var requests []map[string]interface{}
+		if json.Unmarshal(body, &requests) != nil {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch not supported"}}`))
+			return
+		}
+		var responses []map[string]interface{}
+		// responses out of order
+		for i := len(requests) - 1; i >= 0; i-- {
+			response := map[string]interface{}{"jsonrpc": "2.0", "id": requests[i]["id"]}
+			if requests[i]["method"] == "eth_chainId" {
+				response["result"] = "0x1"
+			} else {
+				response["error"] = map[string]interface{}{"code": -32601, "message": "the method does not exist"}
+			}
+			responses = append(responses, response)
+		}
+		_ = json.NewEncoder(w).Encode(responses)
+	}))
+	defer server.Close()
+
+	provider := NewHTTPProvider(server.URL, 10, false)
+	batch := []BatchElem{
+		{Method: "eth_chainId", Result: &dto.RequestResult{}},
+		{Method: "eth_getBlockReceipts", Params: []string{"0x1"}, Result: &dto.RequestResult{}},
+		{Method: "eth_chainId", Result: &dto.RequestResult{}},
+	}
+	assert.NoError(t, provider.SendBatchRequest(context.TODO(), batch))
+	chainId, err := batch[0].Result.(*dto.RequestResult).ToString()
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1", chainId)
+	_, err = batch[1].Result.(*dto.RequestResult).ToString()
+	assert.True(t, dto.IsMethodNotFound(err))
+	assert.NotNil(t, batch[2].Result.(*dto.RequestResult).Result)
+
+	assert.NoError(t, provider.SendBatchRequest(context.TODO(), nil))
+
+	err = dispatchBatch(batch, []byte(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch not supported"}}`))
+	assert.EqualError(t, err, "batch not supported")
+	assert.Error(t, dispatchBatch(batch, []byte(`[]`)))
+}
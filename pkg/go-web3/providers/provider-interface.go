@@ -25,5 +25,14 @@ import "context"
 
 type ProviderInterface interface {
 	SendRequest(ctx context.Context, v any, method string, params interface{}) error
+	SendBatchRequest(ctx context.Context, batch []BatchElem) error
 	Close() error
 }
+
+// BatchElem request of JSON-RPC batch, response of the request is unmarshalled to Result
+// error of the request is kept in the response, like dto.RequestResult.Error
+type BatchElem struct {
+	Method string
+	Params interface{}
+	Result interface{}
+}
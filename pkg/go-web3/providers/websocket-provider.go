@@ -24,6 +24,7 @@ package providers
 import (
 	"context"
 	"math/rand"
+	"sync"
 
 	"github.com/itering/subscan/pkg/go-web3/constants"
 
@@ -33,7 +34,10 @@ import (
 
 type WebSocketProvider struct {
 	address string
-	ws      *websocket.Conn
+
+	// mu serializes requests, response of a request is read from the shared connection
+	mu sync.Mutex
+	ws *websocket.Conn
 }
 
 func NewWebSocketProvider(address string) *WebSocketProvider {
@@ -42,31 +46,88 @@ func NewWebSocketProvider(address string) *WebSocketProvider {
 	return provider
 }
 
-func (provider WebSocketProvider) SendRequest(ctx context.Context, v interface{}, method string, params interface{}) error {
-
-	bodyString := util.JSONRPCObject{Version: "2.0", Method: method, Params: params, ID: rand.Intn(100)}
-
+// conn dial once and reuse the connection, caller holds mu
+func (provider *WebSocketProvider) conn() (*websocket.Conn, error) {
 	if provider.ws == nil {
 		ws, err := websocket.Dial(provider.address, "", provider.address)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		provider.ws = ws
 	}
+	return provider.ws, nil
+}
+
+// reset close broken connection, redialed by next request, caller holds mu
+func (provider *WebSocketProvider) reset() {
+	if provider.ws != nil {
+		_ = provider.ws.Close()
+		provider.ws = nil
+	}
+}
+
+func (provider *WebSocketProvider) SendRequest(ctx context.Context, v interface{}, method string, params interface{}) error {
+
+	bodyString := util.JSONRPCObject{Version: "2.0", Method: method, Params: params, ID: rand.Intn(100)}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	ws, err := provider.conn()
+	if err != nil {
+		return err
+	}
 
 	message := []byte(bodyString.AsJsonString())
-	_, err := provider.ws.Write(message)
+	if _, err = ws.Write(message); err != nil {
+		provider.reset()
+		return err
+	}
+
+	if err = websocket.JSON.Receive(ws, v); err != nil {
+		provider.reset()
+		return err
+	}
+	return nil
+
+}
+
+// SendBatchRequest send requests of batch in one JSON-RPC batch
+func (provider *WebSocketProvider) SendBatchRequest(ctx context.Context, batch []BatchElem) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	body, err := batchBody(batch)
 	if err != nil {
 		return err
 	}
 
-	return websocket.JSON.Receive(provider.ws, v)
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	ws, err := provider.conn()
+	if err != nil {
+		return err
+	}
+
+	if _, err = ws.Write(body); err != nil {
+		provider.reset()
+		return err
+	}
 
+	var data []byte
+	if err = websocket.Message.Receive(ws, &data); err != nil {
+		provider.reset()
+		return err
+	}
+	return dispatchBatch(batch, data)
 }
 
-func (provider WebSocketProvider) Close() error {
+func (provider *WebSocketProvider) Close() error {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
 	if provider.ws != nil {
-		return provider.ws.Close()
+		err := provider.ws.Close()
+		provider.ws = nil
+		return err
 	}
 
 	return customerror.WEBSOCKETNOTDENIFIED
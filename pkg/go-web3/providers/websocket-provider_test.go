@@ -0,0 +1,44 @@
+package providers
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+func TestWebSocketProvider_SendBatchRequest(t *testing.T) {
+	var conns int32
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		atomic.AddInt32(&conns, 1)
+		for {
+			var requests []map[string]interface{}
+			if err := websocket.JSON.Receive(ws, &requests); err != nil {
+				return
+			}
+			var responses []map[string]interface{}
+			for _, request := range requests {
+				responses = append(responses, map[string]interface{}{"jsonrpc": "2.0", "id": request["id"], "result": "0x1"})
+			}
+			_ = websocket.JSON.Send(ws, responses)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewWebSocketProvider("ws" + strings.TrimPrefix(server.URL, "http"))
+	defer provider.Close() // nolint: errcheck
+	for i := 0; i < 3; i++ {
+		batch := []BatchElem{{Method: "eth_chainId", Result: &dto.RequestResult{}}}
+		assert.NoError(t, provider.SendBatchRequest(context.TODO(), batch))
+		chainId, err := batch[0].Result.(*dto.RequestResult).ToString()
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1", chainId)
+	}
+	// connection is reused
+	assert.Equal(t, int32(1), atomic.LoadInt32(&conns))
+}
@@ -17,8 +17,9 @@ import (
 var srv *service.Service
 
 type Balance struct {
-	d    storage.Dao
-	pool subscan_plugin.RedisPool
+	d        storage.Dao
+	pool     subscan_plugin.RedisPool
+	relation func(ctx context.Context, addr string) interface{}
 }
 
 func (a *Balance) Commands() []cli.Command {
@@ -57,9 +58,24 @@ func (a *Balance) Enable() bool {
 
 func (a *Balance) ProcessBlock(context.Context, *storage.Block) error { return nil }
 
+// DeliverTx transfers and accounts of block are written through d
+func (a *Balance) DeliverTx(d storage.Dao, deliver func(subscan_plugin.Plugin) error) error {
+	return deliver(&Balance{d: d, pool: a.pool, relation: a.relation})
+}
+
+// Rollback delete transfers from block num before blocks delivered again
+func (a *Balance) Rollback(ctx context.Context, from uint) error {
+	return dao.DeleteTransfers(ctx, a.storage(), from)
+}
+
 func (a *Balance) SetRedisPool(pool subscan_plugin.RedisPool) {
 	a.pool = pool
-	srv = service.New(a.d, pool)
+	srv = service.New(a.d, pool, a.relation)
+}
+
+// SetAccountRelation relationships of account shown on account view, wired by host to the relation plugin
+func (a *Balance) SetAccountRelation(relation func(ctx context.Context, addr string) interface{}) {
+	a.relation = relation
 }
 
 func New() *Balance {
@@ -60,9 +60,9 @@ func InitTransfer(sg *Storage) {
 	db := sg.Dao.GetDbInstance().(*gorm.DB)
 
 	blockNum, _ := sg.Dao.GetCurrentBlockNum(c)
-	for i := int(blockNum); i >= 0; i -= int(model.SplitTableBlockNum) {
+	for index := int(model.TableIndex(uint(blockNum))); index >= 0; index-- {
 
-		tableName := model.TableNameFromInterface(&model.ChainEvent{BlockNum: uint(i)}, db)
+		tableName := model.TableNameFromInterface(&model.ChainEvent{BlockNum: model.TableBlockNum(uint(index))}, db)
 		var events []*model.ChainEvent
 
 		query := db.Table(tableName).
@@ -19,6 +19,16 @@ func CreateTransfer(ctx context.Context, d *Storage, transfer *bModel.Transfer)
 	return query.Error
 }
 
+// DeleteTransfers delete transfers from block num, metadata counted again
+func DeleteTransfers(ctx context.Context, d *Storage, from uint) error {
+	db := d.Dao.GetDbInstance().(*gorm.DB)
+	if err := db.WithContext(ctx).Where("block_num >= ?", from).Delete(&bModel.Transfer{}).Error; err != nil {
+		return err
+	}
+	RefreshMetadata(ctx, d)
+	return nil
+}
+
 func TransfersCursor(ctx context.Context, db storage.DB, limit int, before, after *uint, opts ...model.Option) ([]bModel.Transfer, bool, bool) {
 	var list []bModel.Transfer
 	d := db.GetDbInstance().(*gorm.DB)
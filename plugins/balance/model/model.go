@@ -11,6 +11,7 @@ type Account struct {
 	Balance  decimal.Decimal `json:"balance" gorm:"type:decimal(65,0);index:balance;index:balance_address,priority:1"`
 	Locked   decimal.Decimal `json:"locked" gorm:"type:decimal(65,0);"`
 	Reserved decimal.Decimal `json:"reserved" gorm:"type:decimal(65,0);"`
+	Relation interface{}     `json:"relation,omitempty" gorm:"-"`
 }
 
 func (a *Account) TableName() string {
@@ -13,6 +13,8 @@ import (
 type Service struct {
 	d    storage.Dao
 	pool subscan_plugin.RedisPool
+	// relation multisig and proxy relationships of account, provided by relation plugin, nil if not registered
+	relation func(ctx context.Context, addr string) interface{}
 }
 
 func (s *Service) GetAccountListCursor(_ context.Context, limit int, before, after *uint) ([]model.Account, map[string]interface{}) {
@@ -38,6 +40,9 @@ func (s *Service) GetAccountJson(ctx context.Context, addr string) *model.Accoun
 	if account == nil {
 		return nil
 	}
+	if s.relation != nil {
+		account.Relation = s.relation(ctx, addr)
+	}
 	account.Address = address.Encode(account.Address)
 	return account
 }
@@ -68,9 +73,10 @@ func (s *Service) GetTransferCursor(ctx context.Context, addr string, blockNum u
 	}
 }
 
-func New(d storage.Dao, pool subscan_plugin.RedisPool) *Service {
+func New(d storage.Dao, pool subscan_plugin.RedisPool, relation func(ctx context.Context, addr string) interface{}) *Service {
 	return &Service{
-		d:    d,
-		pool: pool,
+		d:        d,
+		pool:     pool,
+		relation: relation,
 	}
 }
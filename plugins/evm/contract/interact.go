@@ -0,0 +1,330 @@
+package contract
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/itering/subscan/util"
+)
+
+// AbiArgument input or output of abi function, components of tuple
+type AbiArgument struct {
+	Name         string        `json:"name"`
+	Type         string        `json:"type"`
+	InternalType string        `json:"internal_type,omitempty"`
+	Components   []AbiArgument `json:"components,omitempty"`
+}
+
+// AbiFunction function of abi, overloaded functions are distinguished by signature
+type AbiFunction struct {
+	Name            string        `json:"name"`
+	Signature       string        `json:"signature"`
+	Selector        string        `json:"selector"`
+	StateMutability string        `json:"state_mutability"`
+	Inputs          []AbiArgument `json:"inputs"`
+	Outputs         []AbiArgument `json:"outputs"`
+}
+
+// AbiValue decoded output value
+type AbiValue struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// IsReadFunction view or pure function, can be called without transaction
+func IsReadFunction(method *abi.Method) bool {
+	return method.StateMutability == "view" || method.StateMutability == "pure" || method.Constant
+}
+
+// AbiFunctions read and write functions of abi, ordered by name
+func AbiFunctions(raw []byte) (read, write []AbiFunction, err error) {
+	var abiValue abi.ABI
+	if err = abiValue.UnmarshalJSON(raw); err != nil {
+		return nil, nil, err
+	}
+	read, write = []AbiFunction{}, []AbiFunction{}
+	names := make([]string, 0, len(abiValue.Methods))
+	for name := range abiValue.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		method := abiValue.Methods[name]
+		f := AbiFunction{
+			Name:            method.RawName,
+			Signature:       method.Sig,
+			Selector:        util.AddHex(util.BytesToHex(method.ID)),
+			StateMutability: method.StateMutability,
+			Inputs:          abiArguments(method.Inputs),
+			Outputs:         abiArguments(method.Outputs),
+		}
+		if IsReadFunction(&method) {
+			read = append(read, f)
+		} else {
+			write = append(write, f)
+		}
+	}
+	return
+}
+
+func abiArguments(args abi.Arguments) []AbiArgument {
+	list := make([]AbiArgument, 0, len(args))
+	for _, arg := range args {
+		list = append(list, abiArgument(arg.Name, arg.Type))
+	}
+	return list
+}
+
+func abiArgument(name string, t abi.Type) AbiArgument {
+	arg := AbiArgument{Name: name, Type: t.String(), InternalType: t.TupleRawName}
+	elem := &t
+	for elem.T == abi.SliceTy || elem.T == abi.ArrayTy {
+		elem = elem.Elem
+	}
+	if elem.T == abi.TupleTy {
+		// tuple type string is (type1,type2), as abi json is tuple
+		arg.Type = "tuple" + strings.TrimPrefix(t.String(), elem.String())
+		for i, component := range elem.TupleElems {
+			arg.Components = append(arg.Components, abiArgument(elem.TupleRawNames[i], *component))
+		}
+	}
+	return arg
+}
+
+// FindAbiMethod method of abi by signature, selector or name, name must not be overloaded
+func FindAbiMethod(raw []byte, method string) (*abi.Method, error) {
+	var abiValue abi.ABI
+	if err := abiValue.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	var found []abi.Method
+	for _, m := range abiValue.Methods {
+		if m.Sig == method || strings.EqualFold(util.AddHex(util.BytesToHex(m.ID)), util.AddHex(method)) {
+			return &m, nil
+		}
+		if m.RawName == method {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("method %s not found", method)
+	case 1:
+		return &found[0], nil
+	}
+	return nil, fmt.Errorf("method %s is overloaded, use signature instead", method)
+}
+
+// PackArguments call data of method, args are json values by input type
+// integers are decimal or 0x hex strings or numbers, bytes are 0x hex strings, tuples are objects or arrays
+func PackArguments(method *abi.Method, args []json.RawMessage) ([]byte, error) {
+	if len(args) != len(method.Inputs) {
+		return nil, fmt.Errorf("method %s expects %d arguments, got %d", method.Sig, len(method.Inputs), len(args))
+	}
+	values := make([]interface{}, 0, len(args))
+	for i, input := range method.Inputs {
+		v, err := abiValueOf(input.Type, args[i])
+		if err != nil {
+			return nil, fmt.Errorf("argument %d %s: %w", i, input.Name, err)
+		}
+		values = append(values, v.Interface())
+	}
+	data, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, method.ID...), data...), nil
+}
+
+func abiValueOf(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	typ := t.GetType()
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := jsonBigInt(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if typ == reflect.TypeOf(&big.Int{}) {
+			return reflect.ValueOf(n), nil
+		}
+		v := reflect.New(typ).Elem()
+		if t.T == abi.UintTy {
+			if n.Sign() < 0 || !n.IsUint64() || v.OverflowUint(n.Uint64()) {
+				return reflect.Value{}, fmt.Errorf("%s overflows %s", n, t)
+			}
+			v.SetUint(n.Uint64())
+		} else {
+			if !n.IsInt64() || v.OverflowInt(n.Int64()) {
+				return reflect.Value{}, fmt.Errorf("%s overflows %s", n, t)
+			}
+			v.SetInt(n.Int64())
+		}
+		return v, nil
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			var s string
+			if json.Unmarshal(raw, &s) != nil || (s != "true" && s != "false") {
+				return reflect.Value{}, errors.New("invalid bool")
+			}
+			b = s == "true"
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, errors.New("invalid string")
+		}
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil || !common.IsHexAddress(s) {
+			return reflect.Value{}, errors.New("invalid address")
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BytesTy:
+		b, err := jsonBytes(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+	case abi.FixedBytesTy, abi.FunctionTy:
+		b, err := jsonBytes(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v := reflect.New(typ).Elem()
+		if len(b) != v.Len() {
+			return reflect.Value{}, fmt.Errorf("%s expects %d bytes", t, v.Len())
+		}
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		var list []json.RawMessage
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return reflect.Value{}, fmt.Errorf("%s expects array", t)
+		}
+		var v reflect.Value
+		if t.T == abi.SliceTy {
+			v = reflect.MakeSlice(typ, len(list), len(list))
+		} else {
+			if len(list) != t.Size {
+				return reflect.Value{}, fmt.Errorf("%s expects %d elements", t, t.Size)
+			}
+			v = reflect.New(typ).Elem()
+		}
+		for i, item := range list {
+			elem, err := abiValueOf(*t.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("element %d: %w", i, err)
+			}
+			v.Index(i).Set(elem)
+		}
+		return v, nil
+	case abi.TupleTy:
+		list := make([]json.RawMessage, len(t.TupleElems))
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err == nil {
+			for i, name := range t.TupleRawNames {
+				if list[i] = fields[name]; list[i] == nil {
+					return reflect.Value{}, fmt.Errorf("tuple field %s is required", name)
+				}
+			}
+		} else if err = json.Unmarshal(raw, &list); err != nil || len(list) != len(t.TupleElems) {
+			return reflect.Value{}, fmt.Errorf("tuple expects object or array of %d elements", len(t.TupleElems))
+		}
+		v := reflect.New(typ).Elem()
+		for i, elem := range t.TupleElems {
+			field, err := abiValueOf(*elem, list[i])
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("tuple field %s: %w", t.TupleRawNames[i], err)
+			}
+			v.Field(i).Set(field)
+		}
+		return v, nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported type %s", t)
+}
+
+// jsonBigInt integer of json number or decimal or 0x hex string
+func jsonBigInt(raw json.RawMessage) (*big.Int, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		var n json.Number
+		if err = json.Unmarshal(raw, &n); err != nil {
+			return nil, errors.New("invalid integer")
+		}
+		s = n.String()
+	}
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %s", s)
+	}
+	return n, nil
+}
+
+func jsonBytes(raw json.RawMessage) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil || !strings.HasPrefix(s, "0x") {
+		return nil, errors.New("bytes expects 0x prefixed hex")
+	}
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return nil, errors.New("bytes expects 0x prefixed hex")
+	}
+	return b, nil
+}
+
+// UnpackOutputs decoded return data of method
+func UnpackOutputs(method *abi.Method, data []byte) ([]AbiValue, error) {
+	values, err := method.Outputs.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]AbiValue, 0, len(values))
+	for i, output := range method.Outputs {
+		list = append(list, AbiValue{Name: output.Name, Type: output.Type.String(), Value: abiJsonValue(output.Type, reflect.ValueOf(values[i]))})
+	}
+	return list, nil
+}
+
+// abiJsonValue json friendly value, integers are decimal strings and bytes are hex strings
+func abiJsonValue(t abi.Type, v reflect.Value) interface{} {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		if n, ok := v.Interface().(*big.Int); ok {
+			return n.String()
+		}
+		return fmt.Sprintf("%d", v.Interface())
+	case abi.AddressTy:
+		return strings.ToLower(v.Interface().(common.Address).Hex())
+	case abi.BytesTy:
+		return util.AddHex(util.BytesToHex(v.Bytes()))
+	case abi.FixedBytesTy, abi.FunctionTy:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return util.AddHex(util.BytesToHex(b))
+	case abi.SliceTy, abi.ArrayTy:
+		list := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			list = append(list, abiJsonValue(*t.Elem, v.Index(i)))
+		}
+		return list
+	case abi.TupleTy:
+		m := make(map[string]interface{}, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			m[t.TupleRawNames[i]] = abiJsonValue(*elem, v.Field(i))
+		}
+		return m
+	}
+	return v.Interface()
+}
@@ -0,0 +1,87 @@
+package contract
+
+import (
+	"encoding/json"
+	"github.com/itering/subscan/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testInteractAbi = `[
+{"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+{"inputs":[{"components":[{"name":"id","type":"uint8"},{"name":"tags","type":"bytes32[]"}],"name":"item","type":"tuple"}],"name":"echo","outputs":[{"components":[{"name":"id","type":"uint8"},{"name":"tags","type":"bytes32[]"}],"name":"","type":"tuple"}],"stateMutability":"pure","type":"function"},
+{"inputs":[],"name":"get","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"name":"key","type":"uint256"}],"name":"get","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+func TestAbiFunctions(t *testing.T) {
+	read, write, err := AbiFunctions([]byte(testInteractAbi))
+	assert.NoError(t, err)
+	assert.Len(t, read, 4)
+	assert.Len(t, write, 1)
+	assert.Equal(t, "transfer(address,uint256)", write[0].Signature)
+	assert.Equal(t, "0xa9059cbb", write[0].Selector)
+
+	assert.Equal(t, "echo", read[1].Name)
+	assert.Equal(t, "tuple", read[1].Inputs[0].Type)
+	assert.Equal(t, []AbiArgument{{Name: "id", Type: "uint8"}, {Name: "tags", Type: "bytes32[]"}}, read[1].Inputs[0].Components)
+}
+
+func TestFindAbiMethod(t *testing.T) {
+	method, err := FindAbiMethod([]byte(testInteractAbi), "transfer")
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer(address,uint256)", method.Sig)
+
+	method, err = FindAbiMethod([]byte(testInteractAbi), "0x70a08231")
+	assert.NoError(t, err)
+	assert.Equal(t, "balanceOf(address)", method.Sig)
+
+	method, err = FindAbiMethod([]byte(testInteractAbi), "get(uint256)")
+	assert.NoError(t, err)
+	assert.Equal(t, "get(uint256)", method.Sig)
+
+	_, err = FindAbiMethod([]byte(testInteractAbi), "get")
+	assert.Error(t, err)
+	_, err = FindAbiMethod([]byte(testInteractAbi), "mint")
+	assert.Error(t, err)
+}
+
+func TestPackArguments(t *testing.T) {
+	method, _ := FindAbiMethod([]byte(testInteractAbi), "transfer")
+	data, err := PackArguments(method, []json.RawMessage{
+		json.RawMessage(`"0x1F98431c8aD98523631AE4a59f267346ea31F984"`),
+		json.RawMessage(`"0x10"`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "a9059cbb"+
+		"0000000000000000000000001f98431c8ad98523631ae4a59f267346ea31f984"+
+		"0000000000000000000000000000000000000000000000000000000000000010", util.BytesToHex(data))
+
+	_, err = PackArguments(method, []json.RawMessage{json.RawMessage(`"0x1234"`), json.RawMessage(`1`)})
+	assert.Error(t, err)
+	_, err = PackArguments(method, []json.RawMessage{json.RawMessage(`"0x1F98431c8aD98523631AE4a59f267346ea31F984"`)})
+	assert.Error(t, err)
+
+	echo, _ := FindAbiMethod([]byte(testInteractAbi), "echo")
+	tag := "0x" + "ab" + "00000000000000000000000000000000000000000000000000000000000000"
+	_, err = PackArguments(echo, []json.RawMessage{json.RawMessage(`{"id":256,"tags":[]}`)})
+	assert.Error(t, err)
+	data, err = PackArguments(echo, []json.RawMessage{json.RawMessage(`{"id":7,"tags":["` + tag + `"]}`)})
+	assert.NoError(t, err)
+
+	// echo returns the input tuple
+	values, err := UnpackOutputs(echo, data[4:])
+	assert.NoError(t, err)
+	assert.Equal(t, []AbiValue{{Name: "", Type: "(uint8,bytes32[])", Value: map[string]interface{}{"id": "7", "tags": []interface{}{tag}}}}, values)
+}
+
+func TestUnpackOutputs(t *testing.T) {
+	method, _ := FindAbiMethod([]byte(testInteractAbi), "balanceOf")
+	values, err := UnpackOutputs(method, util.HexToBytes("0x00000000000000000000000000000000000000000000000000000000000003e8"))
+	assert.NoError(t, err)
+	assert.Equal(t, []AbiValue{{Name: "", Type: "uint256", Value: "1000"}}, values)
+
+	_, err = UnpackOutputs(method, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/itering/subscan/share/analytics"
+)
+
+type analyticsRowsKey struct{}
+
+// AnalyticsRows analytics rows of a delivered block, pushed after checkpoint of the block committed
+type AnalyticsRows struct {
+	mu   sync.Mutex
+	rows map[string][]interface{}
+}
+
+// WithAnalyticsRows analytics rows written with ctx are collected instead of pushed
+func WithAnalyticsRows(ctx context.Context) (context.Context, *AnalyticsRows) {
+	rows := &AnalyticsRows{rows: make(map[string][]interface{})}
+	return context.WithValue(ctx, analyticsRowsKey{}, rows), rows
+}
+
+// Push push collected rows to analytics sink
+func (r *AnalyticsRows) Push() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for table, rows := range r.rows {
+		analytics.Push(table, rows...)
+	}
+	r.rows = make(map[string][]interface{})
+}
+
+// pushAnalytics collect row if ctx collect rows of a delivered block, otherwise push it
+func pushAnalytics(ctx context.Context, table string, row interface{}) {
+	rows, ok := ctx.Value(analyticsRowsKey{}).(*AnalyticsRows)
+	if !ok {
+		analytics.Push(table, row)
+		return
+	}
+	rows.mu.Lock()
+	defer rows.mu.Unlock()
+	rows.rows[table] = append(rows.rows[table], row)
+}
+
+func (t *Transaction) analyticsRow() analytics.EvmTransaction {
+	return analytics.EvmTransaction{
+		BlockNum:          t.BlockNum,
+		BlockTimestamp:    t.BlockTimestamp,
+		Hash:              t.Hash,
+		ExtrinsicIndex:    t.ExtrinsicIndex,
+		FromAddress:       t.FromAddress,
+		ToAddress:         t.ToAddress,
+		Contract:          t.Contract,
+		Value:             t.Value,
+		GasLimit:          t.GasLimit,
+		GasUsed:           t.GasUsed,
+		EffectiveGasPrice: t.EffectiveGasPrice,
+		TxnType:           t.TxnType,
+		Success:           t.Success,
+	}
+}
+
+// transferBlockNum transfer id is generated by receipt id, block_num * coefficient * receipt limit + ...
+func transferBlockNum(transferId uint64) uint64 {
+	return transferId / (TransactionIdGenerateCoefficient * TxnReceiptLimit)
+}
+
+func (t *TokensTransfers) analyticsRow() analytics.TokenTransfer {
+	category := Eip20Token
+	if t.Category == TransferCategoryErc721 {
+		category = Eip721Token
+	}
+	return analytics.TokenTransfer{
+		BlockNum:       transferBlockNum(t.TransferId),
+		BlockTimestamp: t.CreateAt,
+		TransferId:     t.TransferId,
+		Hash:           t.Hash,
+		Contract:       t.Contract,
+		Category:       category,
+		Sender:         t.Sender,
+		Receiver:       t.Receiver,
+		Value:          t.Value,
+		TokenId:        t.TokenId,
+	}
+}
+
+// AnalyticsBackfill push evm transactions and token transfers of block range [start, end] to analytics sink
+func AnalyticsBackfill(ctx context.Context, sink *analytics.Sink, start, end uint) error {
+	var transactions []Transaction
+	if err := sg.db.WithContext(ctx).Where("block_num BETWEEN ? AND ?", start, end).Find(&transactions).Error; err != nil {
+		return err
+	}
+	var rows []interface{}
+	for i := range transactions {
+		rows = append(rows, transactions[i].analyticsRow())
+	}
+	sink.Push(analytics.TableEvmTransactions, rows...)
+
+	var transfers []TokensTransfers
+	step := uint64(TransactionIdGenerateCoefficient * TxnReceiptLimit)
+	if err := sg.db.WithContext(ctx).Where("transfer_id >= ? AND transfer_id < ?", uint64(start)*step, uint64(end+1)*step).
+		Find(&transfers).Error; err != nil {
+		return err
+	}
+	rows = nil
+	for i := range transfers {
+		rows = append(rows, transfers[i].analyticsRow())
+	}
+	sink.Push(analytics.TableTokenTransfers, rows...)
+	return nil
+}
@@ -2,9 +2,11 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/itering/subscan/model"
 	balanceModel "github.com/itering/subscan/plugins/balance/model"
+	evmContract "github.com/itering/subscan/plugins/evm/contract"
 	"github.com/itering/subscan/util"
 	"github.com/shopspring/decimal"
 	"strings"
@@ -27,12 +29,26 @@ type ISrv interface {
 	TransactionsCursor(ctx context.Context, limit int, before, after *uint, opts ...model.Option) ([]TransactionSampleJson, map[string]interface{})
 	AccountsCursor(ctx context.Context, address string, limit int, before, after *string) ([]AccountsJson, map[string]interface{})
 	ContractsCursor(ctx context.Context, limit int, before, after *string) ([]ContractsJson, map[string]interface{})
+	ContractFunctions(ctx context.Context, address string) (*ContractFunctionsJson, error)
+	ContractCall(ctx context.Context, p *ContractInteraction) ([]evmContract.AbiValue, error)
+	ContractWriteTransaction(ctx context.Context, p *ContractInteraction) (*ContractUnsignedTransaction, error)
+	ProxyTimeline(ctx context.Context, address string) *ProxyTimelineJson
 
 	AccountTokens(ctx context.Context, address, category string) []AccountTokenJson
 	CollectiblesCursor(ctx context.Context, address string, contract string, limit int, before, after *string) ([]Erc721Holders, map[string]interface{})
+	NftMetadata(ctx context.Context, contract, tokenId string) *NftMetadata
+	NftMetadataRefresh(ctx context.Context, contract, tokenId string) (*NftMetadata, error)
+	NftContent(ctx context.Context, hash string) ([]byte, string, error)
 	TokenListCursor(ctx context.Context, contract, category string, limit int, before, after *string) ([]Token, map[string]interface{})
 	TokenTransfersCursor(ctx context.Context, address, tokenAddress, category string, limit int, before, after *uint) ([]TokenTransferJson, map[string]interface{})
 	TokenHoldersCursor(ctx context.Context, address string, limit int, before, after *string) ([]TokenHolder, map[string]interface{})
+
+	RPC_LatestBlockNum(ctx context.Context) uint64
+	RPC_GetBlock(ctx context.Context, blockNum *uint64, hash string, full bool) *RpcBlock
+	RPC_GetTransaction(ctx context.Context, hash string) *RpcTransaction
+	RPC_GetTransactionReceipt(ctx context.Context, hash string) *RpcReceipt
+	RPC_GetLogs(ctx context.Context, filter *LogFilter) ([]RpcLog, error)
+	RPC_Proxy(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error)
 }
 
 type IPagination interface {
@@ -11,6 +11,7 @@ import (
 	"github.com/itering/subscan/util/network"
 	"math/big"
 	"sync"
+	"sync/atomic"
 
 	"github.com/panjf2000/ants/v2"
 	"github.com/shopspring/decimal"
@@ -88,6 +89,7 @@ func (s *Storage) processBlock(ctx context.Context, blockNum uint64, blockRaw *d
 	if err != nil {
 		return err
 	}
+	receipts := blockReceipts(ctx, blockNum, blockRaw)
 	var wg sync.WaitGroup
 
 	cp, _ := ants.NewPoolWithFunc(5, func(i interface{}) {
@@ -95,7 +97,7 @@ func (s *Storage) processBlock(ctx context.Context, blockNum uint64, blockRaw *d
 		// override contract address
 		defer wg.Done()
 
-		if e := s.CreateTransactionByExecuted(ctx, block.Timestamp, &transaction, hash2ExtrinsicIndex[transaction.Hash]); e != nil {
+		if e := s.CreateTransactionByExecuted(ctx, block.Timestamp, &transaction, hash2ExtrinsicIndex[transaction.Hash], receipts[transaction.Hash]); e != nil {
 			err = e
 		}
 	})
@@ -111,6 +113,44 @@ func (s *Storage) processBlock(ctx context.Context, blockNum uint64, blockRaw *d
 	return s.AddOrUpdateItem(ctx, block, []string{"block_num"}, "transaction_count").Error
 }
 
+// blockReceiptsUnsupported node lacks eth_getBlockReceipts
+var blockReceiptsUnsupported atomic.Bool
+
+// blockReceipts receipts of block transactions by hash, by eth_getBlockReceipts or a batch of eth_getTransactionReceipt
+// receipts missing are fetched by transaction
+func blockReceipts(ctx context.Context, blockNum uint64, blockRaw *dto.Block) map[string]*dto.TransactionReceipt {
+	if len(blockRaw.Transactions) == 0 {
+		return nil
+	}
+	var (
+		list []*dto.TransactionReceipt
+		err  error
+	)
+	if !blockReceiptsUnsupported.Load() {
+		list, err = web3.RPC.Eth.GetBlockReceipts(ctx, new(big.Int).SetUint64(blockNum))
+		if dto.IsMethodNotFound(err) {
+			blockReceiptsUnsupported.Store(true)
+		}
+	}
+	if blockReceiptsUnsupported.Load() || err != nil {
+		hashes := make([]string, len(blockRaw.Transactions))
+		for i, t := range blockRaw.Transactions {
+			hashes[i] = t.Hash
+		}
+		if list, err = web3.RPC.Eth.GetTransactionReceipts(ctx, hashes); err != nil {
+			util.Logger().Warning(fmt.Sprintf("block %d batch receipts error %v", blockNum, err))
+			return nil
+		}
+	}
+	receipts := make(map[string]*dto.TransactionReceipt, len(list))
+	for _, receipt := range list {
+		if receipt != nil {
+			receipts[receipt.TransactionHash] = receipt
+		}
+	}
+	return receipts
+}
+
 func GetBlockByNum(ctx context.Context, blockNum int) *EvmBlock {
 	var block EvmBlock
 	txn := sg.db.WithContext(ctx)
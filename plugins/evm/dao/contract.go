@@ -53,6 +53,7 @@ type Contract struct {
 
 	EipStandard          string `json:"eip_standard" gorm:"size:100"`
 	ProxyImplementation  string `json:"proxy_implementation" gorm:"size:64"`
+	ProxyDetected        bool   `json:"-"`
 	ConstructorArguments string `json:"constructor_arguments" gorm:"type:string"`
 	DeployCodeHash       string `json:"deploy_code_hash" gorm:"size:70;index:deploy_code_hash;default:'';not null"`
 }
@@ -142,24 +143,33 @@ func (c *Contract) VerifySuccess(ctx context.Context, verifyRes *evmContract.Ver
 func (c *Contract) afterVerify(ctx context.Context) {
 	_ = c.fetchAbiMapping(context.Background())
 	// check it is proxy contract
-	if c.hasEvent(ctx, delegateProxy.EventUpgraded) {
-		var proxy delegateProxy.IDelegateProxy
-		if c.hasStorage(ctx, "implementation") {
-			proxy = delegateProxy.Init897(web3.RPC, c.Address)
-		} else {
-			proxy = delegateProxy.Init1967(web3.RPC, c.Address)
-		}
-		if implementation, _ := proxy.Implementation(ctx); implementation != "" {
+	var proxy delegateProxy.IDelegateProxy
+	if c.hasEvent(ctx, delegateProxy.EventUpgraded) && c.hasStorage(ctx, "implementation") {
+		proxy = delegateProxy.Init897(web3.RPC, c.Address)
+		if implementation, _ := proxy.Implementation(ctx); !delegateProxy.IsZeroAddress(implementation) {
 			c.ProxyImplementation = implementation
 			c.EipStandard = proxy.Standard()
+			return
 		}
 	}
+	c.ProxyDetected = true
+	if proxy, implementation := delegateProxy.Detect(ctx, web3.RPC, c.Address); proxy != nil {
+		c.ProxyImplementation = implementation
+		c.EipStandard = proxy.Standard()
+	}
 }
 
-func setContractProxyImplementation(ctx context.Context, contractAddress, implementation string) {
-	if contract := GetContract(ctx, contractAddress); contract != nil && contract.ProxyImplementation != "" {
-		sg.db.Model(Contract{}).Where("address = ?", contractAddress).Update("proxy_implementation", implementation)
+// setContractProxyImplementation implementation of proxy after upgrade, standard is kept if already detected
+func setContractProxyImplementation(ctx context.Context, contractAddress, implementation, standard string) {
+	contract := GetContract(ctx, contractAddress)
+	if contract == nil {
+		return
+	}
+	updates := map[string]interface{}{"proxy_implementation": implementation}
+	if contract.EipStandard == "" {
+		updates["eip_standard"] = standard
 	}
+	sg.db.Model(Contract{}).Where("address = ?", contractAddress).Updates(updates)
 }
 
 func (c *Contract) hasEvent(_ context.Context, eventId string) bool {
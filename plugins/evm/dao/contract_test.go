@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"github.com/itering/subscan/plugins/evm/feature/delegateProxy"
 	"github.com/itering/subscan/util/address"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -20,9 +21,10 @@ func Test_Contract(t *testing.T) {
 	})
 
 	t.Run("setContractProxyImplementation will set contract proxy implementation", func(t *testing.T) {
-		setContractProxyImplementation(ctx, contractAddress, "0x1234567890abcdef1234567890abcdef12345678")
+		setContractProxyImplementation(ctx, contractAddress, "0x1234567890abcdef1234567890abcdef12345678", delegateProxy.EIP1967Standard)
 		afterSetContractProxyImplementation := GetContract(ctx, contractAddress)
 		assert.Equal(t, "0x1234567890abcdef1234567890abcdef12345678", afterSetContractProxyImplementation.ProxyImplementation)
+		assert.Equal(t, delegateProxy.EIP1967Standard, afterSetContractProxyImplementation.EipStandard)
 	})
 
 }
@@ -14,8 +14,6 @@ import (
 	"github.com/itering/subscan/util/ipfs"
 	"github.com/shopspring/decimal"
 	"strings"
-
-	"gorm.io/gorm"
 )
 
 type Erc721Holders struct {
@@ -93,19 +91,17 @@ func (c *Token) RefreshErc721Holders(ctx context.Context, tokenId string) error
 	if to == "" {
 		if collectible != nil {
 			if q := db.Where("contract = ?", c.Contract).Where("token_id =?", tokenId).Delete(Erc721Holders{}); q.RowsAffected > 0 {
-				db.Model(Token{}).Where("contract =?", c.Contract).UpdateColumns(map[string]interface{}{"total_supply": gorm.Expr("total_supply - ?", 1)})
+				refreshErc721TotalSupply(ctx, c.Contract)
 			}
 		}
 		return nil
 	}
 	id := fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("%s%s%s", c.Contract, to, tokenId))))
-	defer func() {
-		_ = c.refreshGetMetadata(context.Background(), c.Contract, tokenId)
-	}()
+	defer queueNftMetadata(context.Background(), c.Contract, tokenId)
 	if collectible == nil {
 		// refresh nft metadata
 		if q := db.Create(&Erc721Holders{Id: id, Contract: c.Contract, Holder: to, TokenId: tokenId}); q.RowsAffected > 0 {
-			db.Model(Token{}).Where("contract =?", c.Contract).UpdateColumns(map[string]interface{}{"total_supply": gorm.Expr("total_supply + ?", 1)})
+			refreshErc721TotalSupply(ctx, c.Contract)
 		}
 	} else {
 		db.Model(Erc721Holders{}).Where("contract = ? and token_id = ?", c.Contract, tokenId).UpdateColumns(Erc721Holders{
@@ -116,6 +112,12 @@ func (c *Token) RefreshErc721Holders(ctx context.Context, tokenId string) error
 	return nil
 }
 
+// refreshErc721TotalSupply total supply of erc721 is the count of held tokens
+func refreshErc721TotalSupply(ctx context.Context, contract string) {
+	sg.db.WithContext(ctx).Model(Token{}).Where("contract = ?", contract).
+		UpdateColumns(map[string]interface{}{"total_supply": sg.db.Model(Erc721Holders{}).Select("COUNT(*)").Where("contract = ?", contract)})
+}
+
 func GetCollectible(ctx context.Context, contract, tokenId string, cols string) *Erc721Holders {
 	var holder Erc721Holders
 	query := sg.db
@@ -189,78 +191,50 @@ func RefreshBaseTokenUri(ctx context.Context, contract string) (string, error) {
 	return uri, nil
 }
 
-func (c *Token) GetMetadata(ctx context.Context, contract, tokenId string) (*Metadata, string, error) {
-	var (
-		tokenUrl string
-		err      error
-	)
+// tokenUri metadata uri of token, base token uri is preferred
+func (c *Token) tokenUri(ctx context.Context, contract, tokenId string) (string, error) {
 	if c.BaseTokenUri != "" {
-		tokenUrl = fmt.Sprintf("%s%s", c.BaseTokenUri, tokenId)
-	} else {
-		// get token uri
-		if c.Category == Eip721Token {
-			ERC721 := erc721.Init(web3.RPC, contract)
-			tokenUrl, err = ERC721.TokenURI(ctx, tokenId)
-			if tokenUrl == "" {
-				return nil, "", err
-			}
-		} else if c.Category == Eip1155Token {
-			ERC1155 := erc1155.Init(web3.RPC, contract)
-			tokenUrl, err = ERC1155.Uri(ctx, tokenId)
-			if tokenUrl == "" {
-				return nil, "", err
-			}
-			if strings.Contains(tokenUrl, "{id}") {
-				tokenUrl = strings.ReplaceAll(tokenUrl, "{id}", tokenId)
-			}
-		} else {
-			return nil, "", fmt.Errorf("unsupported token category %s", c.Category)
-		}
+		return fmt.Sprintf("%s%s", c.BaseTokenUri, tokenId), nil
 	}
-
-	var (
-		metadata Metadata
-		data     []byte
-	)
-	if strings.HasPrefix("/", tokenUrl) {
-		tokenUrl = tokenUrl[1:]
-	}
-	// ipfs,ar,http,base64 metadata json
-	switch {
-	case strings.HasPrefix(tokenUrl, "ipfs://") || strings.HasPrefix(tokenUrl, "https://ipfs.io"):
-		data, err = ipfs.OpenFile(ctx, ipfs.TrimMetadataUri(tokenUrl))
-	case strings.HasPrefix(tokenUrl, "ar://"):
-		data, err = ipfs.OpenArFile(ctx, ipfs.TrimMetadataUri(tokenUrl))
-		// ignore localhost/127.0.0.1
-	case strings.HasPrefix(tokenUrl, "http://localhost") || strings.HasPrefix(tokenUrl, "https://localhost") || strings.Contains(tokenUrl, "127.0.0.1"):
-		return nil, "", nil
-	case strings.HasPrefix(tokenUrl, "http") || strings.HasPrefix(tokenUrl, "https"):
-		data, err = util.HttpGet(ctx, tokenUrl)
-	case strings.HasPrefix(tokenUrl, "data:application/json;base64,"):
-		raw := util.Base64Decode(strings.ReplaceAll(tokenUrl, "data:application/json;base64,", ""))
-		data = []byte(raw)
-	case strings.HasPrefix(tokenUrl, "data:application/json;utf8,"):
-		data = []byte(strings.ReplaceAll(tokenUrl, "data:application/json;utf8,", ""))
-	case strings.HasPrefix(tokenUrl, "did:dkg"):
-		return nil, "", nil
-	default:
-		return nil, "", fmt.Errorf("unsupported token uri %s %s %s", tokenUrl, contract, tokenId)
+	switch c.Category {
+	case Eip721Token:
+		return erc721.Init(web3.RPC, contract).TokenURI(ctx, tokenId)
+	case Eip1155Token:
+		tokenUrl, err := erc1155.Init(web3.RPC, contract).Uri(ctx, tokenId)
+		return strings.ReplaceAll(tokenUrl, "{id}", tokenId), err
 	}
+	return "", fmt.Errorf("unsupported token category %s", c.Category)
+}
 
-	if err != nil {
+func (c *Token) GetMetadata(ctx context.Context, contract, tokenId string) (*Metadata, string, error) {
+	tokenUrl, err := c.tokenUri(ctx, contract, tokenId)
+	if tokenUrl == "" {
+		return nil, "", err
+	}
+	data, err := fetchTokenUri(ctx, tokenUrl)
+	if data == nil {
 		return nil, "", err
 	}
+	var metadata Metadata
 	if util.UnmarshalAny(&metadata, data) == nil {
 		return &metadata, metadata.Image, nil
 	}
-	return nil, "", err
+	return nil, "", nil
 }
 
-func (c *Token) refreshGetMetadata(ctx context.Context, contract string, tokenId string) error {
-	metadata, storageUri, err := c.GetMetadata(ctx, contract, tokenId)
-	if metadata != nil {
-		sg.db.Model(Erc721Holders{}).Where("contract = ?", c.Contract).Where("token_id =?", tokenId).
-			UpdateColumns(map[string]interface{}{"metadata": metadata, "storage_url": storageUri})
+// fetchTokenUri content of ipfs,ar,http,data token uri, nil if uri is not resolvable, such as localhost or did:dkg
+func fetchTokenUri(ctx context.Context, tokenUrl string) ([]byte, error) {
+	tokenUrl = strings.TrimPrefix(tokenUrl, "/")
+	if strings.HasPrefix(tokenUrl, "did:dkg") {
+		return nil, nil
 	}
-	return err
+	content, err := ipfs.Fetch(ctx, tokenUrl)
+	if errors.Is(err, ipfs.ErrUnsupportedUri) && strings.HasPrefix(tokenUrl, "http") {
+		// ignore localhost/127.0.0.1
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return content.Data, nil
 }
@@ -47,10 +47,8 @@ func (t TransactionReceipt) EventProcess(ctx context.Context) error {
 		_ = Publish("erc20", "balance", []string{t.Address, address})
 
 	// proxy
-	case delegateProxy.EventUpgraded:
-		if topics := strings.Split(t.Topics, ","); len(topics) > 1 {
-			setContractProxyImplementation(ctx, t.Address, util.AddHex(abi.DecodeAddress(topics[1])))
-		}
+	case delegateProxy.EventUpgraded, delegateProxy.EventAdminChanged, delegateProxy.EventBeaconUpgraded:
+		proxyEventProcess(ctx, &t)
 
 		// case erc1155.EventTransferBatch, erc1155.EventTransferSingle, erc1155.EventURI:
 		// 	if token := GetTokenByContract(ctx, t.Address); token == nil {
@@ -0,0 +1,241 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/itering/subscan/pkg/go-web3/complex/types"
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	evmContract "github.com/itering/subscan/plugins/evm/contract"
+	"github.com/itering/subscan/plugins/evm/feature/delegateProxy"
+	"github.com/itering/subscan/share/web3"
+	"github.com/itering/subscan/util"
+)
+
+var ErrContractNotVerified = errors.New("contract not verified")
+
+type ContractAbiFunctions struct {
+	Address      string                    `json:"address"`
+	ContractName string                    `json:"contract_name"`
+	Read         []evmContract.AbiFunction `json:"read"`
+	Write        []evmContract.AbiFunction `json:"write"`
+}
+
+// ContractFunctionsJson functions of verified contract, with functions of implementation if contract is a proxy
+type ContractFunctionsJson struct {
+	ContractAbiFunctions
+	EipStandard    string                `json:"eip_standard,omitempty"`
+	Implementation *ContractAbiFunctions `json:"implementation,omitempty"`
+}
+
+// ContractInteraction call of contract function, Implementation uses abi of proxy implementation
+type ContractInteraction struct {
+	Address        string
+	Method         string
+	Args           []json.RawMessage
+	From           string
+	Value          string
+	BlockNum       *uint64
+	Implementation bool
+}
+
+// ContractUnsignedTransaction unsigned transaction of write function, ready to be signed by wallet
+type ContractUnsignedTransaction struct {
+	From     string `json:"from,omitempty"`
+	To       string `json:"to"`
+	Data     string `json:"data"`
+	Value    string `json:"value"`
+	ChainId  string `json:"chainId,omitempty"`
+	Nonce    string `json:"nonce,omitempty"`
+	Gas      string `json:"gas,omitempty"`
+	GasPrice string `json:"gasPrice,omitempty"`
+}
+
+func (c *Contract) verified() bool {
+	return c.VerifyStatus != "" && len(c.Abi) > 0 && c.Abi.String() != "null"
+}
+
+// implementation current implementation of proxy contract, read through delegateProxy of standard
+func (c *Contract) implementation(ctx context.Context) string {
+	proxy := delegateProxy.Init(web3.RPC, c.EipStandard, c.Address)
+	if proxy == nil {
+		return c.ProxyImplementation
+	}
+	if implementation, _ := proxy.Implementation(ctx); !delegateProxy.IsZeroAddress(implementation) {
+		return implementation
+	}
+	return c.ProxyImplementation
+}
+
+// interactionAbi abi of contract, or abi of verified implementation
+func (c *Contract) interactionAbi(ctx context.Context, implementation bool) ([]byte, error) {
+	if !implementation {
+		return c.Abi, nil
+	}
+	address := c.implementation(ctx)
+	if address == "" {
+		return nil, errors.New("contract is not a proxy")
+	}
+	impl := GetContract(ctx, address)
+	if impl == nil || !impl.verified() {
+		return nil, fmt.Errorf("implementation %s %w", address, ErrContractNotVerified)
+	}
+	return impl.Abi, nil
+}
+
+func contractAbiFunctions(c *Contract) (*ContractAbiFunctions, error) {
+	read, write, err := evmContract.AbiFunctions(c.Abi)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractAbiFunctions{Address: c.Address, ContractName: c.ContractName, Read: read, Write: write}, nil
+}
+
+// ContractFunctions read and write functions of verified contract
+func (a *ApiSrv) ContractFunctions(ctx context.Context, address string) (*ContractFunctionsJson, error) {
+	c := GetContract(ctx, address)
+	if c == nil || !c.verified() {
+		return nil, ErrContractNotVerified
+	}
+	functions, err := contractAbiFunctions(c)
+	if err != nil {
+		return nil, err
+	}
+	res := &ContractFunctionsJson{ContractAbiFunctions: *functions, EipStandard: c.EipStandard}
+	if implementation := c.implementation(ctx); implementation != "" {
+		res.Implementation = &ContractAbiFunctions{Address: implementation}
+		if impl := GetContract(ctx, implementation); impl != nil && impl.verified() {
+			if res.Implementation, err = contractAbiFunctions(impl); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func (p *ContractInteraction) method(ctx context.Context) (*abi.Method, []byte, error) {
+	c := GetContract(ctx, p.Address)
+	if c == nil || !c.verified() {
+		return nil, nil, ErrContractNotVerified
+	}
+	abiRaw, err := c.interactionAbi(ctx, p.Implementation)
+	if err != nil {
+		return nil, nil, err
+	}
+	method, err := evmContract.FindAbiMethod(abiRaw, p.Method)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := evmContract.PackArguments(method, p.Args)
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, data, nil
+}
+
+func (p *ContractInteraction) value() (*big.Int, error) {
+	if p.Value == "" {
+		return nil, nil
+	}
+	value, ok := new(big.Int).SetString(p.Value, 0)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %s", p.Value)
+	}
+	return value, nil
+}
+
+// ContractCall eth_call of contract function at block, default the latest block, outputs are decoded by abi
+func (a *ApiSrv) ContractCall(ctx context.Context, p *ContractInteraction) ([]evmContract.AbiValue, error) {
+	method, data, err := p.method(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	blockParameter := "latest"
+	if p.BlockNum != nil {
+		blockParameter = util.IntToHexNumber(*p.BlockNum)
+	}
+	result, err := web3.RPC.Eth.CallAt(ctx, &dto.TransactionParameters{
+		From:  p.From,
+		To:    p.Address,
+		Value: value,
+		Data:  types.ComplexString(util.AddHex(util.BytesToHex(data))),
+	}, blockParameter)
+	if err != nil {
+		return nil, revertError(err)
+	}
+	return evmContract.UnpackOutputs(method, util.HexToBytes(result))
+}
+
+// revertError error with revert reason of Error(string) if node returns revert data
+func revertError(err error) error {
+	var rpcErr *dto.Error
+	if !errors.As(err, &rpcErr) {
+		return err
+	}
+	if data, ok := rpcErr.Data.(string); ok {
+		if reason, unpackErr := abi.UnpackRevert(util.HexToBytes(data)); unpackErr == nil {
+			return fmt.Errorf("execution reverted: %s", reason)
+		}
+	}
+	return err
+}
+
+// ContractWriteTransaction unsigned transaction of write function, nonce and gas are filled if from is set
+func (a *ApiSrv) ContractWriteTransaction(ctx context.Context, p *ContractInteraction) (*ContractUnsignedTransaction, error) {
+	method, data, err := p.method(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if evmContract.IsReadFunction(method) {
+		return nil, fmt.Errorf("method %s is a read function", method.Sig)
+	}
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if value != nil && value.Sign() > 0 && !method.IsPayable() {
+		return nil, fmt.Errorf("method %s is not payable", method.Sig)
+	}
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	txn := &ContractUnsignedTransaction{
+		From:  p.From,
+		To:    p.Address,
+		Data:  util.AddHex(util.BytesToHex(data)),
+		Value: util.AddHex(value.Text(16)),
+	}
+	if web3.CHAIN_ID > 0 {
+		txn.ChainId = util.IntToHexNumber(uint64(web3.CHAIN_ID))
+	}
+	if gasPrice, err := web3.RPC.Eth.GetGasPrice(ctx); err == nil {
+		txn.GasPrice = util.AddHex(gasPrice.Text(16))
+	}
+	if p.From == "" {
+		return txn, nil
+	}
+	nonce, err := web3.RPC.Eth.GetTransactionCount(ctx, p.From, "pending")
+	if err != nil {
+		return nil, err
+	}
+	txn.Nonce = util.AddHex(nonce.Text(16))
+	gas, err := web3.RPC.Eth.EstimateGas(ctx, &dto.TransactionParameters{
+		From:  p.From,
+		To:    p.Address,
+		Value: value,
+		Data:  types.ComplexString(txn.Data),
+	})
+	if err != nil {
+		return nil, revertError(err)
+	}
+	txn.Gas = util.AddHex(gas.Text(16))
+	return txn, nil
+}
@@ -0,0 +1,297 @@
+package dao
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/content"
+	"github.com/itering/subscan/util/ipfs"
+	"github.com/itering/subscan/util/mq"
+)
+
+const (
+	NftMetadataPending     = "pending"
+	NftMetadataSuccess     = "success"
+	NftMetadataFailed      = "failed"
+	NftMetadataUnsupported = "unsupported"
+)
+
+const (
+	nftMetadataMaxAttempts = 8
+	nftMetadataRetryBase   = time.Minute
+	nftMetadataRetryMax    = 24 * time.Hour
+	nftMetadataRetryBatch  = 100
+	// nftMetadataRefreshInterval min interval between fetches requested by refresh api
+	nftMetadataRefreshInterval = 10 * time.Minute
+)
+
+var (
+	ErrTokenNotFound = errors.New("token not found")
+	// ErrNftMetadataRefreshLimited refresh in retry backoff or soon after last fetch
+	ErrNftMetadataRefreshLimited = errors.New("metadata fetched recently, retry later")
+
+	// NftContentStore local store of nft metadata, image and thumbnail, capped by NFT_CONTENT_MAX_MB,
+	// evicted content is saved again by metadata refresh
+	NftContentStore = content.NewStore(util.GetEnv("NFT_CONTENT_DIR", "data/nft"), int64(util.StringToInt(util.GetEnv("NFT_CONTENT_MAX_MB", "10240")))<<20)
+	// nftThumbnailSize max width and height of thumbnail
+	nftThumbnailSize = util.StringToInt(util.GetEnv("NFT_THUMBNAIL_SIZE", "256"))
+)
+
+// NftMetadata fetch state and normalized metadata of nft, hashes are keys of NftContentStore
+type NftMetadata struct {
+	Id          string   `json:"-" gorm:"primaryKey;size:100"`
+	Contract    string   `json:"contract" gorm:"size:100;index:contract_token_id"`
+	TokenId     string   `json:"token_id" gorm:"size:255;index:contract_token_id"`
+	TokenUri    string   `json:"token_uri" gorm:"type:text"`
+	Metadata    Metadata `json:"metadata" gorm:"type:json"`
+	ContentHash string   `json:"content_hash" gorm:"size:70"`
+	ImageHash   string   `json:"image_hash" gorm:"size:70"`
+	Thumbnail   string   `json:"thumbnail" gorm:"size:70"`
+	Status      string   `json:"status" gorm:"size:20;index:status_retry"`
+	Attempts    int      `json:"attempts"`
+	NextRetryAt int64    `json:"next_retry_at" gorm:"index:status_retry"`
+	LastError   string   `json:"last_error,omitempty" gorm:"type:text"`
+	UpdatedAt   int64    `json:"updated_at"`
+}
+
+func (n *NftMetadata) TableName() string {
+	return "evm_nft_metadata"
+}
+
+func nftMetadataId(contract, tokenId string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(contract+tokenId)))
+}
+
+func GetNftMetadata(ctx context.Context, contract, tokenId string) *NftMetadata {
+	var record NftMetadata
+	if q := sg.db.WithContext(ctx).Where("id = ?", nftMetadataId(contract, tokenId)).First(&record); q.Error != nil {
+		return nil
+	}
+	return &record
+}
+
+// nftMetadataBackoff delay before next retry, doubled each attempt
+func nftMetadataBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := nftMetadataRetryBase << (attempts - 1)
+	if delay <= 0 || delay > nftMetadataRetryMax {
+		return nftMetadataRetryMax
+	}
+	return delay
+}
+
+// normalizeNftMetadata metadata of json document, common non-standard fields are mapped to erc721 metadata
+func normalizeNftMetadata(data []byte) (*Metadata, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid metadata json %w", err)
+	}
+	str := func(keys ...string) string {
+		for _, key := range keys {
+			if v, ok := raw[key].(string); ok && strings.TrimSpace(v) != "" {
+				return strings.TrimSpace(v)
+			}
+		}
+		return ""
+	}
+	metadata := Metadata{
+		Name:            str("name", "title"),
+		Description:     str("description"),
+		ExternalUrl:     str("external_url", "external_link"),
+		Image:           str("image", "image_url", "imageUrl"),
+		AnimationUrl:    str("animation_url", "animationUrl"),
+		YoutubeUrl:      str("youtube_url"),
+		BackgroundColor: strings.TrimPrefix(str("background_color"), "#"),
+	}
+	if svg := str("image_data"); metadata.Image == "" && svg != "" {
+		metadata.Image = "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(svg))
+	}
+	attributes := raw["attributes"]
+	if attributes == nil {
+		attributes = raw["traits"]
+	}
+	switch v := attributes.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if attribute, ok := item.(map[string]interface{}); ok {
+				metadata.Attributes = append(metadata.Attributes, attribute)
+			}
+		}
+	case map[string]interface{}:
+		// {"trait_type": "value"}
+		for traitType, value := range v {
+			metadata.Attributes = append(metadata.Attributes, map[string]interface{}{"trait_type": traitType, "value": value})
+		}
+	}
+	return &metadata, nil
+}
+
+// fetch metadata of token uri and image, thumbnail is generated if image is decodable
+func (n *NftMetadata) fetch(ctx context.Context, token *Token) (err error) {
+	if n.TokenUri, err = token.tokenUri(ctx, n.Contract, n.TokenId); err != nil {
+		return err
+	}
+	if n.TokenUri == "" {
+		n.Status = NftMetadataUnsupported
+		return nil
+	}
+	data, err := fetchTokenUri(ctx, n.TokenUri)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		n.Status = NftMetadataUnsupported
+		return nil
+	}
+	metadata, err := normalizeNftMetadata(data)
+	if err != nil {
+		return err
+	}
+	n.Metadata = *metadata
+	if n.ContentHash, err = NftContentStore.Put(data); err != nil {
+		return err
+	}
+	if metadata.Image != "" {
+		image, err := ipfs.Fetch(ctx, metadata.Image)
+		if err != nil {
+			return fmt.Errorf("fetch image %w", err)
+		}
+		if n.ImageHash, err = NftContentStore.Put(image.Data); err != nil {
+			return err
+		}
+		thumbnail, err := content.Thumbnail(image.Data, nftThumbnailSize)
+		switch {
+		case errors.Is(err, content.ErrUnsupportedImage), errors.Is(err, content.ErrImageTooLarge):
+			// video, other media or oversized image, no thumbnail
+		case err != nil:
+			return err
+		default:
+			if n.Thumbnail, err = NftContentStore.Put(thumbnail); err != nil {
+				return err
+			}
+		}
+	}
+	n.Status = NftMetadataSuccess
+	return nil
+}
+
+// FetchNftMetadata fetch and save metadata of nft, failure is recorded with next retry time,
+// returned error is only the error of saving
+func FetchNftMetadata(ctx context.Context, contract, tokenId string) (*NftMetadata, error) {
+	token := GetTokenByContract(ctx, contract)
+	if token == nil {
+		return nil, ErrTokenNotFound
+	}
+	record := GetNftMetadata(ctx, contract, tokenId)
+	if record == nil {
+		record = &NftMetadata{Id: nftMetadataId(contract, tokenId), Contract: contract, TokenId: tokenId}
+	}
+	if err := record.fetch(ctx, token); err != nil {
+		record.Attempts++
+		record.Status = NftMetadataFailed
+		record.LastError = err.Error()
+		record.NextRetryAt = time.Now().Add(nftMetadataBackoff(record.Attempts)).Unix()
+	} else {
+		record.Attempts = 0
+		record.LastError = ""
+		record.NextRetryAt = 0
+	}
+	if err := sg.AddOrUpdateItem(ctx, record, []string{"id"}).Error; err != nil {
+		return nil, err
+	}
+	if record.Status == NftMetadataSuccess {
+		sg.db.WithContext(ctx).Model(Erc721Holders{}).Where("contract = ?", contract).Where("token_id =?", tokenId).
+			UpdateColumns(map[string]interface{}{"metadata": record.Metadata, "storage_url": record.Metadata.Image})
+	}
+	return record, nil
+}
+
+// queueNftMetadata publish metadata fetch of new nft, fetched in place if mq is not enabled
+func queueNftMetadata(ctx context.Context, contract, tokenId string) {
+	if GetNftMetadata(ctx, contract, tokenId) != nil {
+		return
+	}
+	if mq.Instant == nil {
+		_, _ = FetchNftMetadata(ctx, contract, tokenId)
+		return
+	}
+	// pending record is retried if the published fetch is lost
+	_ = sg.AddOrUpdateItem(ctx, &NftMetadata{
+		Id:          nftMetadataId(contract, tokenId),
+		Contract:    contract,
+		TokenId:     tokenId,
+		Status:      NftMetadataPending,
+		NextRetryAt: time.Now().Add(nftMetadataRetryBase * 10).Unix(),
+	}, []string{"id"}).Error
+	_ = Publish(Eip721Token, "metadata", []string{contract, tokenId})
+}
+
+// RetryNftMetadata publish failed or lost pending metadata fetches whose backoff has elapsed
+func RetryNftMetadata(ctx context.Context) {
+	if sg == nil || sg.db == nil {
+		return
+	}
+	var list []NftMetadata
+	now := time.Now()
+	sg.db.WithContext(ctx).Select("id,contract,token_id").
+		Where("status in ?", []string{NftMetadataFailed, NftMetadataPending}).
+		Where("next_retry_at <= ?", now.Unix()).
+		Where("attempts < ?", nftMetadataMaxAttempts).
+		Order("next_retry_at asc").Limit(nftMetadataRetryBatch).Find(&list)
+	for _, record := range list {
+		// hold off next retry until the published fetch is done
+		sg.db.WithContext(ctx).Model(NftMetadata{}).Where("id = ?", record.Id).Update("next_retry_at", now.Add(nftMetadataRetryBase*10).Unix())
+		if mq.Instant == nil {
+			_, _ = FetchNftMetadata(ctx, record.Contract, record.TokenId)
+			continue
+		}
+		_ = Publish(Eip721Token, "metadata", []string{record.Contract, record.TokenId})
+	}
+}
+
+// NftMetadataRefresh fetch metadata of nft again, refresh is rejected in retry backoff or within nftMetadataRefreshInterval of last fetch,
+// attempts are reset, fetch is queued to worker if mq is enabled
+func (a *ApiSrv) NftMetadataRefresh(ctx context.Context, contract, tokenId string) (*NftMetadata, error) {
+	record := GetNftMetadata(ctx, contract, tokenId)
+	if record == nil {
+		if GetTokenByContract(ctx, contract) == nil {
+			return nil, ErrTokenNotFound
+		}
+		queueNftMetadata(ctx, contract, tokenId)
+		return GetNftMetadata(ctx, contract, tokenId), nil
+	}
+	now := time.Now()
+	if nftMetadataRefreshLimited(record, now) {
+		return record, ErrNftMetadataRefreshLimited
+	}
+	// hold off retry and other refresh until the fetch is done
+	record.Attempts, record.NextRetryAt = 0, now.Add(nftMetadataRefreshInterval).Unix()
+	sg.db.WithContext(ctx).Model(NftMetadata{}).Where("id = ?", record.Id).
+		UpdateColumns(map[string]interface{}{"attempts": record.Attempts, "next_retry_at": record.NextRetryAt})
+	if mq.Instant == nil {
+		return FetchNftMetadata(ctx, contract, tokenId)
+	}
+	return record, Publish(Eip721Token, "metadata", []string{contract, tokenId})
+}
+
+func nftMetadataRefreshLimited(record *NftMetadata, now time.Time) bool {
+	return record.NextRetryAt > now.Unix() || now.Sub(time.Unix(record.UpdatedAt, 0)) < nftMetadataRefreshInterval
+}
+
+func (a *ApiSrv) NftMetadata(ctx context.Context, contract, tokenId string) *NftMetadata {
+	return GetNftMetadata(ctx, contract, tokenId)
+}
+
+// NftContent content of hash in NftContentStore with media type
+func (a *ApiSrv) NftContent(_ context.Context, hash string) ([]byte, string, error) {
+	return NftContentStore.Get(hash)
+}
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_normalizeNftMetadata(t *testing.T) {
+	metadata, err := normalizeNftMetadata([]byte(`{"title":" nft ","image_url":"ipfs://cid/1.png","background_color":"#ffffff","traits":[{"trait_type":"eyes","value":"blue"}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "nft", metadata.Name)
+	assert.Equal(t, "ipfs://cid/1.png", metadata.Image)
+	assert.Equal(t, "ffffff", metadata.BackgroundColor)
+	assert.Equal(t, []map[string]interface{}{{"trait_type": "eyes", "value": "blue"}}, metadata.Attributes)
+
+	metadata, err = normalizeNftMetadata([]byte(`{"name":"svg","image_data":"<svg></svg>","attributes":{"level":1}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "data:image/svg+xml;base64,PHN2Zz48L3N2Zz4=", metadata.Image)
+	assert.Equal(t, []map[string]interface{}{{"trait_type": "level", "value": float64(1)}}, metadata.Attributes)
+
+	_, err = normalizeNftMetadata([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func Test_nftMetadataBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, nftMetadataBackoff(0))
+	assert.Equal(t, time.Minute, nftMetadataBackoff(1))
+	assert.Equal(t, 4*time.Minute, nftMetadataBackoff(3))
+	assert.Equal(t, 24*time.Hour, nftMetadataBackoff(20))
+	assert.Equal(t, 24*time.Hour, nftMetadataBackoff(100))
+}
+
+func Test_nftMetadataRefreshLimited(t *testing.T) {
+	now := time.Now()
+	assert.True(t, nftMetadataRefreshLimited(&NftMetadata{NextRetryAt: now.Add(time.Minute).Unix()}, now))
+	assert.True(t, nftMetadataRefreshLimited(&NftMetadata{UpdatedAt: now.Add(-time.Minute).Unix()}, now))
+	assert.False(t, nftMetadataRefreshLimited(&NftMetadata{UpdatedAt: now.Add(-time.Hour).Unix(), NextRetryAt: now.Add(-time.Minute).Unix()}, now))
+}
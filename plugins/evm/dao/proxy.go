@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"context"
+	"strings"
+
+	"github.com/itering/subscan/plugins/evm/abi"
+	"github.com/itering/subscan/plugins/evm/feature/delegateProxy"
+	"github.com/itering/subscan/share/web3"
+	"github.com/itering/subscan/util"
+)
+
+const (
+	ProxyEventUpgraded       = "Upgraded"
+	ProxyEventAdminChanged   = "AdminChanged"
+	ProxyEventBeaconUpgraded = "BeaconUpgraded"
+)
+
+// ProxyUpgrade upgrade event of proxy, id is the id of receipt
+type ProxyUpgrade struct {
+	Id              uint64 `json:"id" gorm:"primaryKey;autoIncrement:false"`
+	Address         string `json:"address" gorm:"size:70;index:address"`
+	Event           string `json:"event" gorm:"size:32"`
+	Implementation  string `json:"implementation" gorm:"size:70;index:implementation"`
+	Beacon          string `json:"beacon,omitempty" gorm:"size:70"`
+	PreviousAdmin   string `json:"previous_admin,omitempty" gorm:"size:70"`
+	NewAdmin        string `json:"new_admin,omitempty" gorm:"size:70"`
+	BlockNum        uint64 `json:"block_num"`
+	BlockTimestamp  uint   `json:"block_timestamp" gorm:"size:32"`
+	TransactionHash string `json:"transaction_hash" gorm:"size:70"`
+}
+
+func (p *ProxyUpgrade) TableName() string {
+	return "evm_proxy_upgrades"
+}
+
+type ProxyUpgradeJson struct {
+	ProxyUpgrade
+	ImplementationName         string `json:"implementation_name,omitempty"`
+	ImplementationVerifyStatus string `json:"implementation_verify_status"`
+}
+
+// ProxyTimelineJson current implementation of proxy and its upgrade history, latest first
+type ProxyTimelineJson struct {
+	Address        string             `json:"address"`
+	EipStandard    string             `json:"eip_standard"`
+	Implementation string             `json:"implementation"`
+	Timeline       []ProxyUpgradeJson `json:"timeline"`
+}
+
+// proxyUpgradeOfReceipt upgrade of Upgraded, AdminChanged or BeaconUpgraded event, nil if receipt is not a proxy event
+func proxyUpgradeOfReceipt(t *TransactionReceipt) *ProxyUpgrade {
+	upgrade := &ProxyUpgrade{
+		Id:              t.Id,
+		Address:         t.Address,
+		BlockNum:        t.BlockNum,
+		BlockTimestamp:  t.BlockTimestamp,
+		TransactionHash: t.TransactionHash,
+	}
+	topics := strings.Split(t.Topics, ",")
+	switch util.TrimHex(t.MethodHash) {
+	case delegateProxy.EventUpgraded:
+		if len(topics) < 2 {
+			return nil
+		}
+		upgrade.Event = ProxyEventUpgraded
+		upgrade.Implementation = util.AddHex(abi.DecodeAddress(topics[1]))
+	case delegateProxy.EventBeaconUpgraded:
+		if len(topics) < 2 {
+			return nil
+		}
+		upgrade.Event = ProxyEventBeaconUpgraded
+		upgrade.Beacon = util.AddHex(abi.DecodeAddress(topics[1]))
+	case delegateProxy.EventAdminChanged:
+		// previousAdmin and newAdmin are not indexed
+		data := util.TrimHex(t.Data)
+		if len(data) < 128 {
+			return nil
+		}
+		upgrade.Event = ProxyEventAdminChanged
+		upgrade.PreviousAdmin = util.AddHex(data[24:64])
+		upgrade.NewAdmin = util.AddHex(data[88:128])
+	default:
+		return nil
+	}
+	return upgrade
+}
+
+// proxyEventProcess record upgrade history of proxy, and refresh implementation of contract
+func proxyEventProcess(ctx context.Context, t *TransactionReceipt) {
+	upgrade := proxyUpgradeOfReceipt(t)
+	if upgrade == nil {
+		return
+	}
+	if upgrade.Event == ProxyEventBeaconUpgraded {
+		beacon := delegateProxy.InitBeacon(web3.RPC, upgrade.Address)
+		// implementation of beacon when upgraded, beacon may be upgraded again later
+		upgrade.Implementation, _ = delegateProxy.BeaconImplementation(ctx, &beacon.Contract, upgrade.Beacon, util.IntToHexNumber(t.BlockNum))
+	}
+	_ = sg.AddOrUpdateItem(ctx, upgrade, []string{"id"}).Error
+	if upgrade.Implementation == "" {
+		return
+	}
+	standard := delegateProxy.EIP1967Standard
+	if upgrade.Event == ProxyEventBeaconUpgraded {
+		standard = delegateProxy.BeaconStandard
+	}
+	setContractProxyImplementation(ctx, upgrade.Address, upgrade.Implementation, standard)
+}
+
+// ProxyTimeline upgrade history of proxy with verify status of each implementation
+func (a *ApiSrv) ProxyTimeline(ctx context.Context, address string) *ProxyTimelineJson {
+	c := GetContract(ctx, address)
+	if c == nil {
+		return nil
+	}
+	res := &ProxyTimelineJson{Address: c.Address, EipStandard: c.EipStandard, Implementation: c.ProxyImplementation, Timeline: []ProxyUpgradeJson{}}
+	var upgrades []ProxyUpgrade
+	sg.db.WithContext(ctx).Where("address = ?", address).Order("id desc").Find(&upgrades)
+	if len(upgrades) == 0 && c.EipStandard == "" && !c.ProxyDetected {
+		// proxy without upgrade event, such as gnosis safe proxy, result is saved so contract is detected once
+		updates := map[string]interface{}{"proxy_detected": true}
+		if proxy, implementation := delegateProxy.Detect(ctx, web3.RPC, c.Address); proxy != nil {
+			res.EipStandard, res.Implementation = proxy.Standard(), implementation
+			updates["eip_standard"], updates["proxy_implementation"] = res.EipStandard, res.Implementation
+		}
+		sg.db.WithContext(ctx).Model(Contract{}).Where("address = ?", c.Address).Updates(updates)
+	}
+	var implementations []string
+	for _, upgrade := range upgrades {
+		if upgrade.Implementation != "" {
+			implementations = append(implementations, upgrade.Implementation)
+		}
+	}
+	verified := make(map[string]Contract)
+	if len(implementations) > 0 {
+		var contracts []Contract
+		sg.db.WithContext(ctx).Select("address,contract_name,verify_status").Where("address in ?", implementations).Find(&contracts)
+		for _, contract := range contracts {
+			verified[contract.Address] = contract
+		}
+	}
+	for _, upgrade := range upgrades {
+		item := ProxyUpgradeJson{ProxyUpgrade: upgrade}
+		if contract, ok := verified[upgrade.Implementation]; ok {
+			item.ImplementationName = contract.ContractName
+			item.ImplementationVerifyStatus = contract.VerifyStatus
+		}
+		res.Timeline = append(res.Timeline, item)
+	}
+	return res
+}
@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/itering/subscan/plugins/evm/feature/delegateProxy"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_proxyUpgradeOfReceipt(t *testing.T) {
+	receipt := TransactionReceipt{
+		Id:              1_000_000_000_001,
+		Address:         "0x25442adf37379be90ed1f7fccd9c9417b10aa4dc",
+		MethodHash:      "0x" + delegateProxy.EventUpgraded,
+		Topics:          "0x" + delegateProxy.EventUpgraded + ",0x00000000000000000000000040f1eca9c82200428704aa555da1009ad4beb2e2",
+		BlockNum:        10,
+		TransactionHash: "0x4a8c6bb3fb43e5e1f0bd4e7dfa3b1d4e3e9f2e5bb0f1b4a2e2c5d1c7a9e0f3b2",
+	}
+	upgrade := proxyUpgradeOfReceipt(&receipt)
+	assert.Equal(t, ProxyEventUpgraded, upgrade.Event)
+	assert.Equal(t, "0x40f1eca9c82200428704aa555da1009ad4beb2e2", upgrade.Implementation)
+	assert.Equal(t, uint64(10), upgrade.BlockNum)
+
+	receipt.MethodHash = "0x" + delegateProxy.EventBeaconUpgraded
+	upgrade = proxyUpgradeOfReceipt(&receipt)
+	assert.Equal(t, ProxyEventBeaconUpgraded, upgrade.Event)
+	assert.Equal(t, "0x40f1eca9c82200428704aa555da1009ad4beb2e2", upgrade.Beacon)
+	assert.Equal(t, "", upgrade.Implementation)
+
+	receipt.MethodHash = "0x" + delegateProxy.EventAdminChanged
+	receipt.Topics = "0x" + delegateProxy.EventAdminChanged
+	receipt.Data = "0000000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000001234567890abcdef1234567890abcdef12345678"
+	upgrade = proxyUpgradeOfReceipt(&receipt)
+	assert.Equal(t, ProxyEventAdminChanged, upgrade.Event)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000", upgrade.PreviousAdmin)
+	assert.Equal(t, "0x1234567890abcdef1234567890abcdef12345678", upgrade.NewAdmin)
+
+	receipt.Data = ""
+	assert.Nil(t, proxyUpgradeOfReceipt(&receipt))
+	receipt.MethodHash = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	assert.Nil(t, proxyUpgradeOfReceipt(&receipt))
+}
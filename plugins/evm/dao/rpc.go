@@ -0,0 +1,432 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/itering/subscan/share/web3"
+	"github.com/itering/subscan/util"
+	"github.com/shopspring/decimal"
+)
+
+// max logs of eth_getLogs
+const RpcLogsLimit = 10000
+
+var (
+	ErrRpcLogsLimit = errors.New("query returned more than 10000 results")
+	ErrRpcLogsRange = errors.New("block range limit exceeded")
+
+	// RpcLogsMaxRange max blocks between fromBlock and toBlock of eth_getLogs
+	RpcLogsMaxRange = uint64(util.StringToInt(util.GetEnv("EVM_RPC_LOGS_MAX_RANGE", "5000")))
+)
+
+// RpcBlock block of eth_getBlockByNumber/Hash, Transactions are hashes or RpcTransaction
+type RpcBlock struct {
+	Number           string        `json:"number"`
+	Hash             string        `json:"hash"`
+	ParentHash       string        `json:"parentHash"`
+	Nonce            string        `json:"nonce"`
+	MixHash          string        `json:"mixHash"`
+	Sha3Uncles       string        `json:"sha3Uncles"`
+	LogsBloom        string        `json:"logsBloom"`
+	TransactionsRoot string        `json:"transactionsRoot"`
+	StateRoot        string        `json:"stateRoot"`
+	ReceiptsRoot     string        `json:"receiptsRoot"`
+	Miner            string        `json:"miner"`
+	Author           string        `json:"author,omitempty"`
+	Difficulty       string        `json:"difficulty"`
+	TotalDifficulty  string        `json:"totalDifficulty"`
+	ExtraData        string        `json:"extraData"`
+	Size             string        `json:"size"`
+	GasLimit         string        `json:"gasLimit"`
+	GasUsed          string        `json:"gasUsed"`
+	Timestamp        string        `json:"timestamp"`
+	BaseFeePerGas    string        `json:"baseFeePerGas,omitempty"`
+	Uncles           []string      `json:"uncles"`
+	Transactions     []interface{} `json:"transactions"`
+}
+
+type RpcTransaction struct {
+	Hash                 string  `json:"hash"`
+	Nonce                string  `json:"nonce"`
+	BlockHash            string  `json:"blockHash"`
+	BlockNumber          string  `json:"blockNumber"`
+	TransactionIndex     string  `json:"transactionIndex"`
+	From                 string  `json:"from"`
+	To                   *string `json:"to"`
+	Value                string  `json:"value"`
+	Gas                  string  `json:"gas"`
+	GasPrice             string  `json:"gasPrice"`
+	MaxFeePerGas         string  `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string  `json:"maxPriorityFeePerGas,omitempty"`
+	Input                string  `json:"input"`
+	Type                 string  `json:"type"`
+	ChainId              string  `json:"chainId,omitempty"`
+	V                    string  `json:"v"`
+	R                    string  `json:"r"`
+	S                    string  `json:"s"`
+}
+
+type RpcReceipt struct {
+	TransactionHash   string   `json:"transactionHash"`
+	TransactionIndex  string   `json:"transactionIndex"`
+	BlockHash         string   `json:"blockHash"`
+	BlockNumber       string   `json:"blockNumber"`
+	From              string   `json:"from"`
+	To                *string  `json:"to"`
+	CumulativeGasUsed string   `json:"cumulativeGasUsed"`
+	GasUsed           string   `json:"gasUsed"`
+	EffectiveGasPrice string   `json:"effectiveGasPrice"`
+	ContractAddress   *string  `json:"contractAddress"`
+	Logs              []RpcLog `json:"logs"`
+	LogsBloom         string   `json:"logsBloom"`
+	Status            string   `json:"status"`
+	Type              string   `json:"type"`
+}
+
+type RpcLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+// LogFilter filter of logs shared by etherscan getLogs and eth_getLogs, Topics are OR values by position,
+// empty matches any, positions are matched with and unless TopicOprs of the pair is or
+type LogFilter struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Addresses []string
+	Topics    [][]string
+	TopicOprs map[[2]int]string
+}
+
+// topicColumns columns of receipt topics by position
+var topicColumns = []string{"method_hash", "topic1", "topic2", "topic3"}
+
+// Scopes conditions of filter, block range is queried by receipt id which is ordered by block num
+func (f *LogFilter) Scopes() []model.Option {
+	step := uint64(TransactionIdGenerateCoefficient * TxnReceiptLimit)
+	opts := []model.Option{model.Where("id >= ? AND id < ?", f.FromBlock*step, (f.ToBlock+1)*step)}
+	if len(f.Addresses) > 0 {
+		opts = append(opts, model.Where("address in ?", f.Addresses))
+	}
+	paired := make(map[int]bool)
+	for i := range topicColumns {
+		for j := i + 1; j < len(topicColumns); j++ {
+			if f.TopicOprs[[2]int{i, j}] != "or" || !f.hasTopic(i) || !f.hasTopic(j) {
+				continue
+			}
+			opts = append(opts, model.Where(fmt.Sprintf("%s in ? or %s in ?", topicColumns[i], topicColumns[j]), f.Topics[i], f.Topics[j]))
+			paired[i], paired[j] = true, true
+		}
+	}
+	for i := range topicColumns {
+		if f.hasTopic(i) && !paired[i] {
+			opts = append(opts, model.Where(topicColumns[i]+" in ?", f.Topics[i]))
+		}
+	}
+	return opts
+}
+
+func (f *LogFilter) hasTopic(i int) bool {
+	return i >= 0 && i < len(f.Topics) && i < len(topicColumns) && len(f.Topics[i]) > 0
+}
+
+func hexDecimal(d decimal.Decimal) string {
+	if d.IsNegative() {
+		return "0x0"
+	}
+	return util.AddHex(d.BigInt().Text(16))
+}
+
+// txIndexOfReceiptId transaction index in block of receipt id, see TransactionId and TxnReceiptLimit
+func txIndexOfReceiptId(id uint64) uint64 {
+	return id / TxnReceiptLimit % TransactionIdGenerateCoefficient
+}
+
+func (a *ApiSrv) RPC_LatestBlockNum(ctx context.Context) uint64 {
+	return uint64(latestBlockNum(ctx))
+}
+
+// RPC_GetBlock block by num or hash, nil if not indexed
+func (a *ApiSrv) RPC_GetBlock(ctx context.Context, blockNum *uint64, hash string, full bool) *RpcBlock {
+	var block *EvmBlock
+	if hash != "" {
+		block = GetBlockByHash(ctx, hash)
+	} else if blockNum != nil {
+		block = GetBlockByNum(ctx, int(*blockNum))
+	}
+	if block == nil {
+		return nil
+	}
+	var txns []Transaction
+	sg.db.WithContext(ctx).Where("block_num = ?", block.BlockNum).Order("transaction_index asc").Find(&txns)
+	r := &RpcBlock{
+		Number:           util.IntToHexNumber(block.BlockNum),
+		Hash:             block.BlockHash,
+		ParentHash:       block.ParentHash,
+		Nonce:            "0x0000000000000000",
+		MixHash:          "0x" + strings.Repeat("0", 64),
+		Sha3Uncles:       block.Sha3Uncles,
+		LogsBloom:        block.LogsBloom,
+		TransactionsRoot: block.TransactionsRoot,
+		StateRoot:        block.StateRoot,
+		ReceiptsRoot:     block.ReceiptsRoot,
+		Miner:            block.Miner,
+		Author:           block.Author,
+		Difficulty:       hexDecimal(block.Difficulty),
+		TotalDifficulty:  hexDecimal(block.TotalDifficulty),
+		ExtraData:        util.IfEmptyElse(block.ExtraData, "0x"),
+		Size:             hexDecimal(block.BlockSize),
+		GasLimit:         hexDecimal(block.GasLimit),
+		GasUsed:          hexDecimal(block.GasUsed),
+		Timestamp:        util.IntToHexNumber(uint64(block.Timestamp)),
+		Uncles:           []string{},
+		Transactions:     []interface{}{},
+	}
+	if block.BaseFeePerGas.IsPositive() {
+		r.BaseFeePerGas = hexDecimal(block.BaseFeePerGas)
+	}
+	for i := range txns {
+		if full {
+			r.Transactions = append(r.Transactions, rpcTransaction(&txns[i], block.BlockHash))
+		} else {
+			r.Transactions = append(r.Transactions, txns[i].Hash)
+		}
+	}
+	return r
+}
+
+// RPC_GetTransaction transaction by hash, nil if not indexed
+func (a *ApiSrv) RPC_GetTransaction(ctx context.Context, hash string) *RpcTransaction {
+	txn := GetTransactionByHash(ctx, hash)
+	if txn == nil {
+		return nil
+	}
+	var blockHash string
+	if block := GetBlockByNum(ctx, int(txn.BlockNum)); block != nil {
+		blockHash = block.BlockHash
+	}
+	return rpcTransaction(txn, blockHash)
+}
+
+func rpcTransaction(txn *Transaction, blockHash string) *RpcTransaction {
+	r := &RpcTransaction{
+		Hash:             txn.Hash,
+		Nonce:            util.IntToHexNumber(uint64(txn.Nonce)),
+		BlockHash:        blockHash,
+		BlockNumber:      util.IntToHexNumber(uint64(txn.BlockNum)),
+		TransactionIndex: util.IntToHexNumber(txn.TransactionIndex),
+		From:             txn.FromAddress,
+		Value:            hexDecimal(txn.Value),
+		Gas:              hexDecimal(txn.GasLimit),
+		GasPrice:         hexDecimal(txn.GasPrice),
+		Input:            util.AddHex(txn.InputData),
+		Type:             util.IntToHexNumber(uint64(txn.TxnType)),
+		V:                util.IntToHexNumber(uint64(txn.V)),
+		R:                txn.R,
+		S:                txn.S,
+	}
+	if txn.ToAddress != "" {
+		r.To = &txn.ToAddress
+	}
+	// eip 1559
+	if txn.TxnType == ethTypes.DynamicFeeTxType {
+		r.MaxFeePerGas = hexDecimal(txn.MaxFeePerGas)
+		r.MaxPriorityFeePerGas = hexDecimal(txn.MaxPriorityFeePerGas)
+	}
+	if txn.TxnType != ethTypes.LegacyTxType && web3.CHAIN_ID > 0 {
+		r.ChainId = util.IntToHexNumber(uint64(web3.CHAIN_ID))
+	}
+	return r
+}
+
+// RPC_GetTransactionReceipt receipt by transaction hash, nil if not indexed
+func (a *ApiSrv) RPC_GetTransactionReceipt(ctx context.Context, hash string) *RpcReceipt {
+	txn := GetTransactionByHash(ctx, hash)
+	if txn == nil {
+		return nil
+	}
+	var receipts []TransactionReceipt
+	sg.db.WithContext(ctx).Where("id BETWEEN ? AND ?", txn.TransactionId*TxnReceiptLimit, (txn.TransactionId+1)*TxnReceiptLimit-1).
+		Order("id asc").Find(&receipts)
+	logs := rpcLogs(ctx, receipts)
+
+	var bloom ethTypes.Bloom
+	for _, l := range logs {
+		bloom.Add(util.HexToBytes(l.Address))
+		for _, topic := range l.Topics {
+			bloom.Add(util.HexToBytes(topic))
+		}
+	}
+	r := &RpcReceipt{
+		TransactionHash:   txn.Hash,
+		TransactionIndex:  util.IntToHexNumber(txn.TransactionIndex),
+		BlockNumber:       util.IntToHexNumber(uint64(txn.BlockNum)),
+		From:              txn.FromAddress,
+		CumulativeGasUsed: hexDecimal(txn.CumulativeGasUsed),
+		GasUsed:           hexDecimal(txn.GasUsed),
+		EffectiveGasPrice: hexDecimal(txn.EffectiveGasPrice),
+		Logs:              logs,
+		LogsBloom:         util.AddHex(util.BytesToHex(bloom.Bytes())),
+		Status:            "0x0",
+		Type:              util.IntToHexNumber(uint64(txn.TxnType)),
+	}
+	if block := GetBlockByNum(ctx, int(txn.BlockNum)); block != nil {
+		r.BlockHash = block.BlockHash
+	}
+	if txn.ToAddress != "" {
+		r.To = &txn.ToAddress
+	} else if txn.Contract != "" {
+		r.ContractAddress = &txn.Contract
+	}
+	if txn.Success {
+		r.Status = "0x1"
+	}
+	return r
+}
+
+// RPC_GetLogs logs matching filter in block order, ErrRpcLogsLimit if more than RpcLogsLimit
+func (a *ApiSrv) RPC_GetLogs(ctx context.Context, filter *LogFilter) ([]RpcLog, error) {
+	var receipts []TransactionReceipt
+	if err := sg.db.WithContext(ctx).Scopes(filter.Scopes()...).Order("id asc").Limit(RpcLogsLimit + 1).Find(&receipts).Error; err != nil {
+		return nil, err
+	}
+	if len(receipts) > RpcLogsLimit {
+		return nil, ErrRpcLogsLimit
+	}
+	return rpcLogs(ctx, receipts), nil
+}
+
+// rpcLogs logs of receipts, logIndex is the position in block
+func rpcLogs(ctx context.Context, receipts []TransactionReceipt) []RpcLog {
+	logs := make([]RpcLog, 0, len(receipts))
+	if len(receipts) == 0 {
+		return logs
+	}
+	var blockNums []uint64
+	for _, receipt := range receipts {
+		if len(blockNums) == 0 || blockNums[len(blockNums)-1] != receipt.BlockNum {
+			blockNums = append(blockNums, receipt.BlockNum)
+		}
+	}
+	blocks := BlockNums2Blocks(ctx, blockNums)
+	// ids of block before the last receipt of result in block, logIndex is position of id in block
+	step := uint64(TransactionIdGenerateCoefficient * TxnReceiptLimit)
+	lastIds := make(map[uint64]uint64, len(blockNums))
+	for _, receipt := range receipts {
+		lastIds[receipt.BlockNum] = receipt.Id
+	}
+	q := sg.db.WithContext(ctx).Model(&TransactionReceipt{})
+	for _, blockNum := range blockNums {
+		q = q.Or("id BETWEEN ? AND ?", blockNum*step, lastIds[blockNum])
+	}
+	var ids []uint64
+	q.Order("id asc").Pluck("id", &ids)
+	logIndex := make(map[uint64]uint64, len(ids))
+	var (
+		current  uint64
+		position uint64
+	)
+	for _, id := range ids {
+		if blockNum := id / step; blockNum != current {
+			current, position = blockNum, 0
+		}
+		logIndex[id] = position
+		position++
+	}
+	for _, receipt := range receipts {
+		topics := strings.Split(receipt.Topics, ",")
+		if receipt.Topics == "" {
+			topics = []string{}
+		}
+		logs = append(logs, RpcLog{
+			Address:          receipt.Address,
+			Topics:           topics,
+			Data:             util.AddHex(receipt.Data),
+			BlockNumber:      util.IntToHexNumber(receipt.BlockNum),
+			BlockHash:        blocks[receipt.BlockNum].BlockHash,
+			TransactionHash:  receipt.TransactionHash,
+			TransactionIndex: util.IntToHexNumber(txIndexOfReceiptId(receipt.Id)),
+			LogIndex:         util.IntToHexNumber(logIndex[receipt.Id]),
+		})
+	}
+	return logs
+}
+
+// rpcProxyMethods read only methods forwarded to node, methods signing or sending transactions,
+// node admin, debug, personal, txpool, miner namespaces and stateful filters are never forwarded
+var rpcProxyMethods = map[string]struct{}{
+	"web3_clientVersion":                      {},
+	"web3_sha3":                               {},
+	"net_version":                             {},
+	"net_listening":                           {},
+	"net_peerCount":                           {},
+	"eth_chainId":                             {},
+	"eth_protocolVersion":                     {},
+	"eth_syncing":                             {},
+	"eth_blockNumber":                         {},
+	"eth_gasPrice":                            {},
+	"eth_maxPriorityFeePerGas":                {},
+	"eth_feeHistory":                          {},
+	"eth_getBalance":                          {},
+	"eth_getStorageAt":                        {},
+	"eth_getTransactionCount":                 {},
+	"eth_getCode":                             {},
+	"eth_getProof":                            {},
+	"eth_call":                                {},
+	"eth_estimateGas":                         {},
+	"eth_getBlockByHash":                      {},
+	"eth_getBlockByNumber":                    {},
+	"eth_getBlockReceipts":                    {},
+	"eth_getBlockTransactionCountByHash":      {},
+	"eth_getBlockTransactionCountByNumber":    {},
+	"eth_getUncleCountByBlockHash":            {},
+	"eth_getUncleCountByBlockNumber":          {},
+	"eth_getUncleByBlockHashAndIndex":         {},
+	"eth_getUncleByBlockNumberAndIndex":       {},
+	"eth_getTransactionByHash":                {},
+	"eth_getTransactionByBlockHashAndIndex":   {},
+	"eth_getTransactionByBlockNumberAndIndex": {},
+	"eth_getTransactionReceipt":               {},
+	"eth_getLogs":                             {},
+}
+
+// RpcProxyAllowed method can be forwarded to node
+func RpcProxyAllowed(method string) bool {
+	_, ok := rpcProxyMethods[method]
+	return ok
+}
+
+// RPC_Proxy forward read only request to node, error of node is returned as *dto.Error
+func (a *ApiSrv) RPC_Proxy(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	if !RpcProxyAllowed(method) {
+		return nil, &dto.Error{Code: -32601, Message: fmt.Sprintf("the method %s does not exist/is not available", method)}
+	}
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Error  *dto.Error      `json:"error"`
+	}
+	if len(params) == 0 {
+		params = json.RawMessage("[]")
+	}
+	if err := web3.RPC.Provider.SendRequest(ctx, &res, method, params); err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return res.Result, nil
+}
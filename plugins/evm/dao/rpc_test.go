@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRpcProxyAllowed(t *testing.T) {
+	for _, method := range []string{"eth_call", "eth_getBalance", "eth_blockNumber", "net_version", "web3_clientVersion"} {
+		assert.True(t, RpcProxyAllowed(method), method)
+	}
+	for _, method := range []string{"eth_sendTransaction", "eth_sendRawTransaction", "eth_sign", "eth_accounts", "eth_newFilter",
+		"admin_peers", "debug_traceTransaction", "personal_unlockAccount", "txpool_content", "miner_start", ""} {
+		assert.False(t, RpcProxyAllowed(method), method)
+	}
+
+	_, err := new(ApiSrv).RPC_Proxy(context.Background(), "admin_addPeer", nil)
+	var rpcErr *dto.Error
+	assert.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, -32601, rpcErr.Code)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/itering/subscan/model"
 	customerror "github.com/itering/subscan/pkg/go-web3/constants"
 	"github.com/itering/subscan/pkg/go-web3/dto"
 	"github.com/itering/subscan/share/web3"
@@ -24,6 +25,10 @@ func Validate(startBlock int, fastMod bool, blockNum int) {
 	const holdOnNum = 10
 
 	util.Logger().Info(fmt.Sprintf("Now: block height %d", finalizedBlock))
+	// cached api responses of repaired blocks are stale
+	defer func() {
+		_, _ = sg.redis.HINCRBY(ctx, model.ResponseCacheGenerationKey(), model.ResponseCacheGenerationField, 1)
+	}()
 	var latestUpdateBlockNum int
 
 	var fillBlock = func(num int, force bool) *dto.Block {
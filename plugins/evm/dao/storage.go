@@ -54,6 +54,8 @@ func (s *Storage) Tables() []interface{} {
 		&EvmBlock{},
 		&Erc721Holders{},
 		&AbiMapping{},
+		&ProxyUpgrade{},
+		&NftMetadata{},
 		// &ERC1155Item{},
 		// &ERC1155Holder{},
 		&Account{},
@@ -61,6 +63,28 @@ func (s *Storage) Tables() []interface{} {
 
 }
 
+// Rollback delete evm blocks, transactions, receipts, token transfers and proxy upgrades from block num,
+// transaction count of contracts and transfer count of tokens are counted again
+func (s *Storage) Rollback(ctx context.Context, from uint) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transferId := uint64(from) * TransactionIdGenerateCoefficient * TxnReceiptLimit
+		if err := tx.Where("transfer_id >= ?", transferId).Delete(&TokensTransfers{}).Error; err != nil {
+			return err
+		}
+		for _, value := range []interface{}{&TransactionReceipt{}, &ProxyUpgrade{}, &Transaction{}, &EvmBlock{}} {
+			if err := tx.Where("block_num >= ?", from).Delete(value).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(Contract{}).Where("transaction_count > 0").UpdateColumn("transaction_count",
+			gorm.Expr("(SELECT COUNT(*) FROM evm_transactions WHERE evm_transactions.to_address = evm_contracts.address)")).Error; err != nil {
+			return err
+		}
+		return tx.Model(Token{}).Where("transfer_count > 0").UpdateColumn("transfer_count",
+			gorm.Expr("(SELECT COUNT(*) FROM evm_tokens_transfers WHERE evm_tokens_transfers.contract = evm_tokens.contract)")).Error
+	})
+}
+
 func Publish(queue, class string, args interface{}) error {
 	if mq.Instant == nil {
 		return nil
@@ -72,6 +96,7 @@ func RefreshMetadata(ctx context.Context) {
 	if sg == nil || sg.db == nil {
 		return
 	}
+	RetryNftMetadata(ctx)
 	db := sg.db
 	var count int64
 	_ = db.Model(Account{}).Count(&count)
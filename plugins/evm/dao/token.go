@@ -6,6 +6,7 @@ import (
 	"github.com/itering/subscan/model"
 	"github.com/itering/subscan/plugins/evm/feature"
 	"github.com/itering/subscan/plugins/evm/feature/erc721"
+	"github.com/itering/subscan/share/analytics"
 	"github.com/itering/subscan/share/web3"
 	"github.com/itering/subscan/util"
 	"github.com/itering/subscan/util/mq"
@@ -279,6 +280,7 @@ func (t *TransactionReceipt) ProcessTokenTransfer(ctx context.Context, category
 	if query.RowsAffected > 0 {
 
 		token.incrTransferCount(ctx, 1)
+		pushAnalytics(ctx, analytics.TableTokenTransfers, transfer.analyticsRow())
 		if mq.Instant != nil {
 			_ = Publish(category, "balance", []string{token.Contract, transfer.Sender})
 			_ = Publish(category, "balance", []string{token.Contract, transfer.Receiver})
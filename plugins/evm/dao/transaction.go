@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/itering/subscan/model"
 	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/itering/subscan/share/analytics"
 	"github.com/itering/subscan/share/web3"
 	"github.com/itering/subscan/util"
 	"strings"
@@ -24,7 +25,7 @@ type Transaction struct {
 	BlockNum       uint            `json:"block_num" gorm:"default: null;size:32;index:block_num" `
 	BlockTimestamp uint            `json:"block_timestamp" gorm:"size:32" `
 	FromAddress    string          `json:"from_address" gorm:"default: null;size:70;index:sender"`
-	ToAddress      string          `json:"to_address" gorm:"default: null;size:70"`
+	ToAddress      string          `json:"to_address" gorm:"default: null;size:70;index:receiver"`
 	InputData      string          `json:"input_data" gorm:"type:string"`
 	Nonce          uint            `json:"nonce" gorm:"size:32" `
 	GasLimit       decimal.Decimal `json:"gas_limit" gorm:"default: 0;type:decimal(40);" `
@@ -47,6 +48,9 @@ type Transaction struct {
 	TransactionIndex     uint64          `json:"transaction_index" gorm:"size:32"`
 	// pk
 	TransactionId uint64 `json:"transaction_id" gorm:"size:64;index:transaction_id,unique" `
+
+	// delivered transaction is stored already, it is delivered again after retry or rollback
+	delivered bool
 }
 
 type TransactionSample struct {
@@ -121,6 +125,9 @@ func (t *Transaction) TableName() string {
 
 func (t *Transaction) AfterCreate(txn *gorm.DB) (err error) {
 	ctx := txn.Statement.Context
+	if t.delivered {
+		return nil
+	}
 	// Increase Contract transaction count
 	if IsContract(ctx, t.ToAddress) {
 		incrContractTransactionCount(ctx, t.ToAddress)
@@ -169,7 +176,8 @@ func GetTransactionByHash(c context.Context, hash string) *Transaction {
 	return &t
 }
 
-func (s *Storage) CreateTransactionByExecuted(ctx context.Context, blockTimestamp uint, ethTransaction *dto.BlockTransaction, extrinsicIndex string) (err error) {
+// CreateTransactionByExecuted save transaction with receipt, receipt is fetched if nil
+func (s *Storage) CreateTransactionByExecuted(ctx context.Context, blockTimestamp uint, ethTransaction *dto.BlockTransaction, extrinsicIndex string, ethReceipt *dto.TransactionReceipt) (err error) {
 	transaction := Transaction{
 		BlockNum:       uint(util.U256(ethTransaction.BlockNumber).Uint64()),
 		BlockTimestamp: blockTimestamp,
@@ -196,14 +204,13 @@ func (s *Storage) CreateTransactionByExecuted(ctx context.Context, blockTimestam
 	transaction.TransactionId = uint64(transaction.BlockNum)*TransactionIdGenerateCoefficient + transaction.TransactionIndex
 
 	// prevent transaction blocking
-	var (
-		receipts   []TransactionReceipt
-		ethReceipt *dto.TransactionReceipt
-	)
+	var receipts []TransactionReceipt
 
 	//  eth_getTransactionReceipt
-	if ethReceipt, err = web3.RPC.Eth.GetTransactionReceipt(ctx, transaction.Hash); err != nil {
-		return err
+	if ethReceipt == nil {
+		if ethReceipt, err = web3.RPC.Eth.GetTransactionReceipt(ctx, transaction.Hash); err != nil {
+			return err
+		}
 	}
 
 	if ethReceipt.ContractAddress != "" {
@@ -246,10 +253,14 @@ func (s *Storage) CreateTransactionByExecuted(ctx context.Context, blockTimestam
 		return
 	}
 	// transaction
+	var delivered int64
+	sg.db.WithContext(ctx).Model(Transaction{}).Where("hash = ?", transaction.Hash).Count(&delivered)
+	transaction.delivered = delivered > 0
 	query := sg.AddOrUpdateItem(ctx, &transaction, []string{"hash"}, "transaction_index")
 	if query.Error != nil {
 		return query.Error
 	}
+	pushAnalytics(ctx, analytics.TableEvmTransactions, transaction.analyticsRow())
 	_ = TouchAccount(ctx, transaction.FromAddress)
 	_ = TouchAccount(ctx, transaction.ToAddress)
 	return nil
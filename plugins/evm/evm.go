@@ -8,17 +8,23 @@ import (
 	"github.com/itering/subscan/plugins/evm/dao"
 	"github.com/itering/subscan/plugins/evm/http"
 	"github.com/itering/subscan/plugins/evm/workers"
+	"github.com/itering/subscan/share/analytics"
 	"github.com/itering/subscan/util"
 	"github.com/itering/substrate-api-rpc/metadata"
 	"github.com/shopspring/decimal"
 	"github.com/urfave/cli"
 	"gorm.io/gorm"
+	"sync"
 )
 
 type EVM struct {
 	d      storage.Dao
 	s      *dao.Storage
 	enable bool
+
+	// analytics rows of delivered blocks, pushed after checkpoint of block committed
+	mu        sync.Mutex
+	analytics map[uint]*dao.AnalyticsRows
 }
 
 func (a *EVM) Commands() []cli.Command {
@@ -44,7 +50,44 @@ func (a *EVM) Enable() bool {
 }
 
 func (a *EVM) ProcessBlock(ctx context.Context, block *storage.Block) error {
-	return a.s.AddEvmBlock(ctx, uint(block.BlockNum), false)
+	ctx, rows := dao.WithAnalyticsRows(ctx)
+	if err := a.s.AddEvmBlock(ctx, uint(block.BlockNum), false); err != nil {
+		return err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.analytics == nil {
+		a.analytics = make(map[uint]*dao.AnalyticsRows)
+	}
+	a.analytics[uint(block.BlockNum)] = rows
+	return nil
+}
+
+// CommitBlock push analytics rows of block after checkpoint of block committed
+func (a *EVM) CommitBlock(_ context.Context, blockNum uint) {
+	a.mu.Lock()
+	rows, ok := a.analytics[blockNum]
+	delete(a.analytics, blockNum)
+	a.mu.Unlock()
+	if ok {
+		rows.Push()
+	}
+}
+
+// Rollback delete evm data from block num before blocks delivered again
+func (a *EVM) Rollback(ctx context.Context, from uint) error {
+	if !a.Enable() {
+		return nil
+	}
+	// rows of blocks not committed are collected again
+	a.mu.Lock()
+	for blockNum := range a.analytics {
+		if blockNum >= from {
+			delete(a.analytics, blockNum)
+		}
+	}
+	a.mu.Unlock()
+	return a.s.Rollback(ctx, from)
 }
 
 func (a *EVM) SetRedisPool(pool subscan_plugin.RedisPool) {
@@ -67,6 +110,7 @@ func (a *EVM) InitDao(d storage.Dao) {
 	a.enable = true
 	a.d = d
 	a.Migrate()
+	analytics.RegisterBackfill("evm", dao.AnalyticsBackfill)
 }
 
 func (a *EVM) InitHttp() []router.Http {
@@ -0,0 +1,33 @@
+package delegateProxy
+
+import (
+	"context"
+	"github.com/itering/subscan/pkg/go-web3"
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/itering/subscan/plugins/evm/contract"
+)
+
+// https://eips.ethereum.org/EIPS/eip-1822
+// slot index 0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7 = keccak256("PROXIABLE")
+// UUPS proxies of openzeppelin store implementation at slot of EIP1967
+
+const EIP1822Standard = "EIP1822"
+
+type EIP1822 struct {
+	contract.Contract
+}
+
+func Init1822(w3 *web3.Web3, contract string) *EIP1822 {
+	t := EIP1822{}
+	t.Eth = w3.Eth
+	t.Contract.TransParam = dto.TransactionParameters{To: contract, Data: ""}
+	return &t
+}
+
+func (c *EIP1822) Implementation(ctx context.Context) (string, error) {
+	return storageAddress(ctx, &c.Contract, "0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7")
+}
+
+func (c *EIP1822) Standard() string {
+	return EIP1822Standard
+}
@@ -0,0 +1,47 @@
+package delegateProxy
+
+import (
+	"context"
+	"errors"
+	"github.com/itering/subscan/pkg/go-web3"
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/itering/subscan/pkg/go-web3/eth/block"
+	"github.com/itering/subscan/plugins/evm/contract"
+	"strings"
+)
+
+// https://github.com/safe-global/safe-smart-account/blob/main/contracts/proxies/SafeProxy.sol
+// singleton(master copy) is stored at slot 0, and returned by masterCopy() through fallback of proxy
+
+const GnosisSafeStandard = "GnosisSafe"
+
+type GnosisSafe struct {
+	contract.Contract
+}
+
+func InitGnosisSafe(w3 *web3.Web3, contract string) *GnosisSafe {
+	t := GnosisSafe{}
+	t.Eth = w3.Eth
+	t.Contract.TransParam = dto.TransactionParameters{To: contract, Data: ""}
+	return &t
+}
+
+func (c *GnosisSafe) Implementation(ctx context.Context) (string, error) {
+	// masterCopy()
+	masterCopy, err := callAddress(ctx, &c.Contract, c.TransParam.To, "0xa619486e", block.LATEST)
+	if err != nil {
+		return "", err
+	}
+	singleton, err := storageAddress(ctx, &c.Contract, "0x0")
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(masterCopy, singleton) {
+		return "", errors.New("not gnosis safe proxy")
+	}
+	return singleton, nil
+}
+
+func (c *GnosisSafe) Standard() string {
+	return GnosisSafeStandard
+}
@@ -0,0 +1,48 @@
+package delegateProxy
+
+import (
+	"context"
+	"github.com/itering/subscan/pkg/go-web3"
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/itering/subscan/pkg/go-web3/eth/block"
+	"github.com/itering/subscan/plugins/evm/contract"
+)
+
+// https://eips.ethereum.org/EIPS/eip-1967#beacon-contract-address
+// slot index 0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50 = bytes32(uint256(keccak256('eip1967.proxy.beacon')) - 1)
+// implementation is returned by implementation() of beacon
+
+const BeaconStandard = "EIP1967Beacon"
+
+type Beacon struct {
+	contract.Contract
+}
+
+func InitBeacon(w3 *web3.Web3, contract string) *Beacon {
+	t := Beacon{}
+	t.Eth = w3.Eth
+	t.Contract.TransParam = dto.TransactionParameters{To: contract, Data: ""}
+	return &t
+}
+
+func (c *Beacon) Beacon(ctx context.Context) (string, error) {
+	return storageAddress(ctx, &c.Contract, "0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+}
+
+func (c *Beacon) Implementation(ctx context.Context) (string, error) {
+	beacon, err := c.Beacon(ctx)
+	if err != nil || IsZeroAddress(beacon) {
+		return beacon, err
+	}
+	return BeaconImplementation(ctx, &c.Contract, beacon, block.LATEST)
+}
+
+// BeaconImplementation implementation() of beacon at block, block is hex number or tag like latest
+func BeaconImplementation(ctx context.Context, c *contract.Contract, beacon, blockNumber string) (string, error) {
+	// implementation()
+	return callAddress(ctx, c, beacon, "0x5c60da1b", blockNumber)
+}
+
+func (c *Beacon) Standard() string {
+	return BeaconStandard
+}
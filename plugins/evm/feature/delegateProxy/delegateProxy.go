@@ -2,17 +2,88 @@ package delegateProxy
 
 import (
 	"context"
+	"errors"
+	"github.com/itering/subscan/pkg/go-web3"
+	"github.com/itering/subscan/pkg/go-web3/complex/types"
 	"github.com/itering/subscan/plugins/evm/abi"
+	"github.com/itering/subscan/plugins/evm/contract"
+	"github.com/itering/subscan/util"
+	"strings"
 )
 
 // Events
 // event Upgraded(address indexed implementation);
+// event AdminChanged(address previousAdmin, address newAdmin);
+// event BeaconUpgraded(address indexed beacon);
 
 var (
-	EventUpgraded = abi.EncodingMethod("Upgraded(address)")
+	EventUpgraded       = abi.EncodingMethod("Upgraded(address)")
+	EventAdminChanged   = abi.EncodingMethod("AdminChanged(address,address)")
+	EventBeaconUpgraded = abi.EncodingMethod("BeaconUpgraded(address)")
 )
 
 type IDelegateProxy interface {
 	Implementation(context.Context) (string, error)
 	Standard() string
 }
+
+// Init proxy of standard, nil if standard is unknown
+func Init(w3 *web3.Web3, standard, address string) IDelegateProxy {
+	switch standard {
+	case EIP1967Standard:
+		return Init1967(w3, address)
+	case Eip897Standard:
+		return Init897(w3, address)
+	case EIP1822Standard:
+		return Init1822(w3, address)
+	case BeaconStandard:
+		return InitBeacon(w3, address)
+	case GnosisSafeStandard:
+		return InitGnosisSafe(w3, address)
+	}
+	return nil
+}
+
+// Detect standard and implementation of proxy contract, checked in order of EIP1967, beacon, EIP1822, Gnosis Safe and EIP897
+func Detect(ctx context.Context, w3 *web3.Web3, address string) (IDelegateProxy, string) {
+	for _, standard := range []string{EIP1967Standard, BeaconStandard, EIP1822Standard, GnosisSafeStandard, Eip897Standard} {
+		proxy := Init(w3, standard, address)
+		if implementation, err := proxy.Implementation(ctx); err == nil && !IsZeroAddress(implementation) {
+			return proxy, implementation
+		}
+	}
+	return nil, ""
+}
+
+// IsZeroAddress empty or zero address
+func IsZeroAddress(address string) bool {
+	return strings.Trim(util.TrimHex(address), "0") == ""
+}
+
+// storageAddress address stored in slot of contract
+func storageAddress(ctx context.Context, c *contract.Contract, slot string) (string, error) {
+	value, err := c.GetStorageByKey(ctx, c.TransParam.To, slot)
+	if err != nil {
+		return "", err
+	}
+	return wordAddress(value)
+}
+
+// callAddress address returned by call of function without arguments at block, block is hex number or tag like latest
+func callAddress(ctx context.Context, c *contract.Contract, to, selector, block string) (string, error) {
+	params := c.TransParam
+	params.To = to
+	params.Data = types.ComplexString(selector)
+	value, err := c.Eth.CallAt(ctx, &params, block)
+	if err != nil {
+		return "", err
+	}
+	return wordAddress(value)
+}
+
+func wordAddress(value string) (string, error) {
+	if value = util.TrimHex(value); len(value) < 64 {
+		return "", errors.New("not address")
+	}
+	return util.AddHex(value[24:64]), nil
+}
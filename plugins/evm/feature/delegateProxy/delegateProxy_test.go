@@ -33,3 +33,17 @@ func TestEIP1967_Implementation(t *testing.T) {
 	assert.Equal(t, "0x0000000000000000000000000000000000000000", Implementation)
 
 }
+
+func TestIsZeroAddress(t *testing.T) {
+	assert.True(t, IsZeroAddress(""))
+	assert.True(t, IsZeroAddress("0x0000000000000000000000000000000000000000"))
+	assert.False(t, IsZeroAddress("0x40f1eca9c82200428704aa555da1009ad4beb2e2"))
+}
+
+func TestWordAddress(t *testing.T) {
+	address, err := wordAddress("0x00000000000000000000000040f1eca9c82200428704aa555da1009ad4beb2e2")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x40f1eca9c82200428704aa555da1009ad4beb2e2", address)
+	_, err = wordAddress("0x")
+	assert.Error(t, err)
+}
@@ -2,8 +2,12 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"github.com/itering/subscan/model"
+	"github.com/itering/subscan/pkg/go-web3/dto"
 	balanceModel "github.com/itering/subscan/plugins/balance/model"
+	evmContract "github.com/itering/subscan/plugins/evm/contract"
 	"github.com/itering/subscan/plugins/evm/dao"
 	"github.com/shopspring/decimal"
 )
@@ -140,6 +144,78 @@ func (m MockServer) ContractsByAddr(ctx context.Context, address string) (contra
 	return &dao.Contract{Address: address, VerifyStatus: "perfect"}
 }
 
+func (m MockServer) ContractFunctions(ctx context.Context, address string) (*dao.ContractFunctionsJson, error) {
+	if address == "0x1f98431c8ad98523631ae4a59f267346ea31f984" {
+		return nil, dao.ErrContractNotVerified
+	}
+	return &dao.ContractFunctionsJson{ContractAbiFunctions: dao.ContractAbiFunctions{Address: address}}, nil
+}
+
+func (m MockServer) ContractCall(ctx context.Context, p *dao.ContractInteraction) ([]evmContract.AbiValue, error) {
+	return []evmContract.AbiValue{{Type: "uint256", Value: "1000"}}, nil
+}
+
+func (m MockServer) ContractWriteTransaction(ctx context.Context, p *dao.ContractInteraction) (*dao.ContractUnsignedTransaction, error) {
+	return &dao.ContractUnsignedTransaction{To: p.Address, Data: "0xa9059cbb", Value: "0x0"}, nil
+}
+
+func (m MockServer) ProxyTimeline(ctx context.Context, address string) *dao.ProxyTimelineJson {
+	if address != "0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346" {
+		return nil
+	}
+	return &dao.ProxyTimelineJson{Address: address, EipStandard: "EIP1967", Timeline: []dao.ProxyUpgradeJson{
+		{ProxyUpgrade: dao.ProxyUpgrade{Address: address, Event: dao.ProxyEventUpgraded}, ImplementationVerifyStatus: "perfect"},
+	}}
+}
+
+func (m MockServer) NftMetadata(ctx context.Context, contract, tokenId string) *dao.NftMetadata {
+	if contract != "0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346" {
+		return nil
+	}
+	return &dao.NftMetadata{Contract: contract, TokenId: tokenId, Status: dao.NftMetadataSuccess, Metadata: dao.Metadata{Name: "nft"}}
+}
+
+func (m MockServer) NftMetadataRefresh(ctx context.Context, contract, tokenId string) (*dao.NftMetadata, error) {
+	if contract != "0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346" {
+		return nil, dao.ErrTokenNotFound
+	}
+	return &dao.NftMetadata{Contract: contract, TokenId: tokenId, Status: dao.NftMetadataFailed, Attempts: 1, LastError: "fetch image"}, nil
+}
+
+func (m MockServer) NftContent(ctx context.Context, hash string) ([]byte, string, error) {
+	if hash != "ab" {
+		return nil, "", errors.New("content not found")
+	}
+	return []byte("<svg></svg>"), "image/svg+xml", nil
+}
+
+func (m MockServer) RPC_LatestBlockNum(ctx context.Context) uint64 {
+	return 100
+}
+
+func (m MockServer) RPC_GetBlock(ctx context.Context, blockNum *uint64, hash string, full bool) *dao.RpcBlock {
+	if hash == "0x6b5e2a4b7a2e4f0a8f8a2c5b3a5f1f1e9f0e7c6b5a4d3c2b1a09f8e7d6c5b4a3" {
+		return &dao.RpcBlock{Number: "0x5", Hash: hash}
+	}
+	return nil
+}
+
+func (m MockServer) RPC_GetTransaction(ctx context.Context, hash string) *dao.RpcTransaction {
+	return nil
+}
+
+func (m MockServer) RPC_GetTransactionReceipt(ctx context.Context, hash string) *dao.RpcReceipt {
+	return nil
+}
+
+func (m MockServer) RPC_GetLogs(ctx context.Context, filter *dao.LogFilter) ([]dao.RpcLog, error) {
+	return []dao.RpcLog{}, nil
+}
+
+func (m MockServer) RPC_Proxy(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	return nil, &dto.Error{Code: -32601, Message: "method not found"}
+}
+
 func init() {
 	srv = MockServer{}
 }
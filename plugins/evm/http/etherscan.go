@@ -71,87 +71,32 @@ func etherscanHandle(w http.ResponseWriter, r *http.Request) error {
 			toJson(w, 0, nil, err)
 			return nil
 		}
-		var opts []model.Option
 		if logsParams.Offset == 0 || logsParams.Page == 0 {
 			logsParams.Offset = 1000
 			logsParams.Page = 1
 		}
-		opts = append(opts, model.WithLimit(logsParams.Page, logsParams.Offset))
-		if logsParams.ToBlock > 0 {
-			opts = append(opts, model.Where("block_num <= ?", logsParams.ToBlock))
-		}
-		if logsParams.FromBlock > 0 {
-			opts = append(opts, model.Where("block_num >= ?", logsParams.FromBlock))
+		filter := &dao.LogFilter{FromBlock: uint64(logsParams.FromBlock), ToBlock: uint64(logsParams.ToBlock)}
+		if logsParams.ToBlock == 0 {
+			filter.ToBlock = srv.RPC_LatestBlockNum(r.Context())
 		}
 		if logsParams.Address != "" {
-			opts = append(opts, model.Where("address = ?", logsParams.Address))
-		}
-		if logsParams.Topic0 != "" {
-			if logsParams.Topic01Opr != "" && logsParams.Topic1 != "" {
-				// topic0 and topic1
-				if logsParams.Topic01Opr == "and" {
-					opts = append(opts, model.Where("method_hash = ? and topic1 = ?", logsParams.Topic0, logsParams.Topic1))
-				} else {
-					opts = append(opts, model.Where("method_hash = ? or topic1 = ?", logsParams.Topic0, logsParams.Topic1))
-				}
-			}
-			if logsParams.Topic020pr != "" && logsParams.Topic2 != "" {
-				// topic0 and topic2
-				if logsParams.Topic020pr == "and" {
-					opts = append(opts, model.Where("method_hash = ? and topic2 = ?", logsParams.Topic0, logsParams.Topic2))
-				} else {
-					opts = append(opts, model.Where("method_hash = ? or topic2 = ?", logsParams.Topic0, logsParams.Topic2))
-				}
-			}
-			if logsParams.Topic031pr != "" && logsParams.Topic3 != "" {
-				// topic0 and topic3
-				if logsParams.Topic031pr == "and" {
-					opts = append(opts, model.Where("method_hash = ? and topic3 = ?", logsParams.Topic0, logsParams.Topic3))
-				} else {
-					opts = append(opts, model.Where("method_hash = ? or topic3 = ?", logsParams.Topic0, logsParams.Topic3))
-				}
-			}
-			if logsParams.Topic01Opr == "" && logsParams.Topic020pr == "" && logsParams.Topic031pr == "" {
-				opts = append(opts, model.Where("method_hash = ?", logsParams.Topic0))
-			}
+			filter.Addresses = []string{logsParams.Address}
 		}
-		if logsParams.Topic1 != "" {
-			if logsParams.Topic12Opr != "" && logsParams.Topic2 != "" {
-				// topic1 and topic2
-				if logsParams.Topic12Opr == "and" {
-					opts = append(opts, model.Where("topic1 = ? and topic2 = ?", logsParams.Topic1, logsParams.Topic2))
-				} else {
-					opts = append(opts, model.Where("topic1 = ? or topic2 = ?", logsParams.Topic1, logsParams.Topic2))
-				}
-			}
-			if logsParams.Topic131pr != "" && logsParams.Topic3 != "" {
-				// topic1 and topic3
-				if logsParams.Topic131pr == "and" {
-					opts = append(opts, model.Where("topic1 = ? and topic3 = ?", logsParams.Topic1, logsParams.Topic3))
-				} else {
-					opts = append(opts, model.Where("topic1 = ? or topic3 = ?", logsParams.Topic1, logsParams.Topic3))
-				}
-			}
-			if logsParams.Topic12Opr == "" && logsParams.Topic131pr == "" && logsParams.Topic01Opr == "" {
-				opts = append(opts, model.Where("topic1 = ?", logsParams.Topic1))
-			}
-		}
-		if logsParams.Topic2 != "" {
-			if logsParams.Topic23Opr != "" && logsParams.Topic3 != "" {
-				// topic2 and topic3
-				if logsParams.Topic23Opr == "and" {
-					opts = append(opts, model.Where("topic2 = ? and topic3 = ?", logsParams.Topic2, logsParams.Topic3))
-				} else {
-					opts = append(opts, model.Where("topic2 = ? or topic3 = ?", logsParams.Topic2, logsParams.Topic3))
-				}
-			}
-			if logsParams.Topic23Opr == "" && logsParams.Topic12Opr == "" && logsParams.Topic020pr == "" {
-				opts = append(opts, model.Where("topic2 = ?", logsParams.Topic2))
+		filter.Topics = make([][]string, 4)
+		for i, topic := range []string{logsParams.Topic0, logsParams.Topic1, logsParams.Topic2, logsParams.Topic3} {
+			if topic != "" {
+				filter.Topics[i] = []string{topic}
 			}
 		}
-		if logsParams.Topic3 != "" && logsParams.Topic23Opr == "" && logsParams.Topic131pr == "" && logsParams.Topic031pr == "" {
-			opts = append(opts, model.Where("topic3 = ?", logsParams.Topic3))
+		filter.TopicOprs = map[[2]int]string{
+			{0, 1}: logsParams.Topic01Opr,
+			{0, 2}: logsParams.Topic020pr,
+			{0, 3}: logsParams.Topic031pr,
+			{1, 2}: logsParams.Topic12Opr,
+			{1, 3}: logsParams.Topic131pr,
+			{2, 3}: logsParams.Topic23Opr,
 		}
+		opts := append(filter.Scopes(), model.WithLimit(logsParams.Page, logsParams.Offset))
 		res := srv.API_GetLogs(r.Context(), opts...)
 		if len(res) == 0 {
 			etherscanRes(w, 0, nil, ErrRecordNotFound)
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/itering/subscan-plugin/router"
 	"github.com/itering/subscan/model"
@@ -8,12 +9,14 @@ import (
 	"github.com/itering/subscan/plugins/evm/dao"
 	"github.com/itering/subscan/util/validator"
 	"net/http"
+	"strings"
 )
 
 func Router() []router.Http {
 	srv = &dao.ApiSrv{}
 	return []router.Http{
 		{"etherscan", etherscanHandle, http.MethodGet},
+		{"rpc", rpcHandle, http.MethodPost},
 
 		{"blocks", blocksHandle, http.MethodPost},
 		{"block", blockHandle, http.MethodPost},
@@ -27,12 +30,19 @@ func Router() []router.Http {
 		{"contracts", contractsHandle, http.MethodPost},
 		{"contract/solcs", solcVersions, http.MethodPost},
 		{"contract/resolcs", resolcVersions, http.MethodPost},
+		{"contract/functions", contractFunctionsHandle, http.MethodPost},
+		{"contract/read", contractReadHandle, http.MethodPost},
+		{"contract/write", contractWriteHandle, http.MethodPost},
+		{"contract/proxy_upgrades", proxyUpgradesHandle, http.MethodPost},
 
 		// token holder
 		{"token/holder", tokenHolderHandle, http.MethodPost},
 		{"tokens", tokenListHandle, http.MethodPost},
 		{"token/transfer", tokenTransferHandle, http.MethodPost},
 		{"token/erc721/collectibles", collectiblesHandle, http.MethodPost},
+		{"token/erc721/metadata", nftMetadataHandle, http.MethodPost},
+		{"token/erc721/metadata/refresh", nftMetadataRefreshHandle, http.MethodPost},
+		{"token/erc721/content", nftContentHandle, http.MethodGet},
 		{"account/tokens", accountTokensHandle, http.MethodPost},
 	}
 }
@@ -90,6 +100,77 @@ func collectiblesHandle(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+type nftMetadataParams struct {
+	Contract string `json:"contract" validate:"required,eth_addr"`
+	TokenId  string `json:"token_id" validate:"required,number"`
+}
+
+// @Summary Evm nft metadata with fetch status, image and thumbnail are hashes of token/erc721/content
+// @Tags EVM
+// @Accept json
+// @Produce json
+// @Param params body nftMetadataParams true "params"
+// @Success 200 {object} J{data=dao.NftMetadata}
+// @Router /api/plugin/evm/token/erc721/metadata [post]
+func nftMetadataHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(nftMetadataParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	metadata := srv.NftMetadata(r.Context(), strings.ToLower(p.Contract), p.TokenId)
+	if metadata == nil {
+		toJson(w, 10002, nil, fmt.Errorf("metadata not found"))
+		return nil
+	}
+	toJson(w, 0, metadata, nil)
+	return nil
+}
+
+// @Summary Evm nft metadata fetch again, rejected in retry backoff or within 10 minutes of last fetch
+// @Tags EVM
+// @Accept json
+// @Produce json
+// @Param params body nftMetadataParams true "params"
+// @Success 200 {object} J{data=dao.NftMetadata}
+// @Router /api/plugin/evm/token/erc721/metadata/refresh [post]
+func nftMetadataRefreshHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(nftMetadataParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	metadata, err := srv.NftMetadataRefresh(r.Context(), strings.ToLower(p.Contract), p.TokenId)
+	if err != nil {
+		toJson(w, 10002, nil, err)
+		return nil
+	}
+	toJson(w, 0, metadata, nil)
+	return nil
+}
+
+// @Summary Evm nft metadata, image or thumbnail content of local content store
+// @Tags EVM
+// @Produce octet-stream
+// @Param hash query string true "content hash"
+// @Success 200 {file} binary
+// @Router /api/plugin/evm/token/erc721/content [get]
+func nftContentHandle(w http.ResponseWriter, r *http.Request) error {
+	data, contentType, err := srv.NftContent(r.Context(), r.URL.Query().Get("hash"))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	// content addressed, never changes
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	// svg may carry scripts
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+	_, _ = w.Write(data)
+	return nil
+}
+
 type tokenListParams struct {
 	Limit    int     `json:"row" validate:"min=1,max=100"`
 	Before   *string `json:"before" validate:"omitempty,min=0"`
@@ -365,6 +446,130 @@ func contractsHandle(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+type contractFunctionsParams struct {
+	Address string `json:"address" validate:"required,eth_addr"`
+}
+
+// @Summary Evm verified contract read and write functions, with functions of implementation if contract is a proxy
+// @Tags EVM
+// @Accept json
+// @Produce json
+// @Param params body contractFunctionsParams true "params"
+// @Success 200 {object} J{data=dao.ContractFunctionsJson}
+// @Router /api/plugin/evm/contract/functions [post]
+func contractFunctionsHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(contractFunctionsParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	functions, err := srv.ContractFunctions(r.Context(), strings.ToLower(p.Address))
+	if err != nil {
+		toJson(w, 10002, nil, err)
+		return nil
+	}
+	toJson(w, 0, functions, nil)
+	return nil
+}
+
+type contractReadParams struct {
+	Address        string            `json:"address" validate:"required,eth_addr"`
+	Method         string            `json:"method" validate:"required"`
+	Args           []json.RawMessage `json:"args"`
+	From           string            `json:"from" validate:"omitempty,eth_addr"`
+	BlockNum       *uint64           `json:"block_num" validate:"omitempty,min=0"`
+	Implementation bool              `json:"implementation"`
+}
+
+// @Summary Evm call verified contract function, method is name, signature or selector
+// @Tags EVM
+// @Accept json
+// @Produce json
+// @Param params body contractReadParams true "params"
+// @Success 200 {object} J{data=[]contract.AbiValue}
+// @Router /api/plugin/evm/contract/read [post]
+func contractReadHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(contractReadParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	outputs, err := srv.ContractCall(r.Context(), &dao.ContractInteraction{
+		Address:        strings.ToLower(p.Address),
+		Method:         p.Method,
+		Args:           p.Args,
+		From:           p.From,
+		BlockNum:       p.BlockNum,
+		Implementation: p.Implementation,
+	})
+	if err != nil {
+		toJson(w, 10002, nil, err)
+		return nil
+	}
+	toJson(w, 0, outputs, nil)
+	return nil
+}
+
+type contractWriteParams struct {
+	Address        string            `json:"address" validate:"required,eth_addr"`
+	Method         string            `json:"method" validate:"required"`
+	Args           []json.RawMessage `json:"args"`
+	From           string            `json:"from" validate:"omitempty,eth_addr"`
+	Value          string            `json:"value" validate:"omitempty,numeric|hexadecimal"`
+	Implementation bool              `json:"implementation"`
+}
+
+// @Summary Evm unsigned transaction of verified contract write function
+// @Tags EVM
+// @Accept json
+// @Produce json
+// @Param params body contractWriteParams true "params"
+// @Success 200 {object} J{data=dao.ContractUnsignedTransaction}
+// @Router /api/plugin/evm/contract/write [post]
+func contractWriteHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(contractWriteParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	txn, err := srv.ContractWriteTransaction(r.Context(), &dao.ContractInteraction{
+		Address:        strings.ToLower(p.Address),
+		Method:         p.Method,
+		Args:           p.Args,
+		From:           p.From,
+		Value:          p.Value,
+		Implementation: p.Implementation,
+	})
+	if err != nil {
+		toJson(w, 10002, nil, err)
+		return nil
+	}
+	toJson(w, 0, txn, nil)
+	return nil
+}
+
+// @Summary Evm proxy contract implementation timeline, with verify status of each implementation
+// @Tags EVM
+// @Accept json
+// @Produce json
+// @Param params body contractParams true "params"
+// @Success 200 {object} J{data=dao.ProxyTimelineJson}
+// @Router /api/plugin/evm/contract/proxy_upgrades [post]
+func proxyUpgradesHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(contractParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	timeline := srv.ProxyTimeline(r.Context(), strings.ToLower(p.Address))
+	if timeline == nil {
+		toJson(w, 10002, nil, fmt.Errorf("contract not found"))
+		return nil
+	}
+	toJson(w, 0, timeline, nil)
+	return nil
+}
+
 // @Summary Polkadot pvm resolc versions
 // @Tags EVM
 // @Accept json
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractInteractionHandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		handle   func(w http.ResponseWriter, r *http.Request) error
+		body     string
+		wantBody string
+	}{
+		{
+			name:     "functions of verified contract",
+			handle:   contractFunctionsHandle,
+			body:     `{"address":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346"}`,
+			wantBody: `"address":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346"`,
+		},
+		{
+			name:     "functions of unverified contract",
+			handle:   contractFunctionsHandle,
+			body:     `{"address":"0x1F98431c8aD98523631AE4a59f267346ea31F984"}`,
+			wantBody: `"code":10002,"message":"contract not verified"`,
+		},
+		{
+			name:     "read",
+			handle:   contractReadHandle,
+			body:     `{"address":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346","method":"balanceOf","args":["0x1F98431c8aD98523631AE4a59f267346ea31F984"],"block_num":100}`,
+			wantBody: `"data":[{"name":"","type":"uint256","value":"1000"}]`,
+		},
+		{
+			name:     "read without method",
+			handle:   contractReadHandle,
+			body:     `{"address":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346"}`,
+			wantBody: `"code":10001`,
+		},
+		{
+			name:     "write",
+			handle:   contractWriteHandle,
+			body:     `{"address":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346","method":"transfer(address,uint256)","args":["0x1F98431c8aD98523631AE4a59f267346ea31F984","1"],"value":"0x0"}`,
+			wantBody: `"data":{"to":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346","data":"0xa9059cbb","value":"0x0"}`,
+		},
+		{
+			name:     "write with invalid value",
+			handle:   contractWriteHandle,
+			body:     `{"address":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346","method":"transfer","value":"1 dot"}`,
+			wantBody: `"code":10001`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			_ = tt.handle(rr, req)
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func TestProxyUpgradesHandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		handle   func(w http.ResponseWriter, r *http.Request) error
+		body     string
+		wantBody string
+	}{
+		{
+			name:     "proxy upgrades",
+			handle:   proxyUpgradesHandle,
+			body:     `{"address":"0xb2bf0bf26A4e98a6AEe1484b3bdaf50E3fb4a346"}`,
+			wantBody: `"eip_standard":"EIP1967","implementation":"","timeline":[{"id":0,"address":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346","event":"Upgraded"`,
+		},
+		{
+			name:     "proxy upgrades of unknown contract",
+			handle:   proxyUpgradesHandle,
+			body:     `{"address":"0x1F98431c8aD98523631AE4a59f267346ea31F984"}`,
+			wantBody: `"code":10002,"message":"contract not found"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			_ = tt.handle(rr, req)
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func TestNftMetadataHandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		handle   func(w http.ResponseWriter, r *http.Request) error
+		body     string
+		wantBody string
+	}{
+		{
+			name:     "nft metadata",
+			handle:   nftMetadataHandle,
+			body:     `{"contract":"0xb2bf0bf26A4e98a6AEe1484b3bdaf50E3fb4a346","token_id":"1"}`,
+			wantBody: `"metadata":{"name":"nft"}`,
+		},
+		{
+			name:     "nft metadata with invalid token id",
+			handle:   nftMetadataHandle,
+			body:     `{"contract":"0xb2bf0bf26A4e98a6AEe1484b3bdaf50E3fb4a346","token_id":"0x1"}`,
+			wantBody: `"code":10001`,
+		},
+		{
+			name:     "nft metadata refresh",
+			handle:   nftMetadataRefreshHandle,
+			body:     `{"contract":"0xb2bf0bf26a4e98a6aee1484b3bdaf50e3fb4a346","token_id":"1"}`,
+			wantBody: `"status":"failed","attempts":1`,
+		},
+		{
+			name:     "nft metadata refresh of unknown token",
+			handle:   nftMetadataRefreshHandle,
+			body:     `{"contract":"0x1F98431c8aD98523631AE4a59f267346ea31F984","token_id":"1"}`,
+			wantBody: `"code":10002,"message":"token not found"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			_ = tt.handle(rr, req)
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func TestNftContentHandle(t *testing.T) {
+	rr := httptest.NewRecorder()
+	_ = nftContentHandle(rr, httptest.NewRequest(http.MethodGet, "/?hash=ab", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "image/svg+xml", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "<svg></svg>", rr.Body.String())
+
+	rr = httptest.NewRecorder()
+	_ = nftContentHandle(rr, httptest.NewRequest(http.MethodGet, "/?hash=cd", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
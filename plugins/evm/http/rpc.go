@@ -0,0 +1,385 @@
+package http
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/itering/subscan/pkg/go-web3/dto"
+	"github.com/itering/subscan/plugins/evm/dao"
+	"github.com/itering/subscan/share/web3"
+	"github.com/itering/subscan/util"
+)
+
+// JSON-RPC error codes
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcInvalidParams  = -32602
+	rpcInternalError  = -32603
+	rpcLimitExceeded  = -32005
+)
+
+// rpcMaxBatch max requests of one batch
+const rpcMaxBatch = 50
+
+type rpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	Version string           `json:"jsonrpc"`
+	ID      json.RawMessage  `json:"id"`
+	Result  *json.RawMessage `json:"result,omitempty"`
+	Error   *dto.Error       `json:"error,omitempty"`
+}
+
+// errNotIndexed data of request not indexed, request is forwarded to node
+var errNotIndexed = errors.New("not indexed")
+
+// @Summary Ethereum JSON-RPC served from indexed data, single request or batch
+// @Description eth_chainId, eth_getBlockByNumber, eth_getBlockByHash, eth_getTransactionByHash, eth_getTransactionReceipt and eth_getLogs
+// @Description are answered from indexed tables, other read only methods or data not indexed yet are forwarded to node, batch is limited to 50 requests
+// @Tags EVM
+// @Accept json
+// @Produce json
+// @Router /api/plugin/evm/rpc [post]
+func rpcHandle(w http.ResponseWriter, r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeRpc(w, rpcErrorResponse(nil, rpcParseError, err))
+		return nil
+	}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var batch []json.RawMessage
+		if err = json.Unmarshal(body, &batch); err != nil {
+			writeRpc(w, rpcErrorResponse(nil, rpcParseError, err))
+			return nil
+		}
+		if len(batch) == 0 {
+			writeRpc(w, rpcErrorResponse(nil, rpcInvalidRequest, errors.New("empty batch")))
+			return nil
+		}
+		if len(batch) > rpcMaxBatch {
+			writeRpc(w, rpcErrorResponse(nil, rpcLimitExceeded, fmt.Errorf("batch limit %d exceeded", rpcMaxBatch)))
+			return nil
+		}
+		res := make([]*rpcResponse, 0, len(batch))
+		for _, raw := range batch {
+			res = append(res, handleRpcRequest(r, raw))
+		}
+		writeRpc(w, res)
+		return nil
+	}
+	writeRpc(w, handleRpcRequest(r, body))
+	return nil
+}
+
+func writeRpc(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func rpcErrorResponse(id json.RawMessage, code int, err error) *rpcResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	var rpcErr *dto.Error
+	if !errors.As(err, &rpcErr) {
+		rpcErr = &dto.Error{Code: code, Message: err.Error()}
+	}
+	return &rpcResponse{Version: "2.0", ID: id, Error: rpcErr}
+}
+
+func handleRpcRequest(r *http.Request, raw json.RawMessage) *rpcResponse {
+	req := new(rpcRequest)
+	if err := json.Unmarshal(raw, req); err != nil {
+		return rpcErrorResponse(nil, rpcInvalidRequest, err)
+	}
+	if req.Method == "" {
+		return rpcErrorResponse(req.ID, rpcInvalidRequest, errors.New("method is required"))
+	}
+	result, err := dispatchRpc(r, req)
+	if errors.Is(err, errNotIndexed) {
+		var proxied json.RawMessage
+		if proxied, err = srv.RPC_Proxy(r.Context(), req.Method, req.Params); err == nil {
+			result = proxied
+		}
+	}
+	if err != nil {
+		code := rpcInternalError
+		var paramsErr *rpcParamsError
+		if errors.As(err, &paramsErr) {
+			code = rpcInvalidParams
+		} else if errors.Is(err, dao.ErrRpcLogsLimit) || errors.Is(err, dao.ErrRpcLogsRange) {
+			code = rpcLimitExceeded
+		}
+		return rpcErrorResponse(req.ID, code, err)
+	}
+	b, ok := result.(json.RawMessage)
+	if !ok {
+		if b, err = json.Marshal(result); err != nil {
+			return rpcErrorResponse(req.ID, rpcInternalError, err)
+		}
+	}
+	if len(b) == 0 {
+		b = json.RawMessage("null")
+	}
+	return &rpcResponse{Version: "2.0", ID: req.ID, Result: &b}
+}
+
+type rpcParamsError struct{ err error }
+
+func (e *rpcParamsError) Error() string { return "invalid params: " + e.err.Error() }
+
+func invalidParams(format string, a ...interface{}) error {
+	return &rpcParamsError{err: fmt.Errorf(format, a...)}
+}
+
+// dispatchRpc result of method from indexed data, errNotIndexed if method or data is not indexed
+func dispatchRpc(r *http.Request, req *rpcRequest) (interface{}, error) {
+	ctx := r.Context()
+	var params []json.RawMessage
+	if len(req.Params) > 0 && !bytes.Equal(req.Params, []byte("null")) {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, invalidParams("params must be an array")
+		}
+	}
+	switch req.Method {
+	case "eth_chainId":
+		if web3.CHAIN_ID <= 0 {
+			return nil, errNotIndexed
+		}
+		return util.IntToHexNumber(uint64(web3.CHAIN_ID)), nil
+	case "eth_getBlockByNumber", "eth_getBlockByHash":
+		var (
+			hash string
+			full bool
+		)
+		if len(params) < 1 {
+			return nil, invalidParams("missing value for required argument 0")
+		}
+		if len(params) > 1 {
+			if err := json.Unmarshal(params[1], &full); err != nil {
+				return nil, invalidParams("full transactions must be a bool")
+			}
+		}
+		var block *dao.RpcBlock
+		if req.Method == "eth_getBlockByHash" {
+			if err := json.Unmarshal(params[0], &hash); err != nil || !isHexBytes(hash, 32) {
+				return nil, invalidParams("invalid block hash")
+			}
+			block = srv.RPC_GetBlock(ctx, nil, strings.ToLower(hash), full)
+		} else {
+			blockNum, err := rpcBlockNumber(r, params[0])
+			if err != nil {
+				return nil, err
+			}
+			block = srv.RPC_GetBlock(ctx, &blockNum, "", full)
+		}
+		if block == nil {
+			return nil, errNotIndexed
+		}
+		return block, nil
+	case "eth_getTransactionByHash", "eth_getTransactionReceipt":
+		var hash string
+		if len(params) < 1 {
+			return nil, invalidParams("missing value for required argument 0")
+		}
+		if err := json.Unmarshal(params[0], &hash); err != nil || !isHexBytes(hash, 32) {
+			return nil, invalidParams("invalid transaction hash")
+		}
+		hash = strings.ToLower(hash)
+		if req.Method == "eth_getTransactionByHash" {
+			if txn := srv.RPC_GetTransaction(ctx, hash); txn != nil {
+				return txn, nil
+			}
+		} else if receipt := srv.RPC_GetTransactionReceipt(ctx, hash); receipt != nil {
+			return receipt, nil
+		}
+		return nil, errNotIndexed
+	case "eth_getLogs":
+		if len(params) < 1 {
+			return nil, invalidParams("missing value for required argument 0")
+		}
+		filter, err := rpcLogFilter(r, params[0])
+		if err != nil {
+			return nil, err
+		}
+		return srv.RPC_GetLogs(ctx, filter)
+	}
+	return nil, errNotIndexed
+}
+
+// rpcBlockNumber block number of hex quantity or tag, latest tags are the latest indexed block
+func rpcBlockNumber(r *http.Request, raw json.RawMessage) (uint64, error) {
+	var tag string
+	if err := json.Unmarshal(raw, &tag); err != nil {
+		return 0, invalidParams("invalid block number")
+	}
+	switch tag {
+	case "", "latest", "safe", "finalized":
+		return srv.RPC_LatestBlockNum(r.Context()), nil
+	case "earliest":
+		return 0, nil
+	case "pending":
+		return 0, errNotIndexed
+	}
+	if !strings.HasPrefix(tag, "0x") {
+		return 0, invalidParams("invalid block number %s", tag)
+	}
+	num, err := strconv.ParseUint(tag[2:], 16, 64)
+	if err != nil {
+		return 0, invalidParams("invalid block number %s", tag)
+	}
+	return num, nil
+}
+
+type rpcLogFilterParams struct {
+	FromBlock json.RawMessage `json:"fromBlock"`
+	ToBlock   json.RawMessage `json:"toBlock"`
+	BlockHash string          `json:"blockHash"`
+	Address   json.RawMessage `json:"address"`
+	Topics    []interface{}   `json:"topics"`
+}
+
+// rpcLogFilter filter of eth_getLogs, range beyond the latest indexed block is not indexed
+func rpcLogFilter(r *http.Request, raw json.RawMessage) (*dao.LogFilter, error) {
+	p := new(rpcLogFilterParams)
+	if err := json.Unmarshal(raw, p); err != nil {
+		return nil, invalidParams("invalid filter")
+	}
+	filter := new(dao.LogFilter)
+	if p.BlockHash != "" {
+		if p.FromBlock != nil || p.ToBlock != nil {
+			return nil, invalidParams("cannot specify both blockHash and fromBlock/toBlock")
+		}
+		block := srv.RPC_GetBlock(r.Context(), nil, strings.ToLower(p.BlockHash), false)
+		if block == nil {
+			return nil, errNotIndexed
+		}
+		num, _ := strconv.ParseUint(util.TrimHex(block.Number), 16, 64)
+		filter.FromBlock, filter.ToBlock = num, num
+	} else {
+		latest := srv.RPC_LatestBlockNum(r.Context())
+		filter.FromBlock, filter.ToBlock = latest, latest
+		var err error
+		if p.FromBlock != nil {
+			if filter.FromBlock, err = rpcBlockNumber(r, p.FromBlock); err != nil {
+				return nil, err
+			}
+		}
+		if p.ToBlock != nil {
+			if filter.ToBlock, err = rpcBlockNumber(r, p.ToBlock); err != nil {
+				return nil, err
+			}
+		}
+		if filter.ToBlock > latest {
+			return nil, errNotIndexed
+		}
+		if filter.FromBlock > filter.ToBlock {
+			return nil, invalidParams("fromBlock is greater than toBlock")
+		}
+		if filter.ToBlock-filter.FromBlock >= dao.RpcLogsMaxRange {
+			return nil, fmt.Errorf("%w, max %d blocks", dao.ErrRpcLogsRange, dao.RpcLogsMaxRange)
+		}
+	}
+
+	addresses, err := parseRpcFilterAddress(p.Address)
+	if err != nil {
+		return nil, err
+	}
+	filter.Addresses = addresses
+	if filter.Topics, err = parseRpcFilterTopics(p.Topics); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// parseRpcFilterAddress address of filter, single address or list of addresses
+func parseRpcFilterAddress(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+	var addresses []string
+	if err := json.Unmarshal(raw, &addresses); err != nil {
+		var address string
+		if err = json.Unmarshal(raw, &address); err != nil {
+			return nil, invalidParams("invalid address")
+		}
+		addresses = []string{address}
+	}
+	for i, address := range addresses {
+		if !isHexBytes(address, 20) {
+			return nil, invalidParams("invalid address %s", address)
+		}
+		addresses[i] = strings.ToLower(address)
+	}
+	return addresses, nil
+}
+
+// parseRpcFilterTopics topics by position, null matches any topic and list matches any of topics
+func parseRpcFilterTopics(topics []interface{}) ([][]string, error) {
+	if len(topics) > 4 {
+		return nil, invalidParams("too many topics")
+	}
+	var (
+		list       = make([][]string, len(topics))
+		checkTopic = func(v interface{}) (string, error) {
+			topic, ok := v.(string)
+			if !ok || !isHexBytes(topic, 32) {
+				return "", invalidParams("invalid topic %v", v)
+			}
+			return strings.ToLower(topic), nil
+		}
+	)
+	for i, t := range topics {
+		switch v := t.(type) {
+		case nil:
+		case string:
+			topic, err := checkTopic(v)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = []string{topic}
+		case []interface{}:
+			var wildcard bool
+			for _, item := range v {
+				if item == nil {
+					wildcard = true
+					continue
+				}
+				topic, err := checkTopic(item)
+				if err != nil {
+					return nil, err
+				}
+				list[i] = append(list[i], topic)
+			}
+			if wildcard {
+				list[i] = nil
+			}
+		default:
+			return nil, invalidParams("invalid topic %v", t)
+		}
+	}
+	return list, nil
+}
+
+// isHexBytes s is 0x prefixed hex of n bytes
+func isHexBytes(s string, n int) bool {
+	if len(s) != 2+n*2 || !strings.HasPrefix(s, "0x") {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
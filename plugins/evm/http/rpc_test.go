@@ -0,0 +1,114 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/itering/subscan/plugins/evm/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRpcFilterTopics(t *testing.T) {
+	topic := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	other := "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+
+	topics, err := parseRpcFilterTopics([]interface{}{topic, nil, []interface{}{topic, other}, []interface{}{other, nil}})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{topic}, nil, {topic, other}, nil}, topics)
+
+	_, err = parseRpcFilterTopics([]interface{}{topic[2:]})
+	assert.Error(t, err)
+	_, err = parseRpcFilterTopics([]interface{}{nil, nil, nil, nil, nil})
+	assert.Error(t, err)
+	_, err = parseRpcFilterTopics([]interface{}{1})
+	assert.Error(t, err)
+}
+
+func TestParseRpcFilterAddress(t *testing.T) {
+	address := "0x1F98431c8aD98523631AE4a59f267346ea31F984"
+	list, err := parseRpcFilterAddress(json.RawMessage(`"` + address + `"`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{strings.ToLower(address)}, list)
+
+	list, err = parseRpcFilterAddress(json.RawMessage(`["` + address + `"]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{strings.ToLower(address)}, list)
+
+	list, err = parseRpcFilterAddress(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, list)
+
+	_, err = parseRpcFilterAddress(json.RawMessage(`"0x1234"`))
+	assert.Error(t, err)
+}
+
+func TestRpcLogFilterRange(t *testing.T) {
+	maxRange := dao.RpcLogsMaxRange
+	dao.RpcLogsMaxRange = 10
+	defer func() { dao.RpcLogsMaxRange = maxRange }()
+
+	req := httptest.NewRequest(http.MethodPost, "/rpc", nil)
+	filter, err := rpcLogFilter(req, json.RawMessage(`{"fromBlock":"0x1","toBlock":"0xa"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), filter.FromBlock)
+	assert.Equal(t, uint64(10), filter.ToBlock)
+
+	_, err = rpcLogFilter(req, json.RawMessage(`{"fromBlock":"0x1","toBlock":"0xb"}`))
+	assert.ErrorIs(t, err, dao.ErrRpcLogsRange)
+}
+
+func TestRpcHandle(t *testing.T) {
+	blockHash := "0x6b5e2a4b7a2e4f0a8f8a2c5b3a5f1f1e9f0e7c6b5a4d3c2b1a09f8e7d6c5b4a3"
+	tests := []struct {
+		name     string
+		body     string
+		wantBody string
+	}{
+		{
+			name:     "logs of block hash",
+			body:     `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"blockHash":"` + blockHash + `"}]}`,
+			wantBody: `{"jsonrpc":"2.0","id":1,"result":[]}`,
+		},
+		{
+			name:     "logs beyond indexed block",
+			body:     `{"jsonrpc":"2.0","id":2,"method":"eth_getLogs","params":[{"fromBlock":"0x1","toBlock":"0x1000"}]}`,
+			wantBody: `{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"method not found","data":null}}`,
+		},
+		{
+			name:     "invalid range",
+			body:     `{"jsonrpc":"2.0","id":3,"method":"eth_getLogs","params":[{"fromBlock":"0x10","toBlock":"0x1"}]}`,
+			wantBody: `"code":-32602`,
+		},
+		{
+			name:     "unsupported method proxied",
+			body:     `{"jsonrpc":"2.0","id":"a","method":"eth_call","params":[]}`,
+			wantBody: `{"jsonrpc":"2.0","id":"a","error":{"code":-32601,"message":"method not found","data":null}}`,
+		},
+		{
+			name:     "batch",
+			body:     `[{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{}]},{"jsonrpc":"2.0","id":2,"method":"eth_getTransactionByHash","params":["0x12"]}]`,
+			wantBody: `[{"jsonrpc":"2.0","id":1,"result":[]},{"jsonrpc":"2.0","id":2,"error":{"code":-32602,"message":"invalid params: invalid transaction hash","data":null}}]`,
+		},
+		{
+			name:     "batch limit",
+			body:     "[" + strings.TrimSuffix(strings.Repeat(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},`, 51), ",") + "]",
+			wantBody: `{"jsonrpc":"2.0","id":null,"error":{"code":-32005,"message":"batch limit 50 exceeded","data":null}}`,
+		},
+		{
+			name:     "parse error",
+			body:     `[{`,
+			wantBody: `"code":-32700`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/rpc", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			_ = rpcHandle(rr, req)
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package workers
 
 import (
 	"context"
+	"fmt"
 	"github.com/itering/subscan/plugins/evm/dao"
 	"github.com/itering/subscan/util"
 )
@@ -28,6 +29,18 @@ func Emit(ctx context.Context, queue, class string, raw interface{}) error {
 			if token := dao.GetTokenByContract(ctx, args[0]); token != nil {
 				return token.RefreshErc721Holders(ctx, args[1])
 			}
+
+		case "metadata":
+			// [contract, tokenId], failed fetch is retried by backoff of dao.RetryNftMetadata
+			var args []string
+			if err := util.UnmarshalAny(&args, raw); err != nil {
+				return err
+			}
+			if len(args) != 2 {
+				return fmt.Errorf("invalid metadata args %v", args)
+			}
+			_, err := dao.FetchNftMetadata(ctx, args[0], args[1])
+			return err
 		}
 
 		// case dao.Eip1155Token:
@@ -0,0 +1,275 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/itering/subscan-plugin/storage"
+	pb "github.com/itering/subscan/api/plugin/v1"
+	"github.com/itering/subscan/util"
+	"github.com/itering/substrate-api-rpc/websocket"
+	"google.golang.org/grpc/metadata"
+)
+
+// remoteDao storage.Dao of plugin process, every call is mediated by Host service of subscan
+type remoteDao struct {
+	host pb.HostClient
+	rpc  *rpcConn
+	// delivery token of the block delivery served by the dao, empty if dao is not of a delivery
+	delivery string
+}
+
+// rpcConn substrate connection of plugin process, shared by dao of plugin and dao of deliveries
+type rpcConn struct {
+	mu   sync.Mutex
+	conn *websocket.PoolConn
+}
+
+func newRemoteDao(host pb.HostClient) *remoteDao {
+	return &remoteDao{host: host, rpc: &rpcConn{}}
+}
+
+// forDelivery dao of block delivery, storage calls are served in the delivery transaction of subscan
+func (d *remoteDao) forDelivery(token string) *remoteDao {
+	return &remoteDao{host: d.host, rpc: d.rpc, delivery: token}
+}
+
+// with delivery token of dao is passed to host
+func (d *remoteDao) with(c context.Context) context.Context {
+	if d.delivery == "" {
+		return c
+	}
+	return metadata.AppendToOutgoingContext(c, deliveryMetadataKey, d.delivery)
+}
+
+func (d *remoteDao) ctx() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(d.with(context.Background()), callTimeout)
+}
+
+func (d *remoteDao) table(model interface{}) (string, error) {
+	s, err := parseSchema(model)
+	if err != nil {
+		return "", err
+	}
+	return s.Table, nil
+}
+
+// GetDbInstance db is not reachable from plugin process
+func (d *remoteDao) GetDbInstance() any {
+	return nil
+}
+
+func (d *remoteDao) FindBy(record interface{}, query interface{}, option *storage.Option) (int, bool) {
+	table, err := d.table(record)
+	if err != nil {
+		util.Logger().Error(err)
+		return 0, true
+	}
+	q, err := toQuery(query)
+	if err != nil {
+		util.Logger().Error(err)
+		return 0, true
+	}
+	req := &pb.FindByRequest{Table: table, Query: q}
+	if option != nil {
+		req.Option = &pb.Option{PageSize: int32(option.PageSize), Page: int32(option.Page), Order: option.Order}
+	}
+	ctx, cancel := d.ctx()
+	defer cancel()
+	reply, err := d.host.FindBy(ctx, req)
+	if err != nil {
+		util.Logger().Error(remoteError(err))
+		return 0, true
+	}
+	if err = scanRows(record, reply.Rows); err != nil {
+		util.Logger().Error(err)
+		return int(reply.Count), true
+	}
+	return int(reply.Count), false
+}
+
+func (d *remoteDao) AutoMigration(model interface{}) error {
+	s, err := parseSchema(model)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := d.ctx()
+	defer cancel()
+	_, err = d.host.AutoMigrate(ctx, &pb.AutoMigrateRequest{Table: s.Table, Columns: modelColumns(s)})
+	return remoteError(err)
+}
+
+func (d *remoteDao) addIndex(model interface{}, indexName string, unique bool, columns ...string) error {
+	table, err := d.table(model)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := d.ctx()
+	defer cancel()
+	_, err = d.host.AddIndex(ctx, &pb.AddIndexRequest{Table: table, Name: indexName, Columns: columns, Unique: unique})
+	return remoteError(err)
+}
+
+func (d *remoteDao) AddIndex(model interface{}, indexName string, columns ...string) error {
+	return d.addIndex(model, indexName, false, columns...)
+}
+
+func (d *remoteDao) AddUniqueIndex(model interface{}, indexName string, columns ...string) error {
+	return d.addIndex(model, indexName, true, columns...)
+}
+
+func (d *remoteDao) Create(record interface{}) error {
+	s, rows, err := structRows(record)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := d.ctx()
+	defer cancel()
+	_, err = d.host.Create(ctx, &pb.CreateRequest{Table: s.Table, Rows: rows})
+	return remoteError(err)
+}
+
+func (d *remoteDao) Update(model interface{}, query interface{}, attr map[string]interface{}) error {
+	table, err := d.table(model)
+	if err != nil {
+		return err
+	}
+	q, err := toQuery(query)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := d.ctx()
+	defer cancel()
+	_, err = d.host.Update(ctx, &pb.UpdateRequest{Table: table, Query: q, Attrs: toRow(attr)})
+	return remoteError(err)
+}
+
+func (d *remoteDao) Delete(model interface{}, query interface{}) error {
+	table, err := d.table(model)
+	if err != nil {
+		return err
+	}
+	q, err := toQuery(query)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := d.ctx()
+	defer cancel()
+	_, err = d.host.Delete(ctx, &pb.DeleteRequest{Table: table, Query: q})
+	return remoteError(err)
+}
+
+func (d *remoteDao) GetBlocksByNums(c context.Context, blockNums []uint, columns string) (blocks []*storage.Block) {
+	req := &pb.GetBlocksByNumsRequest{Columns: columns}
+	for _, num := range blockNums {
+		req.BlockNums = append(req.BlockNums, uint64(num))
+	}
+	reply, err := d.host.GetBlocksByNums(d.with(c), req)
+	if err != nil {
+		util.Logger().Error(remoteError(err))
+		return nil
+	}
+	for _, block := range reply.Blocks {
+		blocks = append(blocks, fromBlock(block))
+	}
+	return
+}
+
+func (d *remoteDao) GetCurrentBlockNum(c context.Context) (uint64, error) {
+	reply, err := d.host.GetCurrentBlockNum(d.with(c), &pb.Empty{})
+	if err != nil {
+		return 0, remoteError(err)
+	}
+	return reply.BlockNum, nil
+}
+
+func (d *remoteDao) SpecialMetadata(spec int) string {
+	ctx, cancel := d.ctx()
+	defer cancel()
+	reply, err := d.host.SpecialMetadata(ctx, &pb.SpecialMetadataRequest{SpecVersion: int64(spec)})
+	if err != nil {
+		util.Logger().Error(remoteError(err))
+		return ""
+	}
+	return reply.Raw
+}
+
+// RPCPool plugin process connect to the substrate endpoint of subscan, connection is reused once connected
+func (d *remoteDao) RPCPool() *websocket.PoolConn {
+	d.rpc.mu.Lock()
+	defer d.rpc.mu.Unlock()
+	if d.rpc.conn == nil {
+		conn, err := websocket.Init()
+		if err != nil {
+			util.Logger().Error(fmt.Errorf("connect substrate endpoint failed: %v", err))
+			return nil
+		}
+		d.rpc.conn = conn
+	}
+	return d.rpc.conn
+}
+
+// SetPrefix tables of external plugin are always prefixed with plugin name by subscan
+func (d *remoteDao) SetPrefix(string) {}
+
+// remoteRedis subscan_plugin.RedisPool of plugin process, mediated by Host service of subscan
+type remoteRedis struct {
+	host pb.HostClient
+}
+
+func (r *remoteRedis) call(c context.Context, req *pb.RedisRequest) (*pb.RedisReply, error) {
+	reply, err := r.host.Redis(c, req)
+	if err != nil {
+		return nil, remoteError(err)
+	}
+	return reply, nil
+}
+
+func (r *remoteRedis) HMGet(c context.Context, key string, field ...string) map[string]string {
+	reply, err := r.call(c, &pb.RedisRequest{Command: "hmget", Key: key, Fields: field})
+	if err != nil {
+		return nil
+	}
+	return reply.Fields
+}
+
+func (r *remoteRedis) GetCacheTtl(c context.Context, key string) int {
+	reply, err := r.call(c, &pb.RedisRequest{Command: "ttl", Key: key})
+	if err != nil {
+		return 0
+	}
+	return int(reply.Int)
+}
+
+func (r *remoteRedis) HmSetEx(c context.Context, key string, value interface{}, ttl int) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("hmsetex value: %w", err)
+	}
+	_, err = r.call(c, &pb.RedisRequest{Command: "hmsetex", Key: key, Value: raw, Ttl: int64(ttl)})
+	return err
+}
+
+func (r *remoteRedis) SISMEMBER(c context.Context, key string, value interface{}) bool {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	reply, err := r.call(c, &pb.RedisRequest{Command: "sismember", Key: key, Value: raw})
+	return err == nil && reply.Ok
+}
+
+func (r *remoteRedis) SAdd(c context.Context, key string, ttl int, value ...string) bool {
+	reply, err := r.call(c, &pb.RedisRequest{Command: "sadd", Key: key, Fields: value, Ttl: int64(ttl)})
+	return err == nil && reply.Ok
+}
+
+func (r *remoteRedis) HINCRBY(c context.Context, key string, field string, value int) (int, error) {
+	reply, err := r.call(c, &pb.RedisRequest{Command: "hincrby", Key: key, Fields: []string{field}, Incr: int64(value)})
+	if err != nil {
+		return 0, err
+	}
+	return int(reply.Int), nil
+}
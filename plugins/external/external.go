@@ -0,0 +1,486 @@
+// Package external run plugins out of process, subscan start plugin binaries found in plugin directory
+// and talk to them over grpc (api/plugin/v1), like hashicorp go-plugin.
+//
+// Handshake: subscan start binary with env SUBSCAN_PLUGIN_MAGIC_COOKIE, plugin listen on loopback and print
+// "{core protocol version}|{plugin protocol version}|tcp|{addr}|grpc" to stdout, then subscan dial addr.
+// Plugin get a mediated storage.Dao and redis pool through Host service served by subscan.
+package external
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/router"
+	"github.com/itering/subscan-plugin/storage"
+	pb "github.com/itering/subscan/api/plugin/v1"
+	"github.com/itering/subscan/util"
+	"github.com/shopspring/decimal"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// CoreProtocolVersion version of handshake
+	CoreProtocolVersion = 1
+	// ProtocolVersion version of api/plugin/v1, plugin with other version is rejected
+	ProtocolVersion = 1
+
+	MagicCookieKey   = "SUBSCAN_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "0f0d3c7a6e1f4b5c9a2d8e7b1c6f3a90"
+
+	// BinaryPrefix plugin binaries in plugin directory are named subscan-plugin-{name}
+	BinaryPrefix = "subscan-plugin-"
+)
+
+var (
+	startTimeout = 10 * time.Second
+	callTimeout  = time.Minute
+
+	mu      sync.Mutex
+	running []*Plugin
+)
+
+// Plugin client of external plugin process, implement subscan_plugin.Plugin
+type Plugin struct {
+	name   string
+	path   string
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+	client pb.PluginClient
+	health healthpb.HealthClient
+	info   *pb.InfoReply
+	exited chan struct{}
+	closed atomic.Bool
+
+	host       *hostServer
+	hostServer *grpc.Server
+}
+
+// Discover start plugin binaries of dir, plugin failed to start is skipped with error logged
+func Discover(dir string) ([]*Plugin, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, BinaryPrefix+"*"))
+	if err != nil {
+		return nil, err
+	}
+	var list []*Plugin
+	for _, path := range paths {
+		if stat, err := os.Stat(path); err != nil || stat.IsDir() || stat.Mode()&0111 == 0 {
+			continue
+		}
+		p, err := Start(path)
+		if err != nil {
+			util.Logger().Error(fmt.Errorf("start external plugin %s failed: %v", path, err))
+			continue
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+// Start plugin binary and finish handshake
+func Start(path string) (*Plugin, error) {
+	p := &Plugin{
+		name:   strings.ToLower(strings.TrimPrefix(filepath.Base(path), BinaryPrefix)),
+		path:   path,
+		exited: make(chan struct{}),
+	}
+	p.cmd = exec.Command(path)
+	p.cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", MagicCookieKey, MagicCookieValue))
+	p.cmd.Stderr = &logWriter{name: p.name}
+	stdout, err := p.cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.cmd.Start(); err != nil {
+		return nil, err
+	}
+	go func() {
+		err := p.cmd.Wait()
+		close(p.exited)
+		// plugin process is not restarted by subscan, operator has to restart subscan
+		if !p.closed.Load() {
+			util.Logger().Error(fmt.Errorf("external plugin %s exited unexpectedly: %v, restart subscan to start it again", p.name, err))
+		}
+	}()
+
+	addr, err := p.handshake(stdout)
+	if err != nil {
+		p.kill()
+		return nil, err
+	}
+	if p.conn, err = grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+		p.kill()
+		return nil, err
+	}
+	p.client = pb.NewPluginClient(p.conn)
+	p.health = healthpb.NewHealthClient(p.conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), startTimeout)
+	defer cancel()
+	if p.info, err = p.client.Info(ctx, &pb.InfoRequest{}); err != nil {
+		p.kill()
+		return nil, remoteError(err)
+	}
+	mu.Lock()
+	running = append(running, p)
+	mu.Unlock()
+	return p, nil
+}
+
+// handshake read address of plugin from first line of stdout, rest of stdout is logged
+func (p *Plugin) handshake(stdout io.Reader) (string, error) {
+	lines := make(chan string, 1)
+	reader := bufio.NewReader(stdout)
+	go func() {
+		line, _ := reader.ReadString('\n')
+		lines <- strings.TrimSpace(line)
+		_, _ = io.Copy(&logWriter{name: p.name}, reader)
+	}()
+	var line string
+	select {
+	case line = <-lines:
+	case <-p.exited:
+		return "", errors.New("plugin exited before handshake")
+	case <-time.After(startTimeout):
+		return "", errors.New("plugin handshake timeout")
+	}
+	parts := strings.Split(line, "|")
+	if len(parts) != 5 {
+		return "", fmt.Errorf("invalid handshake %q, binary is not a subscan plugin", line)
+	}
+	if core, _ := strconv.Atoi(parts[0]); core != CoreProtocolVersion {
+		return "", fmt.Errorf("core protocol version %s not supported, want %d", parts[0], CoreProtocolVersion)
+	}
+	if version, _ := strconv.Atoi(parts[1]); version != ProtocolVersion {
+		return "", fmt.Errorf("plugin protocol version %s not supported, want %d", parts[1], ProtocolVersion)
+	}
+	if parts[2] != "tcp" || parts[4] != "grpc" {
+		return "", fmt.Errorf("plugin network %s protocol %s not supported", parts[2], parts[4])
+	}
+	return parts[3], nil
+}
+
+func (p *Plugin) kill() {
+	p.closed.Store(true)
+	if p.cmd.Process != nil {
+		_ = p.cmd.Process.Kill()
+	}
+}
+
+// Close stop host server, plugin process and connection
+func (p *Plugin) Close() {
+	p.closed.Store(true)
+	mu.Lock()
+	for i, r := range running {
+		if r == p {
+			running = append(running[:i], running[i+1:]...)
+			break
+		}
+	}
+	mu.Unlock()
+	if p.hostServer != nil {
+		p.hostServer.Stop()
+	}
+	if p.conn != nil {
+		_ = p.conn.Close()
+	}
+	if p.cmd.Process != nil {
+		_ = p.cmd.Process.Signal(os.Interrupt)
+		select {
+		case <-p.exited:
+		case <-time.After(5 * time.Second):
+			p.kill()
+		}
+	}
+}
+
+// Close stop all running external plugins
+func Close() {
+	mu.Lock()
+	list := running
+	running = nil
+	mu.Unlock()
+	for _, p := range list {
+		p.Close()
+	}
+}
+
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// Health plugin process is running and grpc health service is serving
+func (p *Plugin) Health(ctx context.Context) error {
+	select {
+	case <-p.exited:
+		return fmt.Errorf("plugin process exited: %v", p.cmd.ProcessState)
+	default:
+	}
+	resp, err := p.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return remoteError(err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("plugin status %s", resp.Status)
+	}
+	return nil
+}
+
+// InitDao serve host of plugin with storage and init plugin with host address
+func (p *Plugin) InitDao(d storage.Dao) {
+	if err := p.initHost(d); err != nil {
+		util.Logger().Error(fmt.Errorf("init external plugin %s failed: %v", p.name, err))
+	}
+}
+
+func (p *Plugin) initHost(d storage.Dao) error {
+	if p.hostServer != nil {
+		p.hostServer.Stop()
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	token := randomToken()
+	p.host = &hostServer{name: p.name, dao: d}
+	p.hostServer = grpc.NewServer(grpc.UnaryInterceptor(tokenInterceptor(token)))
+	pb.RegisterHostServer(p.hostServer, p.host)
+	go func() { _ = p.hostServer.Serve(lis) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	_, err = p.client.Init(ctx, &pb.InitRequest{
+		HostAddr:   lis.Addr().String(),
+		HostToken:  token,
+		WsEndpoint: util.WSEndPoint,
+		Network:    util.NetworkNode,
+	})
+	return remoteError(err)
+}
+
+func (p *Plugin) SetRedisPool(pool subscan_plugin.RedisPool) {
+	if p.host != nil {
+		p.host.setRedis(pool)
+	}
+}
+
+func (p *Plugin) InitHttp() []router.Http {
+	var routes []router.Http
+	for index, route := range p.info.Routes {
+		index := int32(index)
+		routes = append(routes, router.Http{
+			Router: route.Router,
+			Method: route.Method,
+			Handle: func(w http.ResponseWriter, r *http.Request) error {
+				return p.serveHttp(index, w, r)
+			},
+		})
+	}
+	return routes
+}
+
+func (p *Plugin) serveHttp(route int32, w http.ResponseWriter, r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	req := &pb.HttpRequest{Route: route, Method: r.Method, Url: r.URL.String(), Header: make(map[string]*pb.Header), Body: body}
+	for key, values := range r.Header {
+		req.Header[key] = &pb.Header{Values: values}
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), callTimeout)
+	defer cancel()
+	reply, err := p.client.ServeHttp(ctx, req)
+	if err != nil {
+		http.Error(w, remoteError(err).Error(), http.StatusBadGateway)
+		return remoteError(err)
+	}
+	for key, header := range reply.Header {
+		for _, value := range header.Values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(int(reply.Status))
+	_, err = w.Write(reply.Body)
+	return err
+}
+
+func (p *Plugin) ProcessExtrinsic(block *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) error {
+	return p.processExtrinsic(context.Background(), block, extrinsic, events)
+}
+
+func (p *Plugin) processExtrinsic(ctx context.Context, block *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) error {
+	req := &pb.ProcessExtrinsicRequest{Block: toBlock(block), Extrinsic: toExtrinsic(extrinsic)}
+	for i := range events {
+		req.Events = append(req.Events, toEvent(&events[i]))
+	}
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	_, err := p.client.ProcessExtrinsic(ctx, req)
+	return remoteError(err)
+}
+
+func (p *Plugin) ProcessEvent(block *storage.Block, event *storage.Event, fee decimal.Decimal) error {
+	return p.processEvent(context.Background(), block, event, fee)
+}
+
+func (p *Plugin) processEvent(ctx context.Context, block *storage.Block, event *storage.Event, fee decimal.Decimal) error {
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	_, err := p.client.ProcessEvent(ctx, &pb.ProcessEventRequest{Block: toBlock(block), Event: toEvent(event), Fee: fee.String()})
+	return remoteError(err)
+}
+
+// DeliverTx storage calls of the delivery are served with d, so writes of plugin are committed with checkpoint.
+// Delivery calls carry a token bound to d, plugin process pass it to host through DeliverTx of the plugin,
+// other calls of plugin (http routes, workers) are not served in the transaction
+func (p *Plugin) DeliverTx(d storage.Dao, deliver func(subscan_plugin.Plugin) error) error {
+	if p.host == nil {
+		return deliver(p)
+	}
+	token := randomToken()
+	p.host.bind(token, d)
+	defer p.host.unbind(token)
+	return deliver(&deliveryPlugin{Plugin: p, token: token})
+}
+
+// deliveryPlugin plugin of one delivery, process calls carry the delivery token
+type deliveryPlugin struct {
+	*Plugin
+	token string
+}
+
+func (p *deliveryPlugin) ctx(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, deliveryMetadataKey, p.token)
+}
+
+func (p *deliveryPlugin) ProcessExtrinsic(block *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) error {
+	return p.processExtrinsic(p.ctx(context.Background()), block, extrinsic, events)
+}
+
+func (p *deliveryPlugin) ProcessEvent(block *storage.Block, event *storage.Event, fee decimal.Decimal) error {
+	return p.processEvent(p.ctx(context.Background()), block, event, fee)
+}
+
+func (p *deliveryPlugin) ProcessBlock(ctx context.Context, block *storage.Block) error {
+	return p.Plugin.ProcessBlock(p.ctx(ctx), block)
+}
+
+// Rollback rows of plugin tables from block num are deleted by host
+func (p *Plugin) Rollback(ctx context.Context, from uint) error {
+	if p.host == nil {
+		return fmt.Errorf("external plugin %s not initialized", p.name)
+	}
+	return p.host.rollback(ctx, from)
+}
+
+func (p *Plugin) ProcessBlock(ctx context.Context, block *storage.Block) error {
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	_, err := p.client.ProcessBlock(ctx, &pb.ProcessBlockRequest{Block: toBlock(block)})
+	return remoteError(err)
+}
+
+func (p *Plugin) Migrate() {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	if _, err := p.client.Migrate(ctx, &pb.Empty{}); err != nil {
+		util.Logger().Error(fmt.Errorf("migrate external plugin %s failed: %v", p.name, remoteError(err)))
+	}
+}
+
+func (p *Plugin) SubscribeExtrinsic() []string {
+	return p.info.SubscribeExtrinsic
+}
+
+func (p *Plugin) SubscribeEvent() []string {
+	return p.info.SubscribeEvent
+}
+
+func (p *Plugin) Version() string {
+	return p.info.Version
+}
+
+func (p *Plugin) Enable() bool {
+	return p.info.Enable
+}
+
+func (p *Plugin) ConsumptionQueue() []string {
+	return p.info.ConsumptionQueue
+}
+
+func (p *Plugin) ExecWorker(ctx context.Context, queue, class string, raw interface{}) error {
+	if !util.StringInSlice(queue, p.info.ConsumptionQueue) {
+		return nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	_, err = p.client.ExecWorker(ctx, &pb.ExecWorkerRequest{Queue: queue, Class: class, Raw: data})
+	return remoteError(err)
+}
+
+// Commands run in plugin process, flags and args are passed through
+func (p *Plugin) Commands() []cli.Command {
+	var commands []cli.Command
+	for _, command := range p.info.Commands {
+		commands = append(commands, cli.Command{
+			Name:            command.Name,
+			Usage:           command.Usage,
+			SkipFlagParsing: true,
+			Action: func(c *cli.Context) error {
+				reply, err := p.client.RunCommand(context.Background(), &pb.RunCommandRequest{Args: append([]string{c.Command.Name}, c.Args()...)})
+				if err != nil {
+					return remoteError(err)
+				}
+				_, err = os.Stdout.Write(reply.Output)
+				return err
+			},
+		})
+	}
+	return commands
+}
+
+func randomToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// logWriter log output of plugin process line by line
+type logWriter struct {
+	name string
+}
+
+func (l *logWriter) Write(b []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimRight(string(b), "\n"), "\n") {
+		util.Logger().Info(fmt.Sprintf("[plugin %s] %s", l.name, line))
+	}
+	return len(b), nil
+}
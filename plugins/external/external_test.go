@@ -0,0 +1,343 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/router"
+	"github.com/itering/subscan-plugin/storage"
+	pb "github.com/itering/subscan/api/plugin/v1"
+	"github.com/itering/substrate-api-rpc/websocket"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+const helperEnv = "SUBSCAN_EXTERNAL_PLUGIN_HELPER"
+
+func TestMain(m *testing.M) {
+	// test binary run as plugin process
+	if os.Getenv(helperEnv) == "1" {
+		Serve("record", &recordPlugin{})
+		return
+	}
+	os.Exit(m.Run())
+}
+
+type Transfer struct {
+	ID       uint            `gorm:"primaryKey;autoIncrement"`
+	BlockNum int             `gorm:"index"`
+	Amount   decimal.Decimal `gorm:"type:decimal(65,0)"`
+	Memo     []byte
+}
+
+// recordPlugin plugin served by helper process
+type recordPlugin struct {
+	dao   storage.Dao
+	redis subscan_plugin.RedisPool
+}
+
+func (p *recordPlugin) InitDao(d storage.Dao) { p.dao = d }
+
+func (p *recordPlugin) InitHttp() []router.Http {
+	return []router.Http{{Router: "/transfers", Method: http.MethodGet, Handle: func(w http.ResponseWriter, r *http.Request) error {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		return json.NewEncoder(w).Encode(map[string]string{"q": r.URL.Query().Get("q")})
+	}}}
+}
+
+func (p *recordPlugin) ProcessExtrinsic(*storage.Block, *storage.Extrinsic, []storage.Event) error {
+	return nil
+}
+
+func (p *recordPlugin) ProcessEvent(*storage.Block, *storage.Event, decimal.Decimal) error {
+	return nil
+}
+
+func (p *recordPlugin) ProcessBlock(ctx context.Context, block *storage.Block) error {
+	if block.BlockNum == 0 {
+		return errors.New("empty block")
+	}
+	current, err := p.dao.GetCurrentBlockNum(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err = p.redis.HINCRBY(ctx, "blocks", "count", 1); err != nil {
+		return err
+	}
+	return p.dao.Create(&Transfer{BlockNum: int(current) + block.BlockNum, Amount: decimal.New(12, 18)})
+}
+
+// DeliverTx writes of delivery with the dao of delivery
+func (p *recordPlugin) DeliverTx(d storage.Dao, deliver func(subscan_plugin.Plugin) error) error {
+	return deliver(&recordPlugin{dao: d, redis: p.redis})
+}
+
+func (p *recordPlugin) Migrate() {}
+
+func (p *recordPlugin) SubscribeExtrinsic() []string { return []string{"balances"} }
+
+func (p *recordPlugin) SubscribeEvent() []string { return []string{"balances"} }
+
+func (p *recordPlugin) Version() string { return "1.2.0" }
+
+func (p *recordPlugin) SetRedisPool(pool subscan_plugin.RedisPool) { p.redis = pool }
+
+func (p *recordPlugin) Enable() bool { return true }
+
+func (p *recordPlugin) ConsumptionQueue() []string { return nil }
+
+func (p *recordPlugin) ExecWorker(context.Context, string, string, interface{}) error { return nil }
+
+func (p *recordPlugin) Commands() []cli.Command {
+	return []cli.Command{{Name: "hello", Usage: "say hello", Flags: []cli.Flag{cli.StringFlag{Name: "to"}}, Action: func(c *cli.Context) error {
+		_, err := fmt.Fprintf(c.App.Writer, "hello %s", c.String("to"))
+		return err
+	}}}
+}
+
+// fakeDao storage of host, sql built by dry run db is recorded
+type fakeDao struct {
+	db  *gorm.DB
+	mu  sync.Mutex
+	sql []string
+}
+
+func newFakeDao(t *testing.T) *fakeDao {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "root@tcp(127.0.0.1:3306)/subscan", SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+	d := &fakeDao{db: db}
+	_ = db.Callback().Create().After("gorm:create").Register("test:record", func(tx *gorm.DB) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.sql = append(d.sql, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	return d
+}
+
+func (d *fakeDao) GetDbInstance() any { return d.db }
+
+func (d *fakeDao) FindBy(interface{}, interface{}, *storage.Option) (int, bool) { return 0, false }
+
+func (d *fakeDao) AutoMigration(interface{}) error { return nil }
+
+func (d *fakeDao) AddIndex(interface{}, string, ...string) error { return nil }
+
+func (d *fakeDao) AddUniqueIndex(interface{}, string, ...string) error { return nil }
+
+func (d *fakeDao) Create(interface{}) error { return nil }
+
+func (d *fakeDao) Update(interface{}, interface{}, map[string]interface{}) error { return nil }
+
+func (d *fakeDao) Delete(interface{}, interface{}) error { return nil }
+
+func (d *fakeDao) GetBlocksByNums(context.Context, []uint, string) []*storage.Block { return nil }
+
+func (d *fakeDao) GetCurrentBlockNum(context.Context) (uint64, error) { return 100, nil }
+
+func (d *fakeDao) SpecialMetadata(int) string { return "0x00" }
+
+func (d *fakeDao) RPCPool() *websocket.PoolConn { return nil }
+
+func (d *fakeDao) SetPrefix(string) {}
+
+type fakeRedis struct {
+	subscan_plugin.RedisPool
+	mu   sync.Mutex
+	incr map[string]int
+}
+
+func (r *fakeRedis) HINCRBY(_ context.Context, key string, field string, value int) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.incr[key+"."+field] += value
+	return r.incr[key+"."+field], nil
+}
+
+func TestPlugin(t *testing.T) {
+	dir := t.TempDir()
+	script := fmt.Sprintf("#!/bin/sh\n%s=1 exec %q\n", helperEnv, os.Args[0])
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, BinaryPrefix+"record"), []byte(script), 0755))
+	// not executable, ignored
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, BinaryPrefix+"readme"), []byte("readme"), 0644))
+
+	list, err := Discover(dir)
+	assert.NoError(t, err)
+	if !assert.Len(t, list, 1) {
+		return
+	}
+	p := list[0]
+	defer Close()
+	assert.Equal(t, "record", p.Name())
+	assert.Equal(t, "1.2.0", p.Version())
+	assert.True(t, p.Enable())
+	assert.Equal(t, []string{"balances"}, p.SubscribeEvent())
+	assert.NoError(t, p.Health(context.TODO()))
+
+	d := newFakeDao(t)
+	r := &fakeRedis{incr: make(map[string]int)}
+	p.InitDao(d)
+	p.SetRedisPool(r)
+
+	// block delivered, storage and redis mediated by host
+	assert.NoError(t, p.ProcessBlock(context.TODO(), &storage.Block{BlockNum: 5}))
+	assert.EqualError(t, p.ProcessBlock(context.TODO(), &storage.Block{}), "empty block")
+	assert.Equal(t, 1, r.incr["blocks.count"])
+	if assert.Len(t, d.sql, 1) {
+		assert.Contains(t, d.sql[0], "INSERT IGNORE INTO `record_transfers`")
+		assert.Contains(t, d.sql[0], "105")
+		assert.Contains(t, d.sql[0], "12000000000000000000")
+	}
+
+	// block delivered in transaction, storage calls of delivery served with dao of transaction
+	tx := newFakeDao(t)
+	assert.NoError(t, p.DeliverTx(tx, func(p subscan_plugin.Plugin) error {
+		return p.ProcessBlock(context.TODO(), &storage.Block{BlockNum: 6})
+	}))
+	assert.Len(t, d.sql, 1)
+	if assert.Len(t, tx.sql, 1) {
+		assert.Contains(t, tx.sql[0], "106")
+	}
+	assert.Empty(t, p.host.txs)
+
+	// http
+	routes := p.InitHttp()
+	if assert.Len(t, routes, 1) {
+		assert.Equal(t, "/transfers", routes[0].Router)
+		w := httptest.NewRecorder()
+		assert.NoError(t, routes[0].Handle(w, httptest.NewRequest(http.MethodGet, "/api/plugin/record/transfers?q=alice", nil)))
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"q":"alice"}`, w.Body.String())
+	}
+
+	// cli
+	commands := p.Commands()
+	if assert.Len(t, commands, 1) {
+		assert.Equal(t, "hello", commands[0].Name)
+		reply, err := p.client.RunCommand(context.TODO(), &pb.RunCommandRequest{Args: []string{"hello", "--to", "subscan"}})
+		assert.NoError(t, err)
+		assert.Equal(t, "hello subscan", string(reply.Output))
+	}
+
+	p.Close()
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+	assert.Error(t, p.Health(ctx))
+}
+
+func TestHostCurrent(t *testing.T) {
+	d, tx := newFakeDao(t), newFakeDao(t)
+	h := &hostServer{name: "test", dao: d}
+	h.bind("token", tx)
+	assert.Equal(t, storage.Dao(tx), h.current(metadata.NewIncomingContext(context.TODO(), metadata.Pairs(deliveryMetadataKey, "token"))))
+	// calls out of delivery, or of a finished delivery
+	assert.Equal(t, storage.Dao(d), h.current(context.TODO()))
+	assert.Equal(t, storage.Dao(d), h.current(metadata.NewIncomingContext(context.TODO(), metadata.Pairs(deliveryMetadataKey, "other"))))
+	h.unbind("token")
+	assert.Equal(t, storage.Dao(d), h.current(metadata.NewIncomingContext(context.TODO(), metadata.Pairs(deliveryMetadataKey, "token"))))
+}
+
+func TestHandshake(t *testing.T) {
+	p := &Plugin{name: "test", exited: make(chan struct{})}
+	addr, err := p.handshake(strings.NewReader("1|1|tcp|127.0.0.1:1234|grpc\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:1234", addr)
+
+	_, err = p.handshake(strings.NewReader("1|2|tcp|127.0.0.1:1234|grpc\n"))
+	assert.EqualError(t, err, "plugin protocol version 2 not supported, want 1")
+	_, err = p.handshake(strings.NewReader("hello\n"))
+	assert.Error(t, err)
+}
+
+func TestRows(t *testing.T) {
+	_, rows, err := structRows([]Transfer{{BlockNum: 1, Amount: decimal.New(5, 0), Memo: []byte("a")}, {ID: 9, BlockNum: 2}})
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		// zero auto increment primary key omitted
+		assert.NotContains(t, rows[0].Columns, "id")
+		assert.Equal(t, "5", fromValue(rows[0].Columns["amount"]))
+		assert.Equal(t, uint64(9), fromValue(rows[1].Columns["id"]))
+	}
+
+	var list []*Transfer
+	assert.NoError(t, scanRows(&list, rows))
+	if assert.Len(t, list, 2) {
+		assert.Equal(t, 1, list[0].BlockNum)
+		assert.True(t, decimal.New(5, 0).Equal(list[0].Amount))
+		assert.Equal(t, []byte("a"), list[0].Memo)
+		assert.Equal(t, uint(9), list[1].ID)
+	}
+	var one Transfer
+	assert.NoError(t, scanRows(&one, rows[1:]))
+	assert.Equal(t, 2, one.BlockNum)
+	assert.Error(t, scanRows(one, rows))
+
+	assert.Equal(t, time.Unix(0, 100), fromValue(toValue(time.Unix(0, 100))))
+	assert.Nil(t, fromValue(toValue((*int)(nil))))
+}
+
+func TestQuery(t *testing.T) {
+	_, err := toQuery("block_num > 10")
+	assert.Error(t, err)
+
+	q, err := toQuery(map[string]interface{}{"block_num": 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), fromValue(q.Conditions.Columns["block_num"]))
+
+	q, err = toQuery(&Transfer{BlockNum: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"block_num": int64(3)}, fromRow(q.Conditions))
+
+	_, err = toQuery([]int{1})
+	assert.Error(t, err)
+}
+
+func TestParseOrder(t *testing.T) {
+	column, desc, err := parseOrder("block_num")
+	assert.NoError(t, err)
+	assert.Equal(t, "block_num", column)
+	assert.False(t, desc)
+
+	column, desc, err = parseOrder(" id DESC ")
+	assert.NoError(t, err)
+	assert.Equal(t, "id", column)
+	assert.True(t, desc)
+
+	for _, order := range []string{"", "id desc, block_num", "id; drop table t", "id sideways"} {
+		_, _, err = parseOrder(order)
+		assert.Error(t, err, order)
+	}
+}
+
+func TestColumnsModel(t *testing.T) {
+	s, err := parseSchema(&Transfer{})
+	assert.NoError(t, err)
+	value, err := columnsModel(modelColumns(s))
+	assert.NoError(t, err)
+	migrated, err := parseSchema(value)
+	assert.NoError(t, err)
+	for _, column := range []string{"id", "block_num", "amount", "memo"} {
+		assert.NotNil(t, migrated.LookUpField(column), column)
+	}
+	assert.True(t, migrated.LookUpField("id").PrimaryKey)
+	assert.Equal(t, "decimal(65,0)", string(migrated.LookUpField("amount").DataType))
+
+	_, err = columnsModel([]*pb.Column{{Name: "lower", DbName: "lower", DataType: "string"}})
+	assert.Error(t, err)
+}
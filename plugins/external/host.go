@@ -0,0 +1,389 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/storage"
+	pb "github.com/itering/subscan/api/plugin/v1"
+	"github.com/itering/subscan/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	tokenMetadataKey = "x-subscan-plugin-token"
+	// deliveryMetadataKey token of block delivery, storage calls with it are served in the delivery transaction
+	deliveryMetadataKey = "x-subscan-plugin-delivery"
+)
+
+// hostServer mediated storage.Dao and redis pool of external plugin, plugin never get db instance
+// tables of plugin are prefixed with plugin name, tables of other plugins or subscan are not reachable
+type hostServer struct {
+	pb.UnimplementedHostServer
+	name string
+	dao  storage.Dao
+
+	mu    sync.RWMutex
+	redis subscan_plugin.RedisPool
+	txs   map[string]storage.Dao
+}
+
+// bind storage calls with delivery token to d while a block is delivered in transaction
+func (h *hostServer) bind(token string, d storage.Dao) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.txs == nil {
+		h.txs = make(map[string]storage.Dao)
+	}
+	h.txs[token] = d
+}
+
+func (h *hostServer) unbind(token string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.txs, token)
+}
+
+// current storage of call, calls of delivery are served in its transaction,
+// other calls like http routes of plugin are served with storage of plugin
+func (h *hostServer) current(ctx context.Context) storage.Dao {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tokens := md.Get(deliveryMetadataKey); len(tokens) > 0 {
+		h.mu.RLock()
+		defer h.mu.RUnlock()
+		if tx, ok := h.txs[tokens[0]]; ok {
+			return tx
+		}
+	}
+	return h.dao
+}
+
+func (h *hostServer) db(ctx context.Context) (*gorm.DB, error) {
+	db, ok := h.current(ctx).GetDbInstance().(*gorm.DB)
+	if !ok || db == nil {
+		return nil, status.Error(codes.Unavailable, "db not available")
+	}
+	return db.WithContext(ctx), nil
+}
+
+// pluginTable table owned by plugin
+func (h *hostServer) pluginTable(table string) (string, error) {
+	if table == "" {
+		return "", status.Error(codes.InvalidArgument, "empty table")
+	}
+	return fmt.Sprintf("%s_%s", h.name, table), nil
+}
+
+// where column equal conditions, raw sql is not accepted from plugin
+func where(tx *gorm.DB, query *pb.Query) *gorm.DB {
+	if conditions := query.GetConditions(); len(conditions.GetColumns()) > 0 {
+		tx = tx.Where(fromRow(conditions))
+	}
+	return tx
+}
+
+// parseOrder order of "column", "column asc" or "column desc"
+func parseOrder(order string) (column string, desc bool, err error) {
+	fields := strings.Fields(order)
+	if len(fields) == 0 || len(fields) > 2 {
+		return "", false, fmt.Errorf("invalid order %q", order)
+	}
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return "", false, fmt.Errorf("invalid order direction %q", fields[1])
+		}
+	}
+	return fields[0], desc, nil
+}
+
+// orderBy order by column of table, column must exist in table
+func orderBy(db *gorm.DB, table, order string) (clause.OrderByColumn, error) {
+	column, desc, err := parseOrder(order)
+	if err != nil {
+		return clause.OrderByColumn{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !db.Migrator().HasColumn(table, column) {
+		return clause.OrderByColumn{}, status.Errorf(codes.InvalidArgument, "unknown order column %s", column)
+	}
+	return clause.OrderByColumn{Column: clause.Column{Name: column}, Desc: desc}, nil
+}
+
+func dbError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *hostServer) FindBy(ctx context.Context, req *pb.FindByRequest) (*pb.FindByReply, error) {
+	table, err := h.pluginTable(req.Table)
+	if err != nil {
+		return nil, err
+	}
+	db, err := h.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx := where(db.Table(table), req.Query)
+	if order := req.Option.GetOrder(); order != "" {
+		column, err := orderBy(db, table, order)
+		if err != nil {
+			return nil, err
+		}
+		tx = tx.Order(column)
+	}
+	var count int64
+	if err = tx.Count(&count).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if req.Option != nil {
+		// default page limit 1000, same as native plugin storage
+		pageSize := int(req.Option.PageSize)
+		if pageSize == 0 {
+			pageSize = 1000
+		}
+		tx = tx.Offset(int(req.Option.Page) * pageSize).Limit(pageSize)
+	}
+	var rows []map[string]interface{}
+	if err = tx.Find(&rows).Error; err != nil {
+		return nil, dbError(err)
+	}
+	reply := &pb.FindByReply{Count: count}
+	for _, row := range rows {
+		reply.Rows = append(reply.Rows, toRow(row))
+	}
+	return reply, nil
+}
+
+func (h *hostServer) AutoMigrate(ctx context.Context, req *pb.AutoMigrateRequest) (*pb.Empty, error) {
+	table, err := h.pluginTable(req.Table)
+	if err != nil {
+		return nil, err
+	}
+	db, err := h.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := columnsModel(req.Columns)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if db.Dialector.Name() == "mysql" {
+		db = db.Set("gorm:table_options", "ENGINE=InnoDB")
+	}
+	return &pb.Empty{}, dbError(db.Table(table).AutoMigrate(value))
+}
+
+func (h *hostServer) AddIndex(ctx context.Context, req *pb.AddIndexRequest) (*pb.Empty, error) {
+	table, err := h.pluginTable(req.Table)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" || len(req.Columns) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "index name and columns required")
+	}
+	db, err := h.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if db.Migrator().HasIndex(table, req.Name) {
+		return &pb.Empty{}, nil
+	}
+	var columns []interface{}
+	for _, column := range req.Columns {
+		columns = append(columns, clause.Column{Name: column})
+	}
+	sql := "CREATE INDEX ? ON ? (" + strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",") + ")"
+	if req.Unique {
+		sql = "CREATE UNIQUE INDEX ? ON ? (" + strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",") + ")"
+	}
+	args := append([]interface{}{clause.Column{Name: req.Name}, clause.Table{Name: table}}, columns...)
+	return &pb.Empty{}, dbError(db.Exec(sql, args...).Error)
+}
+
+func (h *hostServer) Create(ctx context.Context, req *pb.CreateRequest) (*pb.Empty, error) {
+	table, err := h.pluginTable(req.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Rows) == 0 {
+		return &pb.Empty{}, nil
+	}
+	db, err := h.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rows []map[string]interface{}
+	for _, row := range req.Rows {
+		rows = append(rows, fromRow(row))
+	}
+	tx := db.Table(table)
+	// columns of plugin table are unknown to host, mysql on duplicate key update need them
+	if db.Dialector.Name() == "mysql" {
+		tx = tx.Clauses(clause.Insert{Modifier: "IGNORE"})
+	} else {
+		tx = tx.Scopes(model.IgnoreDuplicate)
+	}
+	return &pb.Empty{}, dbError(tx.Create(rows).Error)
+}
+
+func (h *hostServer) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.Empty, error) {
+	table, err := h.pluginTable(req.Table)
+	if err != nil {
+		return nil, err
+	}
+	db, err := h.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, dbError(where(db.Table(table), req.Query).Updates(fromRow(req.Attrs)).Error)
+}
+
+func (h *hostServer) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.Empty, error) {
+	table, err := h.pluginTable(req.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Query.GetConditions().GetColumns()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "delete without conditions")
+	}
+	db, err := h.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, dbError(where(db.Table(table), req.Query).Delete(map[string]interface{}{}).Error)
+}
+
+// rollback delete rows from block num of every table of plugin, tables of plugin must have column block_num
+func (h *hostServer) rollback(ctx context.Context, from uint) error {
+	db, ok := h.dao.GetDbInstance().(*gorm.DB)
+	if !ok || db == nil {
+		return errors.New("db not available")
+	}
+	db = db.WithContext(ctx)
+	tables, err := db.Migrator().GetTables()
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if !strings.HasPrefix(table, h.name+"_") {
+			continue
+		}
+		if !db.Migrator().HasColumn(table, "block_num") {
+			return fmt.Errorf("table %s has no column block_num, can not be rolled back", table)
+		}
+		if err = db.Table(table).Where("block_num >= ?", from).Delete(map[string]interface{}{}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *hostServer) GetBlocksByNums(ctx context.Context, req *pb.GetBlocksByNumsRequest) (*pb.GetBlocksByNumsReply, error) {
+	var nums []uint
+	for _, num := range req.BlockNums {
+		nums = append(nums, uint(num))
+	}
+	reply := &pb.GetBlocksByNumsReply{}
+	for _, block := range h.current(ctx).GetBlocksByNums(ctx, nums, req.Columns) {
+		reply.Blocks = append(reply.Blocks, toBlock(block))
+	}
+	return reply, nil
+}
+
+func (h *hostServer) GetCurrentBlockNum(ctx context.Context, _ *pb.Empty) (*pb.GetCurrentBlockNumReply, error) {
+	num, err := h.current(ctx).GetCurrentBlockNum(ctx)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &pb.GetCurrentBlockNumReply{BlockNum: num}, nil
+}
+
+func (h *hostServer) SpecialMetadata(ctx context.Context, req *pb.SpecialMetadataRequest) (*pb.SpecialMetadataReply, error) {
+	return &pb.SpecialMetadataReply{Raw: h.current(ctx).SpecialMetadata(int(req.SpecVersion))}, nil
+}
+
+func (h *hostServer) setRedis(pool subscan_plugin.RedisPool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.redis = pool
+}
+
+func (h *hostServer) Redis(ctx context.Context, req *pb.RedisRequest) (*pb.RedisReply, error) {
+	h.mu.RLock()
+	pool := h.redis
+	h.mu.RUnlock()
+	if pool == nil {
+		return nil, status.Error(codes.Unavailable, "redis not available")
+	}
+	var value interface{}
+	if len(req.Value) > 0 {
+		if err := json.Unmarshal(req.Value, &value); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	reply := &pb.RedisReply{}
+	switch req.Command {
+	case "hmget":
+		reply.Fields = pool.HMGet(ctx, req.Key, req.Fields...)
+	case "ttl":
+		reply.Int = int64(pool.GetCacheTtl(ctx, req.Key))
+	case "hmsetex":
+		if err := pool.HmSetEx(ctx, req.Key, value, int(req.Ttl)); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reply.Ok = true
+	case "sismember":
+		reply.Ok = pool.SISMEMBER(ctx, req.Key, value)
+	case "sadd":
+		reply.Ok = pool.SAdd(ctx, req.Key, int(req.Ttl), req.Fields...)
+	case "hincrby":
+		if len(req.Fields) != 1 {
+			return nil, status.Error(codes.InvalidArgument, "hincrby need one field")
+		}
+		n, err := pool.HINCRBY(ctx, req.Key, req.Fields[0], int(req.Incr))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reply.Int = int64(n)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported redis command %s", req.Command)
+	}
+	return reply, nil
+}
+
+// tokenInterceptor reject calls without token of plugin, host listen on loopback but is reachable by other local processes
+func tokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get(tokenMetadataKey); len(values) != 1 || values[0] != token {
+			return nil, status.Error(codes.Unauthenticated, "invalid plugin token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// remoteError error of grpc call, message of status is returned as plain error
+func remoteError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if s, ok := status.FromError(err); ok {
+		return errors.New(s.Message())
+	}
+	return err
+}
@@ -0,0 +1,211 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/storage"
+	pb "github.com/itering/subscan/api/plugin/v1"
+	"github.com/itering/substrate-api-rpc/websocket"
+	"github.com/shopspring/decimal"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Serve serve plugin for subscan, called by main of plugin binary, block until subscan stop the plugin
+//
+//	func main() {
+//		external.Serve("token", tokenPlugin.New())
+//	}
+func Serve(name string, p subscan_plugin.Plugin) {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		_, _ = fmt.Fprintf(os.Stderr, "%s is a subscan plugin, put it in the plugin directory of subscan as %s%s\n", os.Args[0], BinaryPrefix, name)
+		os.Exit(1)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "plugin listen failed: %v\n", err)
+		os.Exit(1)
+	}
+	server := NewServer(name, p)
+	go func() {
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, syscall.SIGTERM, syscall.SIGINT)
+		<-sigs
+		server.GracefulStop()
+	}()
+	fmt.Printf("%d|%d|tcp|%s|grpc\n", CoreProtocolVersion, ProtocolVersion, lis.Addr().String())
+	_ = server.Serve(lis)
+}
+
+// NewServer grpc server of plugin with health service
+func NewServer(name string, p subscan_plugin.Plugin) *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterPluginServer(server, &pluginServer{name: name, plugin: p})
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	return server
+}
+
+// pluginServer serve plugin, plugin errors are returned as grpc status with message
+type pluginServer struct {
+	pb.UnimplementedPluginServer
+	name   string
+	plugin subscan_plugin.Plugin
+	conn   *grpc.ClientConn
+	dao    *remoteDao
+}
+
+// txDeliverer plugin process writes of delivery with the dao given, same as DeliverTx of native plugins
+type txDeliverer interface {
+	DeliverTx(d storage.Dao, deliver func(subscan_plugin.Plugin) error) error
+}
+
+// deliver call process with plugin of the delivery if request is of a block delivery and plugin implement DeliverTx,
+// so storage calls of plugin are served in the delivery transaction of subscan
+func (s *pluginServer) deliver(ctx context.Context, process func(subscan_plugin.Plugin) error) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(deliveryMetadataKey)
+	deliverer, ok := s.plugin.(txDeliverer)
+	if len(tokens) == 0 || !ok || s.dao == nil {
+		return process(s.plugin)
+	}
+	return deliverer.DeliverTx(s.dao.forDelivery(tokens[0]), process)
+}
+
+func pluginError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+func (s *pluginServer) Info(context.Context, *pb.InfoRequest) (*pb.InfoReply, error) {
+	reply := &pb.InfoReply{
+		Name:               s.name,
+		Version:            s.plugin.Version(),
+		Enable:             s.plugin.Enable(),
+		SubscribeExtrinsic: s.plugin.SubscribeExtrinsic(),
+		SubscribeEvent:     s.plugin.SubscribeEvent(),
+		ConsumptionQueue:   s.plugin.ConsumptionQueue(),
+	}
+	for _, route := range s.plugin.InitHttp() {
+		reply.Routes = append(reply.Routes, &pb.Route{Method: route.Method, Router: route.Router})
+	}
+	for _, command := range s.plugin.Commands() {
+		reply.Commands = append(reply.Commands, &pb.Command{Name: command.Name, Usage: command.Usage})
+	}
+	return reply, nil
+}
+
+func (s *pluginServer) Init(_ context.Context, req *pb.InitRequest) (*pb.Empty, error) {
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+	token := req.HostToken
+	conn, err := grpc.NewClient(req.HostAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(metadata.AppendToOutgoingContext(ctx, tokenMetadataKey, token), method, req, reply, cc, opts...)
+		}))
+	if err != nil {
+		return nil, pluginError(err)
+	}
+	s.conn = conn
+	if req.WsEndpoint != "" {
+		websocket.SetEndpoint(req.WsEndpoint)
+	}
+	host := pb.NewHostClient(conn)
+	s.dao = newRemoteDao(host)
+	s.plugin.InitDao(s.dao)
+	s.plugin.SetRedisPool(&remoteRedis{host: host})
+	return &pb.Empty{}, nil
+}
+
+func (s *pluginServer) Migrate(context.Context, *pb.Empty) (*pb.Empty, error) {
+	s.plugin.Migrate()
+	return &pb.Empty{}, nil
+}
+
+func (s *pluginServer) ProcessBlock(ctx context.Context, req *pb.ProcessBlockRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, pluginError(s.deliver(ctx, func(p subscan_plugin.Plugin) error {
+		return p.ProcessBlock(ctx, fromBlock(req.Block))
+	}))
+}
+
+func (s *pluginServer) ProcessEvent(ctx context.Context, req *pb.ProcessEventRequest) (*pb.Empty, error) {
+	fee, _ := decimal.NewFromString(req.Fee)
+	return &pb.Empty{}, pluginError(s.deliver(ctx, func(p subscan_plugin.Plugin) error {
+		return p.ProcessEvent(fromBlock(req.Block), fromEvent(req.Event), fee)
+	}))
+}
+
+func (s *pluginServer) ProcessExtrinsic(ctx context.Context, req *pb.ProcessExtrinsicRequest) (*pb.Empty, error) {
+	var events = make([]storage.Event, 0, len(req.Events))
+	for _, event := range req.Events {
+		events = append(events, *fromEvent(event))
+	}
+	return &pb.Empty{}, pluginError(s.deliver(ctx, func(p subscan_plugin.Plugin) error {
+		return p.ProcessExtrinsic(fromBlock(req.Block), fromExtrinsic(req.Extrinsic), events)
+	}))
+}
+
+func (s *pluginServer) ExecWorker(ctx context.Context, req *pb.ExecWorkerRequest) (*pb.Empty, error) {
+	raw, err := simplejson.NewJson(req.Raw)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.Empty{}, pluginError(s.plugin.ExecWorker(ctx, req.Queue, req.Class, raw))
+}
+
+func (s *pluginServer) ServeHttp(ctx context.Context, req *pb.HttpRequest) (*pb.HttpReply, error) {
+	routes := s.plugin.InitHttp()
+	if req.Route < 0 || int(req.Route) >= len(routes) {
+		return nil, status.Errorf(codes.NotFound, "route %d not found", req.Route)
+	}
+	r, err := http.NewRequestWithContext(ctx, req.Method, req.Url, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for key, header := range req.Header {
+		r.Header[key] = header.Values
+	}
+	w := httptest.NewRecorder()
+	// error of handle is ignored as native plugin routes, response is written by handle
+	_ = routes[req.Route].Handle(w, r)
+	reply := &pb.HttpReply{Status: int32(w.Code), Header: make(map[string]*pb.Header), Body: w.Body.Bytes()}
+	for key, values := range w.Header() {
+		reply.Header[key] = &pb.Header{Values: values}
+	}
+	return reply, nil
+}
+
+func (s *pluginServer) RunCommand(_ context.Context, req *pb.RunCommandRequest) (*pb.RunCommandReply, error) {
+	var output bytes.Buffer
+	app := cli.NewApp()
+	app.Name = s.name
+	app.Commands = s.plugin.Commands()
+	app.Writer, app.ErrWriter = &output, &output
+	// keep plugin process alive when command return exit error
+	cli.OsExiter = func(int) {}
+	if err := app.Run(append([]string{s.name}, req.Args...)); err != nil {
+		return nil, status.Error(codes.Unknown, fmt.Sprintf("%s%v", output.String(), err))
+	}
+	return &pb.RunCommandReply{Output: output.Bytes()}, nil
+}
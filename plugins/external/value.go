@@ -0,0 +1,368 @@
+package external
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"go/token"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/itering/subscan-plugin/storage"
+	pb "github.com/itering/subscan/api/plugin/v1"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm/schema"
+)
+
+var schemaCache sync.Map
+
+// parseSchema gorm schema of plugin model, model is struct, pointer of struct or slice of them
+func parseSchema(model interface{}) (*schema.Schema, error) {
+	if model == nil {
+		return nil, errors.New("nil model")
+	}
+	return schema.Parse(model, &schemaCache, schema.NamingStrategy{})
+}
+
+func toValue(v interface{}) *pb.Value {
+	if valuer, ok := v.(driver.Valuer); ok {
+		var err error
+		if v, err = valuer.Value(); err != nil {
+			v = nil
+		}
+	}
+	switch val := v.(type) {
+	case nil:
+		return &pb.Value{Kind: &pb.Value_Null{Null: true}}
+	case string:
+		return &pb.Value{Kind: &pb.Value_String_{String_: val}}
+	case []byte:
+		return &pb.Value{Kind: &pb.Value_Bytes{Bytes: val}}
+	case bool:
+		return &pb.Value{Kind: &pb.Value_Bool{Bool: val}}
+	case time.Time:
+		return &pb.Value{Kind: &pb.Value_Time{Time: val.UnixNano()}}
+	case float32:
+		return &pb.Value{Kind: &pb.Value_Float{Float: float64(val)}}
+	case float64:
+		return &pb.Value{Kind: &pb.Value_Float{Float: val}}
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &pb.Value{Kind: &pb.Value_Int{Int: rv.Int()}}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &pb.Value{Kind: &pb.Value_Uint{Uint: rv.Uint()}}
+	case reflect.String:
+		return &pb.Value{Kind: &pb.Value_String_{String_: rv.String()}}
+	case reflect.Bool:
+		return &pb.Value{Kind: &pb.Value_Bool{Bool: rv.Bool()}}
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return toValue(nil)
+		}
+		return toValue(rv.Elem().Interface())
+	}
+	return &pb.Value{Kind: &pb.Value_String_{String_: fmt.Sprint(v)}}
+}
+
+func fromValue(v *pb.Value) interface{} {
+	switch kind := v.GetKind().(type) {
+	case *pb.Value_Int:
+		return kind.Int
+	case *pb.Value_Uint:
+		return kind.Uint
+	case *pb.Value_Float:
+		return kind.Float
+	case *pb.Value_String_:
+		return kind.String_
+	case *pb.Value_Bytes:
+		return kind.Bytes
+	case *pb.Value_Bool:
+		return kind.Bool
+	case *pb.Value_Time:
+		return time.Unix(0, kind.Time)
+	}
+	return nil
+}
+
+func toRow(columns map[string]interface{}) *pb.Row {
+	row := &pb.Row{Columns: make(map[string]*pb.Value, len(columns))}
+	for column, v := range columns {
+		row.Columns[column] = toValue(v)
+	}
+	return row
+}
+
+func fromRow(row *pb.Row) map[string]interface{} {
+	columns := make(map[string]interface{}, len(row.GetColumns()))
+	for column, v := range row.GetColumns() {
+		columns[column] = fromValue(v)
+	}
+	return columns
+}
+
+// structRows rows of record, auto increment primary key with zero value is omitted
+func structRows(record interface{}) (*schema.Schema, []*pb.Row, error) {
+	s, err := parseSchema(record)
+	if err != nil {
+		return nil, nil, err
+	}
+	rv := reflect.Indirect(reflect.ValueOf(record))
+	var values []reflect.Value
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		for i := 0; i < rv.Len(); i++ {
+			values = append(values, reflect.Indirect(rv.Index(i)))
+		}
+	} else {
+		values = append(values, rv)
+	}
+	var rows []*pb.Row
+	for _, value := range values {
+		rows = append(rows, toRow(structColumns(s, value, false)))
+	}
+	return s, rows, nil
+}
+
+// structColumns column values of struct, only non-zero values if nonZero
+func structColumns(s *schema.Schema, value reflect.Value, nonZero bool) map[string]interface{} {
+	columns := make(map[string]interface{})
+	for _, field := range s.Fields {
+		if field.DBName == "" {
+			continue
+		}
+		v, zero := field.ValueOf(context.Background(), value)
+		if zero && (nonZero || field.AutoIncrement) {
+			continue
+		}
+		columns[field.DBName] = v
+	}
+	return columns
+}
+
+// toQuery where conditions of column map or struct, raw sql string is not supported by host
+func toQuery(query interface{}) (*pb.Query, error) {
+	switch q := query.(type) {
+	case nil:
+		return &pb.Query{}, nil
+	case string:
+		return nil, fmt.Errorf("raw sql query %q not supported, use column map or struct", q)
+	case map[string]interface{}:
+		return &pb.Query{Conditions: toRow(q)}, nil
+	}
+	rv := reflect.Indirect(reflect.ValueOf(query))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported query type %T", query)
+	}
+	s, err := parseSchema(query)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Query{Conditions: toRow(structColumns(s, rv, true))}, nil
+}
+
+// scanRows set rows to record, record is pointer of struct or pointer of slice
+func scanRows(record interface{}, rows []*pb.Row) error {
+	s, err := parseSchema(record)
+	if err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(record)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("record must be a pointer, got %T", record)
+	}
+	rv = rv.Elem()
+	set := func(value reflect.Value, row *pb.Row) error {
+		for column, v := range row.GetColumns() {
+			field := s.LookUpField(column)
+			if field == nil {
+				continue
+			}
+			if err := field.Set(context.Background(), value, fromValue(v)); err != nil {
+				return fmt.Errorf("set column %s: %w", column, err)
+			}
+		}
+		return nil
+	}
+	if rv.Kind() != reflect.Slice {
+		if len(rows) == 0 {
+			return nil
+		}
+		return set(rv, rows[0])
+	}
+	elemType := rv.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Pointer
+	if isPtr {
+		elemType = elemType.Elem()
+	}
+	list := reflect.MakeSlice(rv.Type(), 0, len(rows))
+	for _, row := range rows {
+		elem := reflect.New(elemType)
+		if err = set(elem.Elem(), row); err != nil {
+			return err
+		}
+		if !isPtr {
+			elem = elem.Elem()
+		}
+		list = reflect.Append(list, elem)
+	}
+	rv.Set(list)
+	return nil
+}
+
+// modelColumns columns of model for auto migration on host
+func modelColumns(s *schema.Schema) []*pb.Column {
+	var columns []*pb.Column
+	for _, field := range s.Fields {
+		if field.DBName == "" {
+			continue
+		}
+		columns = append(columns, &pb.Column{
+			Name:     field.Name,
+			DbName:   field.DBName,
+			DataType: string(field.DataType),
+			Size:     int32(field.Size),
+			Tag:      field.Tag.Get("gorm"),
+		})
+	}
+	return columns
+}
+
+// columnsModel build struct of columns for gorm auto migration, column of custom data type stored as string with type tag
+func columnsModel(columns []*pb.Column) (interface{}, error) {
+	var fields []reflect.StructField
+	for _, column := range columns {
+		var typ reflect.Type
+		tag := column.Tag
+		switch schema.DataType(column.DataType) {
+		case schema.Bool:
+			typ = reflect.TypeOf(false)
+		case schema.Int:
+			typ = reflect.TypeOf(int64(0))
+			if column.Size == 32 {
+				typ = reflect.TypeOf(int32(0))
+			}
+		case schema.Uint:
+			typ = reflect.TypeOf(uint64(0))
+			if column.Size == 32 {
+				typ = reflect.TypeOf(uint32(0))
+			}
+		case schema.Float:
+			typ = reflect.TypeOf(float64(0))
+		case schema.String:
+			typ = reflect.TypeOf("")
+		case schema.Time:
+			typ = reflect.TypeOf(time.Time{})
+		case schema.Bytes:
+			typ = reflect.TypeOf([]byte{})
+		default:
+			typ = reflect.TypeOf("")
+			if tagSetting := schema.ParseTagSetting(tag, ";"); tagSetting["TYPE"] == "" {
+				tag = fmt.Sprintf("type:%s;%s", column.DataType, tag)
+			}
+		}
+		if !token.IsIdentifier(column.Name) || !token.IsExported(column.Name) {
+			return nil, fmt.Errorf("invalid column name %q", column.Name)
+		}
+		fields = append(fields, reflect.StructField{
+			Name: column.Name,
+			Type: typ,
+			Tag:  reflect.StructTag(fmt.Sprintf(`gorm:"column:%s;%s"`, column.DbName, tag)),
+		})
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("model without columns")
+	}
+	return reflect.New(reflect.StructOf(fields)).Interface(), nil
+}
+
+func toBlock(b *storage.Block) *pb.Block {
+	if b == nil {
+		return nil
+	}
+	return &pb.Block{
+		BlockNum:       int64(b.BlockNum),
+		BlockTimestamp: int64(b.BlockTimestamp),
+		Hash:           b.Hash,
+		SpecVersion:    int64(b.SpecVersion),
+		Validator:      b.Validator,
+		Finalized:      b.Finalized,
+	}
+}
+
+func fromBlock(b *pb.Block) *storage.Block {
+	if b == nil {
+		return nil
+	}
+	return &storage.Block{
+		BlockNum:       int(b.BlockNum),
+		BlockTimestamp: int(b.BlockTimestamp),
+		Hash:           b.Hash,
+		SpecVersion:    int(b.SpecVersion),
+		Validator:      b.Validator,
+		Finalized:      b.Finalized,
+	}
+}
+
+func toEvent(e *storage.Event) *pb.Event {
+	return &pb.Event{
+		Id:            uint64(e.Id),
+		BlockNum:      int64(e.BlockNum),
+		ExtrinsicIdx:  int64(e.ExtrinsicIdx),
+		ModuleId:      e.ModuleId,
+		EventId:       e.EventId,
+		Params:        e.Params,
+		ExtrinsicHash: e.ExtrinsicHash,
+		EventIdx:      int64(e.EventIdx),
+	}
+}
+
+func fromEvent(e *pb.Event) *storage.Event {
+	return &storage.Event{
+		Id:            uint(e.Id),
+		BlockNum:      int(e.BlockNum),
+		ExtrinsicIdx:  int(e.ExtrinsicIdx),
+		ModuleId:      e.ModuleId,
+		EventId:       e.EventId,
+		Params:        e.Params,
+		ExtrinsicHash: e.ExtrinsicHash,
+		EventIdx:      int(e.EventIdx),
+	}
+}
+
+func toExtrinsic(e *storage.Extrinsic) *pb.Extrinsic {
+	return &pb.Extrinsic{
+		ExtrinsicIndex:     e.ExtrinsicIndex,
+		CallCode:           e.CallCode,
+		CallModuleFunction: e.CallModuleFunction,
+		CallModule:         e.CallModule,
+		Params:             e.Params,
+		AccountId:          e.AccountId,
+		Signature:          e.Signature,
+		Nonce:              int64(e.Nonce),
+		Era:                e.Era,
+		ExtrinsicHash:      e.ExtrinsicHash,
+		Success:            e.Success,
+		Fee:                e.Fee.String(),
+	}
+}
+
+func fromExtrinsic(e *pb.Extrinsic) *storage.Extrinsic {
+	fee, _ := decimal.NewFromString(e.Fee)
+	return &storage.Extrinsic{
+		ExtrinsicIndex:     e.ExtrinsicIndex,
+		CallCode:           e.CallCode,
+		CallModuleFunction: e.CallModuleFunction,
+		CallModule:         e.CallModule,
+		Params:             e.Params,
+		AccountId:          e.AccountId,
+		Signature:          e.Signature,
+		Nonce:              int(e.Nonce),
+		Era:                e.Era,
+		ExtrinsicHash:      e.ExtrinsicHash,
+		Success:            e.Success,
+		Fee:                fee,
+	}
+}
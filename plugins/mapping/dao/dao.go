@@ -0,0 +1,191 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/plugins/mapping/model"
+	"github.com/itering/subscan/util"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	structTypes sync.Map // table name => reflect.Type
+	goTypes     = map[string]reflect.Type{
+		model.TypeString:  reflect.TypeOf(""),
+		model.TypeAddress: reflect.TypeOf(""),
+		model.TypeInt:     reflect.TypeOf(int64(0)),
+		model.TypeDecimal: reflect.TypeOf(decimal.Decimal{}),
+		model.TypeBool:    reflect.TypeOf(false),
+		model.TypeJson:    reflect.TypeOf(""),
+	}
+)
+
+// rowType struct of table rows, built-in columns followed by columns of mapping
+func rowType(t *model.Table) reflect.Type {
+	if v, ok := structTypes.Load(t.TableName()); ok {
+		return v.(reflect.Type)
+	}
+	keys := "index:" + t.KeysIndex() + ",unique"
+	tag := func(column, gormTag string) string {
+		if util.StringInSlice(column, t.Keys) {
+			gormTag = strings.TrimPrefix(gormTag+";"+keys, ";")
+		}
+		return fmt.Sprintf(`gorm:"column:%s;%s" json:"%s"`, column, gormTag, column)
+	}
+	fields := []reflect.StructField{
+		{Name: "ID", Type: reflect.TypeOf(uint(0)), Tag: reflect.StructTag(`gorm:"column:id;primaryKey;autoIncrement" json:"id"`)},
+		{Name: "BlockNum", Type: reflect.TypeOf(uint(0)), Tag: reflect.StructTag(tag(model.ColumnBlockNum, "index:"+t.TableName()+"_block_num"))},
+		{Name: "BlockTimestamp", Type: reflect.TypeOf(0), Tag: reflect.StructTag(tag(model.ColumnBlockTimestamp, ""))},
+		{Name: "Index", Type: reflect.TypeOf(""), Tag: reflect.StructTag(tag(t.IndexColumn(), "size:100"))},
+	}
+	if !t.IsEvent() {
+		fields = append(fields, reflect.StructField{Name: "CallPath", Type: reflect.TypeOf(""), Tag: reflect.StructTag(tag(model.ColumnCallPath, "size:100"))})
+	}
+	for i, c := range t.Columns {
+		var gormTag []string
+		switch c.Type {
+		case model.TypeString:
+			gormTag = append(gormTag, fmt.Sprintf("size:%d", c.Size))
+		case model.TypeAddress:
+			gormTag = append(gormTag, "size:100")
+		case model.TypeDecimal:
+			gormTag = append(gormTag, "type:decimal(65,0)")
+		case model.TypeJson:
+			gormTag = append(gormTag, "type:text")
+		}
+		if c.Index {
+			gormTag = append(gormTag, fmt.Sprintf("index:%s_%s", t.TableName(), c.Name))
+		}
+		fields = append(fields, reflect.StructField{Name: fmt.Sprintf("C%d", i), Type: goTypes[c.Type], Tag: reflect.StructTag(tag(c.Name, strings.Join(gormTag, ";")))})
+	}
+	st := reflect.StructOf(fields)
+	structTypes.Store(t.TableName(), st)
+	return st
+}
+
+// Migrate create table of rows, columns added to mapping are added to table,
+// unique index of previous key set is dropped
+func Migrate(db storage.DB, t *model.Table) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	if d.Dialector.Name() == "mysql" {
+		d = d.Set("gorm:table_options", "ENGINE=InnoDB")
+	}
+	value := reflect.New(rowType(t)).Interface()
+	if err := d.Table(t.TableName()).AutoMigrate(value); err != nil {
+		return err
+	}
+	m := d.Table(t.TableName()).Migrator()
+	indexes, err := m.GetIndexes(value)
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		name := index.Name()
+		// index of keys named {table}_keys before named by key set
+		if name != t.KeysIndex() && (strings.HasPrefix(name, "mapping_keys_") || name == t.TableName()+"_keys") {
+			if err = m.DropIndex(value, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteRows delete rows from block num
+func DeleteRows(ctx context.Context, db storage.DB, t *model.Table, from uint) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Table(t.TableName()).Where("block_num >= ?", from).Delete(reflect.New(rowType(t)).Interface()).Error
+}
+
+// SaveRow insert row, row of the same keys is updated if table upsert
+func SaveRow(ctx context.Context, db storage.DB, t *model.Table, row map[string]interface{}) error {
+	d := db.GetDbInstance().(*gorm.DB).WithContext(ctx)
+	conflict := clause.OnConflict{DoNothing: true}
+	if updates := updateColumns(t, row); t.Upsert && len(updates) > 0 {
+		var columns []clause.Column
+		for _, key := range t.Keys {
+			columns = append(columns, clause.Column{Name: key})
+		}
+		conflict = clause.OnConflict{Columns: columns, DoUpdates: clause.AssignmentColumns(updates)}
+	}
+	return d.Model(reflect.New(rowType(t)).Interface()).Table(t.TableName()).Clauses(conflict).Create(row).Error
+}
+
+func updateColumns(t *model.Table, row map[string]interface{}) []string {
+	var updates []string
+	for column := range row {
+		if !util.StringInSlice(column, t.Keys) {
+			updates = append(updates, column)
+		}
+	}
+	sort.Strings(updates)
+	return updates
+}
+
+// RowsCursor rows of table filtered by columns, ordered by id desc
+func RowsCursor(ctx context.Context, db storage.DB, t *model.Table, filters map[string]interface{}, limit int, before, after *uint) ([]map[string]interface{}, bool, bool) {
+	d := db.GetDbInstance().(*gorm.DB)
+	fetch := limit + 1
+	var hasPrev, hasNext bool
+	q := d.WithContext(ctx).Table(t.TableName())
+	if len(filters) > 0 {
+		q = q.Where(filters)
+	}
+	if after != nil && *after > 0 {
+		q = q.Where("id < ?", *after).Order("id desc")
+	} else if before != nil && *before > 0 {
+		q = q.Where("id > ?", *before).Order("id asc")
+	} else {
+		q = q.Order("id desc")
+	}
+	found := reflect.New(reflect.SliceOf(rowType(t)))
+	if err := q.Limit(fetch).Find(found.Interface()).Error; err != nil {
+		return nil, false, false
+	}
+	rows := found.Elem()
+	if before != nil && *before > 0 {
+		hasPrev = rows.Len() > limit
+		if hasPrev {
+			rows = rows.Slice(0, limit)
+		}
+		hasNext = true
+	} else {
+		hasNext = rows.Len() > limit
+		if hasNext {
+			rows = rows.Slice(0, limit)
+		}
+		hasPrev = after != nil && *after > 0
+	}
+	list := rowMaps(t, rows)
+	if before != nil && *before > 0 {
+		for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+			list[i], list[j] = list[j], list[i]
+		}
+	}
+	return list, hasPrev, hasNext
+}
+
+// rowMaps column name => value of row structs
+func rowMaps(t *model.Table, rows reflect.Value) []map[string]interface{} {
+	columns := t.BuiltinColumns()
+	for _, c := range t.Columns {
+		columns = append(columns, c.Name)
+	}
+	list := make([]map[string]interface{}, 0, rows.Len())
+	for i := 0; i < rows.Len(); i++ {
+		row := make(map[string]interface{}, len(columns))
+		for j, column := range columns {
+			row[column] = rows.Index(i).Field(j).Interface()
+		}
+		list = append(list, row)
+	}
+	return list
+}
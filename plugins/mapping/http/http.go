@@ -0,0 +1,117 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/itering/subscan-plugin/router"
+	"github.com/itering/subscan/plugins/mapping/model"
+	"github.com/itering/subscan/plugins/mapping/service"
+	"github.com/itering/subscan/util/validator"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+)
+
+var (
+	svc *service.Service
+)
+
+// Router list api of every mapping table, /api/plugin/mapping/{table}
+func Router(s *service.Service, tables []*model.Table) []router.Http {
+	svc = s
+	var routes []router.Http
+	for _, t := range tables {
+		routes = append(routes, router.Http{Router: t.Name, Handle: rowsHandle(t), Method: http.MethodPost})
+	}
+	return routes
+}
+
+type rowsParams struct {
+	Limit  int   `json:"row" validate:"min=1,max=100"`
+	Before *uint `json:"before" validate:"omitempty,min=0"`
+	After  *uint `json:"after" validate:"omitempty,min=0"`
+}
+
+// @Summary Get rows of mapping table, other params are filters of block_num, key or indexed columns
+// @Tags mapping
+// @Accept json
+// @Produce json
+// @Param params body rowsParams true "params"
+// @Success 200 {object} J{data=object{list=[]object,pagination=object}}
+// @Router /api/plugin/mapping/{table} [post]
+func rowsHandle(t *model.Table) func(w http.ResponseWriter, r *http.Request) error {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		body, _ := io.ReadAll(r.Body)
+		p := new(rowsParams)
+		if err := validator.Validate(body, p); err != nil {
+			toJson(w, 10001, nil, err)
+			return nil
+		}
+		var params map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&params); err != nil {
+			toJson(w, 10001, nil, err)
+			return nil
+		}
+		delete(params, "row")
+		delete(params, "before")
+		delete(params, "after")
+		filters, err := svc.Filters(t, params)
+		if err != nil {
+			toJson(w, 10001, nil, err)
+			return nil
+		}
+		list, page := svc.GetRowsCursor(r.Context(), t, filters, p.Limit, p.Before, p.After)
+		toJson(w, 0, map[string]interface{}{
+			"list": list, "pagination": page,
+		}, nil)
+		return nil
+	}
+}
+
+type J struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	TTL     int         `json:"ttl"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (j J) Render(w http.ResponseWriter) error {
+	header := w.Header()
+	if val := header["Content-Type"]; len(val) == 0 {
+		header["Content-Type"] = []string{"application/json; charset=utf-8"}
+	}
+	return nil
+}
+
+func (j J) WriteContentType(w http.ResponseWriter) {
+	var (
+		jsonBytes []byte
+		err       error
+	)
+	_ = j.Render(w)
+	if jsonBytes, err = json.Marshal(j); err != nil {
+		_ = errors.WithStack(err)
+		return
+	}
+	if _, err = w.Write(jsonBytes); err != nil {
+		_ = errors.WithStack(err)
+	}
+}
+
+func toJson(w http.ResponseWriter, code int, data interface{}, err error) {
+	j := J{
+		Message: "success",
+		TTL:     1,
+		Data:    data,
+	}
+	if err != nil {
+		j.Message = err.Error()
+	}
+	if code != 0 {
+		j.Code = code
+	}
+	j.WriteContentType(w)
+	_ = j.Render(w)
+}
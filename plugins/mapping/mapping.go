@@ -0,0 +1,152 @@
+package mapping
+
+import (
+	"context"
+	"fmt"
+	subscan_plugin "github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/router"
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/plugins/mapping/http"
+	"github.com/itering/subscan/plugins/mapping/model"
+	"github.com/itering/subscan/plugins/mapping/service"
+	"github.com/itering/subscan/util"
+	"github.com/shopspring/decimal"
+	"github.com/urfave/cli"
+	"sync"
+)
+
+var srv *service.Service
+
+// nestedCallModules modules of wrapper calls dispatching nested calls
+var nestedCallModules = []string{"utility", "proxy", "multisig", "sudo"}
+
+// Mapping index events and calls to tables declared in mapping.yaml, no code needed
+type Mapping struct {
+	d    storage.Dao
+	srv  *service.Service
+	once sync.Once
+	spec *model.Spec
+}
+
+func New() *Mapping {
+	return &Mapping{}
+}
+
+// Spec declared tables, disabled if mapping file not exists or invalid
+func (a *Mapping) Spec() *model.Spec {
+	a.once.Do(func() {
+		path := util.GetEnv("MAPPING_CONFIG", fmt.Sprintf("%s/mapping.yaml", util.ConfDir))
+		if !util.FileExists(path) {
+			return
+		}
+		spec, err := service.LoadSpec(path)
+		if err != nil {
+			util.Logger().Error(fmt.Errorf("mapping plugin disabled, load %s failed: %v", path, err))
+			return
+		}
+		a.spec = spec
+	})
+	return a.spec
+}
+
+func (a *Mapping) Commands() []cli.Command {
+	return nil
+}
+
+func (a *Mapping) ConsumptionQueue() []string {
+	return nil
+}
+
+func (a *Mapping) Enable() bool {
+	return a.Spec() != nil && len(a.Spec().Tables) > 0
+}
+
+func (a *Mapping) InitDao(d storage.Dao) {
+	a.d = d
+	if !a.Enable() {
+		return
+	}
+	a.srv = service.New(d, a.Spec())
+	srv = a.srv
+	a.Migrate()
+}
+
+func (a *Mapping) InitHttp() []router.Http {
+	if !a.Enable() {
+		return nil
+	}
+	return http.Router(srv, a.Spec().Tables)
+}
+
+func (a *Mapping) ProcessBlock(context.Context, *storage.Block) error {
+	return nil
+}
+
+// Rollback delete rows from block num before blocks delivered again
+func (a *Mapping) Rollback(ctx context.Context, from uint) error {
+	if !a.Enable() {
+		return nil
+	}
+	return a.srv.Rollback(ctx, from)
+}
+
+// DeliverTx rows of block are written through d
+func (a *Mapping) DeliverTx(d storage.Dao, deliver func(subscan_plugin.Plugin) error) error {
+	return deliver(&Mapping{d: d, srv: service.New(d, a.Spec()), spec: a.Spec()})
+}
+
+func (a *Mapping) ProcessExtrinsic(block *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) error {
+	return a.srv.EmitExtrinsic(context.TODO(), block, extrinsic, events)
+}
+
+func (a *Mapping) ProcessEvent(block *storage.Block, event *storage.Event, _ decimal.Decimal) error {
+	if event == nil {
+		return nil
+	}
+	return a.srv.EmitEvent(context.TODO(), block, event)
+}
+
+func (a *Mapping) SubscribeExtrinsic() []string {
+	return a.subscribe(false)
+}
+
+func (a *Mapping) SubscribeEvent() []string {
+	return a.subscribe(true)
+}
+
+func (a *Mapping) subscribe(event bool) []string {
+	var modules []string
+	if !a.Enable() {
+		return nil
+	}
+	for _, t := range a.Spec().Tables {
+		if t.IsEvent() != event {
+			continue
+		}
+		candidates := []string{t.Module()}
+		if !event {
+			// calls nested in wrapper calls
+			candidates = append(candidates, nestedCallModules...)
+		}
+		for _, module := range candidates {
+			if !util.StringInSlice(module, modules) {
+				modules = append(modules, module)
+			}
+		}
+	}
+	return modules
+}
+
+func (a *Mapping) Migrate() {
+	if err := srv.Migrate(); err != nil {
+		util.Logger().Error(err)
+	}
+}
+
+func (a *Mapping) SetRedisPool(subscan_plugin.RedisPool) {}
+
+func (a *Mapping) Version() string {
+	return "0.1"
+}
+
+func (a *Mapping) ExecWorker(context.Context, string, string, interface{}) error { return nil }
@@ -0,0 +1,276 @@
+package model
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"github.com/itering/subscan/util"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Spec declared in mapping.yaml, every table map an event or a call to rows of table mapping_{name}
+type Spec struct {
+	Tables []*Table `json:"tables"`
+}
+
+// Table rows of captured event or call
+type Table struct {
+	Name    string    `json:"name"`
+	Event   string    `json:"event"` // module.event, like PalletCbcPoi.PoiSubmitted
+	Call    string    `json:"call"`  // module.call, like PalletCbcPoi.submit_poi
+	Columns []*Column `json:"columns"`
+	Keys    []string  `json:"keys"`   // unique columns of row, default event_index or extrinsic_index and call_path
+	Upsert  bool      `json:"upsert"` // update columns of the row with the same keys, default keep the first row
+
+	module string
+	method string
+}
+
+// Column value from decoded param or derived from block, event or extrinsic
+type Column struct {
+	Name  string `json:"name"`
+	Param string `json:"param"` // param name or position, nested value by path like info.amount
+	Field string `json:"field"` // derived field, see Fields
+	Type  string `json:"type"`  // string(default), address, int, decimal, bool or json
+	Size  int    `json:"size"`  // size of string column, default 255
+	Index bool   `json:"index"` // indexed and filterable by api
+}
+
+const (
+	TypeString  = "string"
+	TypeAddress = "address"
+	TypeInt     = "int"
+	TypeDecimal = "decimal"
+	TypeBool    = "bool"
+	TypeJson    = "json"
+)
+
+const (
+	ColumnId             = "id"
+	ColumnBlockNum       = "block_num"
+	ColumnBlockTimestamp = "block_timestamp"
+	ColumnEventIndex     = "event_index"
+	ColumnExtrinsicIndex = "extrinsic_index"
+	// ColumnCallPath path of call in call tree of extrinsic, empty of extrinsic call, like 0.1 of nested call of batch
+	ColumnCallPath = "call_path"
+)
+
+type field struct {
+	Type  string
+	Event bool // available for event
+	Call  bool // available for call
+}
+
+// Fields derived fields of column
+var Fields = map[string]field{
+	"block_hash":      {Type: TypeString, Event: true, Call: true},
+	"spec_version":    {Type: TypeInt, Event: true, Call: true},
+	"extrinsic_index": {Type: TypeString, Event: true, Call: true},
+	"extrinsic_hash":  {Type: TypeString, Event: true, Call: true},
+	"event_idx":       {Type: TypeInt, Event: true},
+	"account_id":      {Type: TypeAddress, Call: true},
+	"success":         {Type: TypeBool, Call: true},
+	"fee":             {Type: TypeDecimal, Call: true},
+}
+
+var (
+	namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,47}$`)
+	types       = []string{TypeString, TypeAddress, TypeInt, TypeDecimal, TypeBool, TypeJson}
+	// list params of api
+	reservedNames = []string{"row", "before", "after"}
+)
+
+// Validate check spec and fill default values
+func (s *Spec) Validate() error {
+	names := make(map[string]bool)
+	for i, t := range s.Tables {
+		if t == nil {
+			return fmt.Errorf("table %d is empty", i)
+		}
+		if !namePattern.MatchString(t.Name) {
+			return fmt.Errorf("invalid table name %q", t.Name)
+		}
+		if names[t.Name] {
+			return fmt.Errorf("duplicate table %s", t.Name)
+		}
+		names[t.Name] = true
+		if err := t.validate(); err != nil {
+			return fmt.Errorf("table %s: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+func (t *Table) validate() error {
+	path := t.Event
+	if (t.Event == "") == (t.Call == "") {
+		return fmt.Errorf("one of event or call required")
+	}
+	if t.Call != "" {
+		path = t.Call
+	}
+	parts := strings.Split(path, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid %q, want module.name", path)
+	}
+	t.module, t.method = parts[0], parts[1]
+
+	columns := make(map[string]bool)
+	for _, column := range t.BuiltinColumns() {
+		columns[column] = true
+	}
+	for i, c := range t.Columns {
+		if c == nil {
+			return fmt.Errorf("column %d is empty", i)
+		}
+		if !namePattern.MatchString(c.Name) {
+			return fmt.Errorf("invalid column name %q", c.Name)
+		}
+		if columns[c.Name] || util.StringInSlice(c.Name, reservedNames) {
+			return fmt.Errorf("column %s is duplicate or reserved", c.Name)
+		}
+		columns[c.Name] = true
+		if (c.Param == "") == (c.Field == "") {
+			return fmt.Errorf("column %s: one of param or field required", c.Name)
+		}
+		if c.Field != "" {
+			f, ok := Fields[c.Field]
+			if !ok || (t.IsEvent() && !f.Event) || (!t.IsEvent() && !f.Call) {
+				return fmt.Errorf("column %s: field %s not available", c.Name, c.Field)
+			}
+			if c.Type == "" {
+				c.Type = f.Type
+			}
+		}
+		if c.Type == "" {
+			c.Type = TypeString
+		}
+		if !util.StringInSlice(c.Type, types) {
+			return fmt.Errorf("column %s: unsupported type %s", c.Name, c.Type)
+		}
+		if c.Index && c.Type == TypeJson {
+			return fmt.Errorf("column %s: json column can not be indexed", c.Name)
+		}
+		if c.Size <= 0 {
+			c.Size = 255
+		}
+	}
+	for _, key := range t.Keys {
+		if !columns[key] || key == ColumnId {
+			return fmt.Errorf("key %s is not a column", key)
+		}
+	}
+	if len(t.Keys) == 0 {
+		t.Keys = []string{t.IndexColumn()}
+		if !t.IsEvent() {
+			t.Keys = append(t.Keys, ColumnCallPath)
+		}
+	}
+	return nil
+}
+
+// Param decoded param of event or call
+type Param struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
+type Params []Param
+
+// Lookup value of param by name or position, nested value by path like info.amount or targets.0
+func (p Params) Lookup(path string) interface{} {
+	segments := strings.Split(path, ".")
+	var value interface{}
+	found := false
+	for _, param := range p {
+		if param.Name != "" && param.Name == segments[0] {
+			value, found = param.Value, true
+			break
+		}
+	}
+	if !found {
+		i, err := strconv.Atoi(segments[0])
+		if err != nil || i < 0 || i >= len(p) {
+			return nil
+		}
+		value = p[i].Value
+	}
+	for _, segment := range segments[1:] {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[segment]
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil
+			}
+			value = v[i]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// TableName db table of rows
+func (t *Table) TableName() string {
+	return "mapping_" + t.Name
+}
+
+func (t *Table) IsEvent() bool {
+	return t.Event != ""
+}
+
+// IndexColumn event_index of event rows, extrinsic_index of call rows
+func (t *Table) IndexColumn() string {
+	if t.IsEvent() {
+		return ColumnEventIndex
+	}
+	return ColumnExtrinsicIndex
+}
+
+// BuiltinColumns columns of every row before declared columns, call_path only of call rows
+func (t *Table) BuiltinColumns() []string {
+	columns := []string{ColumnId, ColumnBlockNum, ColumnBlockTimestamp, t.IndexColumn()}
+	if !t.IsEvent() {
+		columns = append(columns, ColumnCallPath)
+	}
+	return columns
+}
+
+// KeysIndex name of unique index of keys, derived from key set so a changed key set is a new index,
+// index names are unique of database in postgres
+func (t *Table) KeysIndex() string {
+	sum := sha1.Sum([]byte(t.TableName() + ":" + strings.Join(t.Keys, ",")))
+	return fmt.Sprintf("mapping_keys_%x", sum[:8])
+}
+
+// Module lower module id, as subscribed by plugin
+func (t *Table) Module() string {
+	return strings.ToLower(t.module)
+}
+
+// Match event or call of table
+func (t *Table) Match(module, method string) bool {
+	return strings.EqualFold(t.module, module) && strings.EqualFold(t.method, method)
+}
+
+// Column column by name, built-in columns are not included
+func (t *Table) Column(name string) *Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// Filterable columns can be filtered by api, built-in, key and indexed columns
+func (t *Table) Filterable(name string) bool {
+	if name == ColumnBlockNum || name == t.IndexColumn() || util.StringInSlice(name, t.Keys) {
+		return true
+	}
+	c := t.Column(name)
+	return c != nil && c.Index
+}
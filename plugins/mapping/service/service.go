@@ -0,0 +1,348 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/plugins/mapping/dao"
+	"github.com/itering/subscan/plugins/mapping/model"
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/address"
+	"github.com/shopspring/decimal"
+)
+
+type Service struct {
+	d    storage.Dao
+	spec *model.Spec
+}
+
+func New(d storage.Dao, spec *model.Spec) *Service {
+	return &Service{d: d, spec: spec}
+}
+
+// LoadSpec load and validate mapping file
+func LoadSpec(path string) (*model.Spec, error) {
+	c := config.New(config.WithSource(file.NewSource(path)))
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		return nil, err
+	}
+	spec := new(model.Spec)
+	if err := c.Scan(spec); err != nil {
+		return nil, err
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func (s *Service) Table(name string) *model.Table {
+	for _, t := range s.spec.Tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Service) Migrate() error {
+	for _, t := range s.spec.Tables {
+		if err := dao.Migrate(s.d, t); err != nil {
+			return fmt.Errorf("migrate %s: %w", t.TableName(), err)
+		}
+	}
+	return nil
+}
+
+// EmitEvent save rows of tables capture the event
+func (s *Service) EmitEvent(ctx context.Context, block *storage.Block, event *storage.Event) error {
+	var params model.Params
+	for _, t := range s.spec.Tables {
+		if !t.IsEvent() || !t.Match(event.ModuleId, event.EventId) {
+			continue
+		}
+		if params == nil {
+			if err := decodeParams(event.Params, &params); err != nil {
+				return fmt.Errorf("decode params of event %d-%d: %w", event.BlockNum, event.EventIdx, err)
+			}
+		}
+		row := baseRow(block, t.IndexColumn(), fmt.Sprintf("%d-%d", event.BlockNum, event.EventIdx))
+		for _, c := range t.Columns {
+			var value interface{}
+			if c.Param != "" {
+				value = params.Lookup(c.Param)
+			} else {
+				value = eventField(c.Field, block, event)
+			}
+			row[c.Name] = convert(c.Type, value)
+		}
+		if err := dao.SaveRow(ctx, s.d, t, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EmitExtrinsic save rows of tables capture the call or nested calls of utility/proxy/multisig/sudo wrappers,
+// params of nested call exclude its call params, success of nested call is attributed by events of extrinsic
+func (s *Service) EmitExtrinsic(ctx context.Context, block *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) error {
+	var raw []interface{}
+	if err := decodeParams(extrinsic.Params, &raw); err != nil {
+		return fmt.Errorf("decode params of extrinsic %s: %w", extrinsic.ExtrinsicIndex, err)
+	}
+	tree := substrate.ParseCallTree(extrinsic.CallModule, extrinsic.CallModuleFunction, raw)
+	attributed := false
+	for _, call := range append([]*substrate.Call{tree}, tree.SubCalls()...) {
+		var params model.Params
+		for _, t := range s.spec.Tables {
+			if t.IsEvent() || !t.Match(call.CallModule, call.CallModuleFunction) {
+				continue
+			}
+			if params == nil {
+				if call == tree {
+					if err := decodeParams(extrinsic.Params, &params); err != nil {
+						return fmt.Errorf("decode params of extrinsic %s: %w", extrinsic.ExtrinsicIndex, err)
+					}
+				} else {
+					params = make(model.Params, 0, len(call.Params))
+					for _, param := range call.Params {
+						params = append(params, model.Param{Name: param.Name, Value: param.Value})
+					}
+				}
+			}
+			if call != tree && !attributed {
+				tree.AttributeEvents(callEvents(events))
+				attributed = true
+			}
+			row := baseRow(block, t.IndexColumn(), extrinsic.ExtrinsicIndex)
+			row[model.ColumnCallPath] = call.Path
+			for _, c := range t.Columns {
+				var value interface{}
+				if c.Param != "" {
+					value = params.Lookup(c.Param)
+				} else {
+					value = extrinsicField(c.Field, block, extrinsic, call)
+				}
+				row[c.Name] = convert(c.Type, value)
+			}
+			if err := dao.SaveRow(ctx, s.d, t, row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// callEvents events of extrinsic replayed by call tree
+func callEvents(events []storage.Event) []substrate.CallEvent {
+	list := make([]substrate.CallEvent, 0, len(events))
+	for _, event := range events {
+		var params []storage.EventParam
+		_ = util.UnmarshalAny(&params, event.Params)
+		callEvent := substrate.CallEvent{EventIndex: fmt.Sprintf("%d-%d", event.BlockNum, event.EventIdx), EventIdx: event.EventIdx, ModuleId: event.ModuleId, EventId: event.EventId}
+		for _, param := range params {
+			callEvent.Params = append(callEvent.Params, param.Value)
+		}
+		list = append(list, callEvent)
+	}
+	return list
+}
+
+// Rollback delete rows of every table from block num
+func (s *Service) Rollback(ctx context.Context, from uint) error {
+	for _, t := range s.spec.Tables {
+		if err := dao.DeleteRows(ctx, s.d, t, from); err != nil {
+			return fmt.Errorf("rollback %s: %w", t.TableName(), err)
+		}
+	}
+	return nil
+}
+
+// decodeParams numbers are decoded as json.Number, balances exceed float64 precision
+func decodeParams(raw []byte, params interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	return decoder.Decode(params)
+}
+
+func baseRow(block *storage.Block, indexColumn, index string) map[string]interface{} {
+	return map[string]interface{}{
+		model.ColumnBlockNum:       block.BlockNum,
+		model.ColumnBlockTimestamp: block.BlockTimestamp,
+		indexColumn:                index,
+	}
+}
+
+func eventField(field string, block *storage.Block, event *storage.Event) interface{} {
+	switch field {
+	case "block_hash":
+		return block.Hash
+	case "spec_version":
+		return block.SpecVersion
+	case "extrinsic_index":
+		return fmt.Sprintf("%d-%d", event.BlockNum, event.ExtrinsicIdx)
+	case "extrinsic_hash":
+		return event.ExtrinsicHash
+	case "event_idx":
+		return event.EventIdx
+	}
+	return nil
+}
+
+// extrinsicField derived field of call, success of nested call is false if the call or extrinsic failed
+func extrinsicField(field string, block *storage.Block, extrinsic *storage.Extrinsic, call *substrate.Call) interface{} {
+	switch field {
+	case "block_hash":
+		return block.Hash
+	case "spec_version":
+		return block.SpecVersion
+	case "extrinsic_index":
+		return extrinsic.ExtrinsicIndex
+	case "extrinsic_hash":
+		return extrinsic.ExtrinsicHash
+	case "account_id":
+		return extrinsic.AccountId
+	case "success":
+		return extrinsic.Success && call.Success
+	case "fee":
+		return extrinsic.Fee
+	}
+	return nil
+}
+
+// convert value of param or field to column type
+func convert(typ string, value interface{}) interface{} {
+	switch typ {
+	case model.TypeAddress:
+		return toAddress(value)
+	case model.TypeInt:
+		return toDecimal(value).IntPart()
+	case model.TypeDecimal:
+		return toDecimal(value)
+	case model.TypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string:
+			b, _ := strconv.ParseBool(v)
+			return b
+		}
+		return !toDecimal(value).IsZero()
+	case model.TypeJson:
+		b, _ := json.Marshal(value)
+		return string(b)
+	}
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	return util.ToString(value)
+}
+
+// toAddress account id of db format, MultiAddress like {"Id": "0x..."} is unwrapped
+func toAddress(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		if accountId := address.Format(v); accountId != "" {
+			return accountId
+		}
+		if accountId := address.Format(address.Decode(v)); accountId != "" {
+			return accountId
+		}
+		return v
+	case map[string]interface{}:
+		if len(v) == 1 {
+			for _, inner := range v {
+				return toAddress(inner)
+			}
+		}
+	}
+	return ""
+}
+
+func toDecimal(value interface{}) decimal.Decimal {
+	switch v := value.(type) {
+	case json.Number:
+		d, _ := decimal.NewFromString(v.String())
+		return d
+	case string:
+		if strings.HasPrefix(v, "0x") {
+			if i, ok := new(big.Int).SetString(v[2:], 16); ok {
+				return decimal.NewFromBigInt(i, 0)
+			}
+			return decimal.Zero
+		}
+	case bool:
+		if v {
+			return decimal.New(1, 0)
+		}
+		return decimal.Zero
+	}
+	return util.DecimalFromInterface(value)
+}
+
+// GetRowsCursor rows of table, addresses are encoded and json columns are decoded
+func (s *Service) GetRowsCursor(ctx context.Context, t *model.Table, filters map[string]interface{}, limit int, before, after *uint) ([]map[string]interface{}, map[string]interface{}) {
+	list, hasPrev, hasNext := dao.RowsCursor(ctx, s.d, t, filters, limit, before, after)
+	for _, row := range list {
+		for _, c := range t.Columns {
+			switch c.Type {
+			case model.TypeAddress:
+				if accountId, _ := row[c.Name].(string); accountId != "" {
+					row[c.Name] = address.Encode(accountId)
+				}
+			case model.TypeJson:
+				if raw, _ := row[c.Name].(string); raw != "" && json.Valid([]byte(raw)) {
+					row[c.Name] = json.RawMessage(raw)
+				}
+			}
+		}
+	}
+	var start, end interface{}
+	if len(list) > 0 {
+		start = list[0][model.ColumnId]
+		end = list[len(list)-1][model.ColumnId]
+	}
+	return list, map[string]interface{}{
+		"start_cursor":      start,
+		"end_cursor":        end,
+		"has_previous_page": hasPrev,
+		"has_next_page":     hasNext,
+	}
+}
+
+// Filters column filters of api, values are converted to column type
+func (s *Service) Filters(t *model.Table, params map[string]interface{}) (map[string]interface{}, error) {
+	filters := make(map[string]interface{})
+	for name, value := range params {
+		if !t.Filterable(name) {
+			return nil, fmt.Errorf("column %s is not filterable", name)
+		}
+		switch name {
+		case model.ColumnBlockNum:
+			filters[name] = toDecimal(value).IntPart()
+		case t.IndexColumn(), model.ColumnCallPath:
+			filters[name] = util.ToString(value)
+		default:
+			filters[name] = convert(t.Column(name).Type, value)
+		}
+	}
+	return filters, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/plugins/mapping/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+const alice = "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+
+const spec = `
+tables:
+  - name: poi_submissions
+    event: PalletCbcPoi.PoiSubmitted
+    columns:
+      - name: account
+        param: who
+        type: address
+        index: true
+      - name: amount
+        param: "1"
+        type: decimal
+      - name: score
+        param: info.scores.0
+        type: int
+      - name: info
+        param: info
+        type: json
+      - name: extrinsic_index
+        field: extrinsic_index
+  - name: stakes
+    call: PalletCbcPos.stake
+    keys: [account]
+    upsert: true
+    columns:
+      - name: account
+        field: account_id
+      - name: value
+        param: value
+        type: decimal
+`
+
+// dryRunDao sql of dry run db is recorded
+type dryRunDao struct {
+	storage.Dao
+	db  *gorm.DB
+	sql []string
+}
+
+func newDryRunDao(t *testing.T) *dryRunDao {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "root@tcp(127.0.0.1:3306)/subscan", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+	d := &dryRunDao{db: db}
+	record := func(tx *gorm.DB) {
+		d.sql = append(d.sql, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	}
+	_ = db.Callback().Create().After("gorm:create").Register("test:record", record)
+	_ = db.Callback().Delete().After("gorm:delete").Register("test:record", record)
+	return d
+}
+
+func (d *dryRunDao) GetDbInstance() any { return d.db }
+
+func loadSpec(t *testing.T, content string) (*model.Spec, error) {
+	path := filepath.Join(t.TempDir(), "mapping.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return LoadSpec(path)
+}
+
+func TestLoadSpec(t *testing.T) {
+	s, err := loadSpec(t, spec)
+	assert.NoError(t, err)
+	if !assert.Len(t, s.Tables, 2) {
+		return
+	}
+	events := s.Tables[0]
+	assert.Equal(t, "mapping_poi_submissions", events.TableName())
+	assert.Equal(t, "palletcbcpoi", events.Module())
+	assert.Equal(t, []string{model.ColumnEventIndex}, events.Keys)
+	assert.Equal(t, model.TypeString, events.Column("extrinsic_index").Type)
+	assert.True(t, events.Filterable("account"))
+	assert.True(t, events.Filterable("block_num"))
+	assert.False(t, events.Filterable("amount"))
+	assert.Equal(t, model.TypeAddress, s.Tables[1].Column("account").Type)
+
+	for _, invalid := range []string{
+		"tables: [{name: a, event: Balances}]",
+		"tables: [{name: a, event: Balances.Transfer, call: Balances.transfer}]",
+		"tables: [{name: A, event: Balances.Transfer}]",
+		"tables: [{name: a, event: Balances.Transfer}, {name: a, event: Balances.Deposit}]",
+		"tables: [{name: a, event: Balances.Transfer, columns: [{name: block_num, param: from}]}]",
+		"tables: [{name: a, event: Balances.Transfer, columns: [{name: row, param: from}]}]",
+		"tables: [{name: a, event: Balances.Transfer, columns: [{name: from, param: from, type: float}]}]",
+		"tables: [{name: a, event: Balances.Transfer, columns: [{name: signer, field: account_id}]}]",
+		"tables: [{name: a, event: Balances.Transfer, keys: [to]}]",
+	} {
+		_, err = loadSpec(t, invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestService_EmitEvent(t *testing.T) {
+	s, err := loadSpec(t, spec)
+	assert.NoError(t, err)
+	d := newDryRunDao(t)
+	srv := New(d, s)
+	block := &storage.Block{BlockNum: 10, BlockTimestamp: 1700000000}
+	params := []byte(`[{"name":"who","type":"AccountId","value":"0x` + alice + `"},{"type":"Balance","value":123456789012345678901234567890},{"name":"info","value":{"scores":[7,8]}}]`)
+
+	assert.NoError(t, srv.EmitEvent(context.TODO(), block, &storage.Event{BlockNum: 10, EventIdx: 2, ExtrinsicIdx: 1, ModuleId: "palletcbcpoi", EventId: "PoiSubmitted", Params: params}))
+	// not captured
+	assert.NoError(t, srv.EmitEvent(context.TODO(), block, &storage.Event{BlockNum: 10, EventIdx: 3, ModuleId: "balances", EventId: "Transfer", Params: params}))
+	if assert.Len(t, d.sql, 1) {
+		assert.Equal(t, "INSERT INTO `mapping_poi_submissions` (`account`,`amount`,`block_num`,`block_timestamp`,`event_index`,`extrinsic_index`,`info`,`score`) "+
+			"VALUES ('"+alice+"','123456789012345678901234567890',10,1700000000,'10-2','10-1','{\"scores\":[7,8]}',7) ON DUPLICATE KEY UPDATE `id`=`id`", d.sql[0])
+	}
+}
+
+func TestService_EmitExtrinsic(t *testing.T) {
+	s, err := loadSpec(t, spec)
+	assert.NoError(t, err)
+	d := newDryRunDao(t)
+	srv := New(d, s)
+	block := &storage.Block{BlockNum: 10, BlockTimestamp: 1700000000}
+	extrinsic := &storage.Extrinsic{ExtrinsicIndex: "10-1", CallModule: "PalletCbcPos", CallModuleFunction: "stake", AccountId: alice, Fee: decimal.New(1, 10),
+		Params: []byte(`[{"name":"value","type":"Compact<Balance>","value":"0x0de0b6b3a7640000"}]`)}
+
+	assert.NoError(t, srv.EmitExtrinsic(context.TODO(), block, extrinsic, nil))
+	if assert.Len(t, d.sql, 1) {
+		assert.Equal(t, "INSERT INTO `mapping_stakes` (`account`,`block_num`,`block_timestamp`,`call_path`,`extrinsic_index`,`value`) "+
+			"VALUES ('"+alice+"',10,1700000000,'','10-1','1000000000000000000') "+
+			"ON DUPLICATE KEY UPDATE `block_num`=VALUES(`block_num`),`block_timestamp`=VALUES(`block_timestamp`),`call_path`=VALUES(`call_path`),`extrinsic_index`=VALUES(`extrinsic_index`),`value`=VALUES(`value`)", d.sql[0])
+	}
+}
+
+func TestService_EmitNestedExtrinsic(t *testing.T) {
+	s, err := loadSpec(t, `
+tables:
+  - name: transfers
+    call: Balances.transfer_keep_alive
+    columns:
+      - name: value
+        param: value
+        type: decimal
+      - name: success
+        field: success
+`)
+	assert.NoError(t, err)
+	table := s.Tables[0]
+	assert.Equal(t, []string{model.ColumnExtrinsicIndex, model.ColumnCallPath}, table.Keys)
+	d := newDryRunDao(t)
+	srv := New(d, s)
+	block := &storage.Block{BlockNum: 10, BlockTimestamp: 1700000000}
+	transfer := `{"call_module":"Balances","call_name":"transfer_keep_alive","params":[{"name":"value","type":"Compact<Balance>","value":123456789012345678901234567890}]}`
+	extrinsic := &storage.Extrinsic{ExtrinsicIndex: "10-1", CallModule: "Utility", CallModuleFunction: "force_batch", Success: true,
+		Params: []byte(`[{"name":"calls","type":"Vec<Call>","value":[` + transfer + `,{"call_module":"Proxy","call_name":"proxy","params":[{"name":"call","type":"Call","value":` + transfer + `}]}]}]`)}
+	events := []storage.Event{
+		{BlockNum: 10, EventIdx: 0, ModuleId: "Balances", EventId: "Transfer"},
+		{BlockNum: 10, EventIdx: 1, ModuleId: "Utility", EventId: "ItemCompleted"},
+		{BlockNum: 10, EventIdx: 2, ModuleId: "Proxy", EventId: "ProxyExecuted", Params: []byte(`[{"type":"DispatchResult","value":{"Err":{"Token":"FundsUnavailable"}}}]`)},
+		{BlockNum: 10, EventIdx: 3, ModuleId: "Utility", EventId: "ItemCompleted"},
+		{BlockNum: 10, EventIdx: 4, ModuleId: "Utility", EventId: "BatchCompleted"},
+	}
+
+	assert.NoError(t, srv.EmitExtrinsic(context.TODO(), block, extrinsic, events))
+	if assert.Len(t, d.sql, 2) {
+		assert.Equal(t, "INSERT INTO `mapping_transfers` (`block_num`,`block_timestamp`,`call_path`,`extrinsic_index`,`success`,`value`) "+
+			"VALUES (10,1700000000,'0','10-1',true,'123456789012345678901234567890') ON DUPLICATE KEY UPDATE `id`=`id`", d.sql[0])
+		assert.Equal(t, "INSERT INTO `mapping_transfers` (`block_num`,`block_timestamp`,`call_path`,`extrinsic_index`,`success`,`value`) "+
+			"VALUES (10,1700000000,'1.0','10-1',false,'123456789012345678901234567890') ON DUPLICATE KEY UPDATE `id`=`id`", d.sql[1])
+	}
+}
+
+func TestService_Rollback(t *testing.T) {
+	s, err := loadSpec(t, spec)
+	assert.NoError(t, err)
+	d := newDryRunDao(t)
+	assert.NoError(t, New(d, s).Rollback(context.TODO(), 100))
+	assert.Equal(t, []string{
+		"DELETE FROM `mapping_poi_submissions` WHERE block_num >= 100",
+		"DELETE FROM `mapping_stakes` WHERE block_num >= 100",
+	}, d.sql)
+}
+
+func TestTable_KeysIndex(t *testing.T) {
+	s, err := loadSpec(t, spec)
+	assert.NoError(t, err)
+	table := s.Tables[1]
+	index := table.KeysIndex()
+	assert.LessOrEqual(t, len(index), 64)
+	table.Keys = []string{"account", "value"}
+	assert.NotEqual(t, index, table.KeysIndex())
+	assert.NotEqual(t, s.Tables[0].KeysIndex(), index)
+}
+
+func TestService_Filters(t *testing.T) {
+	s, err := loadSpec(t, spec)
+	assert.NoError(t, err)
+	srv := New(nil, s)
+	table := srv.Table("poi_submissions")
+
+	filters, err := srv.Filters(table, map[string]interface{}{"account": "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", "block_num": "10"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"account": alice, "block_num": int64(10)}, filters)
+
+	_, err = srv.Filters(table, map[string]interface{}{"amount": "1"})
+	assert.EqualError(t, err, "column amount is not filterable")
+	assert.Nil(t, srv.Table("unknown"))
+}
+
+func TestConvert(t *testing.T) {
+	assert.Equal(t, alice, convert(model.TypeAddress, map[string]interface{}{"Id": alice}))
+	assert.Equal(t, "", convert(model.TypeAddress, nil))
+	assert.Equal(t, true, convert(model.TypeBool, "true"))
+	assert.Equal(t, false, convert(model.TypeBool, nil))
+	assert.Equal(t, "", convert(model.TypeString, nil))
+	assert.Equal(t, `{"a":1}`, convert(model.TypeString, map[string]interface{}{"a": 1}))
+	assert.Equal(t, "null", convert(model.TypeJson, nil))
+	assert.Equal(t, int64(255), convert(model.TypeInt, "0xff"))
+}
@@ -1,9 +1,14 @@
 package plugins
 
 import (
+	"context"
 	"github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/storage"
 	"github.com/itering/subscan/plugins/balance"
 	"github.com/itering/subscan/plugins/evm"
+	"github.com/itering/subscan/plugins/external"
+	"github.com/itering/subscan/plugins/mapping"
+	"github.com/itering/subscan/plugins/relation"
 	"github.com/itering/subscan/plugins/system"
 	"reflect"
 	"strings"
@@ -18,6 +23,9 @@ func init() {
 	registerNative(balance.New())
 	registerNative(system.New())
 	registerNative(evm.New())
+	registerNative(relation.New())
+	registerNative(mapping.New())
+	wireAccountRelation()
 }
 
 func register(name string, f subscan_plugin.Plugin) {
@@ -38,3 +46,72 @@ func register(name string, f subscan_plugin.Plugin) {
 func registerNative(p subscan_plugin.Plugin) {
 	register(reflect.ValueOf(p).Type().Elem().Name(), p)
 }
+
+// RegisterExternal start and register external plugins of dir, native plugin with the same name take precedence
+func RegisterExternal(dir string) error {
+	list, err := external.Discover(dir)
+	if err != nil {
+		return err
+	}
+	for _, p := range list {
+		if _, ok := RegisteredPlugins[p.Name()]; ok {
+			p.Close()
+			continue
+		}
+		register(p.Name(), p)
+	}
+	return nil
+}
+
+// CloseExternal stop external plugin processes
+func CloseExternal() {
+	external.Close()
+}
+
+// Rollbacker plugin delete data of blocks from block num, called before checkpoint reset so blocks are delivered to a clean state
+type Rollbacker interface {
+	Rollback(ctx context.Context, from uint) error
+}
+
+// BlockCommitter plugin notified after checkpoint of a delivered block committed,
+// effects out of database like analytics rows are pushed only for committed blocks
+type BlockCommitter interface {
+	CommitBlock(ctx context.Context, blockNum uint)
+}
+
+// TxDeliverer plugin write data of a delivered block only through d, d is bound to the transaction advancing plugin checkpoint
+// so a block is committed exactly once, deliver is called with plugin processing through d
+type TxDeliverer interface {
+	DeliverTx(d storage.Dao, deliver func(p subscan_plugin.Plugin) error) error
+}
+
+// HealthReporter plugin report health itself, like external plugin process
+type HealthReporter interface {
+	Health(ctx context.Context) error
+}
+
+// AccountRelationProvider plugin provide multisig and proxy relationships of account, like relation
+type AccountRelationProvider interface {
+	AccountRelation(ctx context.Context, addr string) interface{}
+}
+
+// AccountRelationViewer plugin show relationships of account on its account view, like balance
+type AccountRelationViewer interface {
+	SetAccountRelation(relation func(ctx context.Context, addr string) interface{})
+}
+
+// wireAccountRelation hand the registered relationship provider to viewers, plugins never import each other
+func wireAccountRelation() {
+	for _, plugin := range RegisteredPlugins {
+		provider, ok := plugin.(AccountRelationProvider)
+		if !ok {
+			continue
+		}
+		for _, p := range RegisteredPlugins {
+			if viewer, ok := p.(AccountRelationViewer); ok {
+				viewer.SetAccountRelation(provider.AccountRelation)
+			}
+		}
+		return
+	}
+}
@@ -65,3 +65,10 @@ func TestRegister(t *testing.T) {
 	assert.NotNil(t, RegisteredPlugins["test"])
 	assert.Nil(t, RegisteredPlugins["test2"])
 }
+
+func TestWireAccountRelation(t *testing.T) {
+	assert.Implements(t, (*AccountRelationProvider)(nil), RegisteredPlugins["relation"])
+	assert.Implements(t, (*AccountRelationViewer)(nil), RegisteredPlugins["balance"])
+	// relation plugin not initialized yet
+	assert.Nil(t, RegisteredPlugins["relation"].(AccountRelationProvider).AccountRelation(context.TODO(), "addr"))
+}
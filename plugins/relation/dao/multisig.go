@@ -0,0 +1,188 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/model"
+	rModel "github.com/itering/subscan/plugins/relation/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func CreateMultisig(ctx context.Context, db storage.DB, multisig *rModel.Multisig) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Scopes(model.IgnoreDuplicate).Create(multisig).Error; err != nil {
+			return err
+		}
+		var signatories []rModel.MultisigSignatory
+		for _, signatory := range multisig.Signatories {
+			signatories = append(signatories, rModel.MultisigSignatory{Multisig: multisig.Address, Signatory: signatory, BlockNum: multisig.BlockNum})
+		}
+		return tx.Scopes(model.IgnoreDuplicate).Create(&signatories).Error
+	})
+}
+
+func GetMultisig(ctx context.Context, db storage.DB, address string) *rModel.Multisig {
+	var multisig rModel.Multisig
+	d := db.GetDbInstance().(*gorm.DB)
+	if err := d.WithContext(ctx).Where("address = ?", address).First(&multisig).Error; err != nil {
+		return nil
+	}
+	var signatories []rModel.MultisigSignatory
+	d.WithContext(ctx).Where("multisig = ?", address).Order("id asc").Find(&signatories)
+	for _, signatory := range signatories {
+		multisig.Signatories = append(multisig.Signatories, signatory.Signatory)
+	}
+	return &multisig
+}
+
+// GetSignatoryMultisig multisig accounts of signatory
+func GetSignatoryMultisig(ctx context.Context, db storage.DB, signatory string) (list []string) {
+	d := db.GetDbInstance().(*gorm.DB)
+	d.WithContext(ctx).Model(&rModel.MultisigSignatory{}).Where("signatory = ?", signatory).Order("id asc").Pluck("multisig", &list)
+	return
+}
+
+// NewMultisigOperation NewMultisig event, depositor is the first approval
+func NewMultisigOperation(ctx context.Context, db storage.DB, operation *rModel.MultisigOperation, extrinsicIndex string) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Scopes(model.IgnoreDuplicate).Create(operation).Error; err != nil {
+			return err
+		}
+		if operation.ID == 0 {
+			if err := findOperation(tx, operation); err != nil {
+				return err
+			}
+		}
+		return createApproval(tx, operation.ID, operation.Depositor, extrinsicIndex, operation.BlockNum)
+	})
+}
+
+// UpdateMultisigOperation MultisigApproval/MultisigExecuted/MultisigCancelled event
+// approving account is recorded as approval except cancelled
+func UpdateMultisigOperation(ctx context.Context, db storage.DB, operation *rModel.MultisigOperation, approving, extrinsicIndex string) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		status, success, executed, blockNum := operation.Status, operation.Success, operation.ExecutedExtrinsicIndex, operation.BlockNum
+		if err := findOperation(tx, operation); err != nil {
+			if err != gorm.ErrRecordNotFound {
+				return err
+			}
+			// NewMultisig not indexed
+			operation.Status = rModel.MultisigPending
+			if err = tx.Scopes(model.IgnoreDuplicate).Create(operation).Error; err != nil {
+				return err
+			}
+		}
+		if status != rModel.MultisigPending {
+			if err := tx.Model(operation).Updates(map[string]interface{}{
+				"status": status, "success": success, "executed_extrinsic_index": executed,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		if status == rModel.MultisigCancelled {
+			return nil
+		}
+		return createApproval(tx, operation.ID, approving, extrinsicIndex, blockNum)
+	})
+}
+
+func findOperation(tx *gorm.DB, operation *rModel.MultisigOperation) error {
+	return tx.Where("multisig = ? and call_hash = ? and timepoint_height = ? and timepoint_index = ?",
+		operation.Multisig, operation.CallHash, operation.TimepointHeight, operation.TimepointIndex).First(operation).Error
+}
+
+func createApproval(tx *gorm.DB, operationId uint, account, extrinsicIndex string, blockNum uint) error {
+	if account == "" {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rModel.MultisigApproval{
+		OperationId: operationId, Account: account, ExtrinsicIndex: extrinsicIndex, BlockNum: blockNum,
+	}).Error
+}
+
+// DeleteRelations delete multisig and proxy relationships indexed from block num,
+// relationships removed or updated from block num are removed or updated again when blocks are delivered again
+func DeleteRelations(ctx context.Context, db storage.DB, from uint) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	for _, m := range []interface{}{&rModel.Multisig{}, &rModel.MultisigSignatory{}, &rModel.MultisigOperation{}, &rModel.MultisigApproval{}, &rModel.Proxy{}} {
+		if err := d.WithContext(ctx).Where("block_num >= ?", from).Delete(m).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func MultisigOperationsCursor(ctx context.Context, db storage.DB, multisig, status string, limit int, before, after *uint) ([]rModel.MultisigOperation, bool, bool) {
+	var list []rModel.MultisigOperation
+	d := db.GetDbInstance().(*gorm.DB)
+	fetch := limit + 1
+	var hasPrev, hasNext bool
+	q := d.WithContext(ctx).Model(&rModel.MultisigOperation{}).Where("multisig = ?", multisig)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	if after != nil && *after > 0 {
+		q = q.Where("id < ?", *after).Order("id desc")
+	} else if before != nil && *before > 0 {
+		q = q.Where("id > ?", *before).Order("id asc")
+	} else {
+		q = q.Order("id desc")
+	}
+	if err := q.Limit(fetch).Find(&list).Error; err != nil {
+		return nil, false, false
+	}
+	if before != nil && *before > 0 {
+		hasPrev = len(list) > limit
+		if hasPrev {
+			list = list[:limit]
+		}
+		for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+			list[i], list[j] = list[j], list[i]
+		}
+		hasNext = true
+	} else {
+		hasNext = len(list) > limit
+		if hasNext {
+			list = list[:limit]
+		}
+		hasPrev = after != nil && *after > 0
+	}
+	fillApprovals(d.WithContext(ctx), list)
+	return list, hasPrev, hasNext
+}
+
+func fillApprovals(d *gorm.DB, list []rModel.MultisigOperation) {
+	if len(list) == 0 {
+		return
+	}
+	var ids []uint
+	for _, operation := range list {
+		ids = append(ids, operation.ID)
+	}
+	var approvals []rModel.MultisigApproval
+	d.Where("operation_id in ?", ids).Order("id asc").Find(&approvals)
+	for i := range list {
+		for _, approval := range approvals {
+			if approval.OperationId == list[i].ID {
+				list[i].Approvals = append(list[i].Approvals, approval)
+			}
+		}
+	}
+}
+
+// PendingMultisigOperations pending operations of multisig accounts
+func PendingMultisigOperations(ctx context.Context, db storage.DB, multisig []string) []rModel.MultisigOperation {
+	if len(multisig) == 0 {
+		return nil
+	}
+	var list []rModel.MultisigOperation
+	d := db.GetDbInstance().(*gorm.DB).WithContext(ctx)
+	d.Where("multisig in ? and status = ?", multisig, rModel.MultisigPending).Order("id desc").Limit(100).Find(&list)
+	fillApprovals(d, list)
+	return list
+}
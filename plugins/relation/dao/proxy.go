@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/model"
+	rModel "github.com/itering/subscan/plugins/relation/model"
+	"gorm.io/gorm"
+)
+
+func AddProxy(ctx context.Context, db storage.DB, proxy *rModel.Proxy) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Scopes(model.IgnoreDuplicate).Create(proxy).Error
+}
+
+func RemoveProxy(ctx context.Context, db storage.DB, proxy *rModel.Proxy) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Where("delegator = ? and delegatee = ? and proxy_type = ? and delay = ?",
+		proxy.Delegator, proxy.Delegatee, proxy.ProxyType, proxy.Delay).Delete(&rModel.Proxy{}).Error
+}
+
+// RemoveDelegatorProxies remove all proxies of delegator, like pure proxy killed
+func RemoveDelegatorProxies(ctx context.Context, db storage.DB, delegator string) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Where("delegator = ?", delegator).Delete(&rModel.Proxy{}).Error
+}
+
+func GetProxies(ctx context.Context, db storage.DB, query interface{}, args ...interface{}) (list []rModel.Proxy) {
+	d := db.GetDbInstance().(*gorm.DB)
+	d.WithContext(ctx).Where(query, args...).Order("id asc").Find(&list)
+	return
+}
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"encoding/json"
+	"github.com/itering/subscan-plugin/router"
+	_ "github.com/itering/subscan/plugins/relation/model"
+	"github.com/itering/subscan/plugins/relation/service"
+	"github.com/itering/subscan/util/address"
+	"github.com/itering/subscan/util/validator"
+	"github.com/pkg/errors"
+	"net/http"
+)
+
+var (
+	svc *service.Service
+)
+
+func Router(s *service.Service) []router.Http {
+	svc = s
+	return []router.Http{
+		{Router: "account", Handle: accountHandle, Method: http.MethodPost},
+		{Router: "multisig/operations", Handle: multisigOperationsHandle, Method: http.MethodPost},
+	}
+}
+
+type accountParams struct {
+	Address string `json:"address" validate:"required,addr"`
+}
+
+// @Summary Get account multisig and proxy relationships
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param params body accountParams true "params"
+// @Success 200 {object} J{data=model.AccountRelation}
+// @Router /api/plugin/relation/account [post]
+func accountHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(accountParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	toJson(w, 0, svc.GetAccountRelation(r.Context(), address.Decode(p.Address)), nil)
+	return nil
+}
+
+type multisigOperationsParams struct {
+	Address string `json:"address" validate:"required,addr"`
+	Status  string `json:"status" validate:"omitempty,oneof=pending executed cancelled"`
+	Limit   int    `json:"row" validate:"min=1,max=100"`
+	Before  *uint  `json:"before" validate:"omitempty,min=0"`
+	After   *uint  `json:"after" validate:"omitempty,min=0"`
+}
+
+// @Summary Get multisig operations with approvals
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param params body multisigOperationsParams true "params"
+// @Success 200 {object} J{data=object{list=[]model.MultisigOperation,pagination=object}}
+// @Router /api/plugin/relation/multisig/operations [post]
+func multisigOperationsHandle(w http.ResponseWriter, r *http.Request) error {
+	p := new(multisigOperationsParams)
+	if err := validator.Validate(r.Body, p); err != nil {
+		toJson(w, 10001, nil, err)
+		return nil
+	}
+	list, page := svc.GetMultisigOperationsCursor(r.Context(), address.Decode(p.Address), p.Status, p.Limit, p.Before, p.After)
+	toJson(w, 0, map[string]interface{}{
+		"list": list, "pagination": page,
+	}, nil)
+	return nil
+}
+
+type J struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	TTL     int         `json:"ttl"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (j J) Render(w http.ResponseWriter) error {
+	header := w.Header()
+	if val := header["Content-Type"]; len(val) == 0 {
+		header["Content-Type"] = []string{"application/json; charset=utf-8"}
+	}
+	return nil
+}
+
+func (j J) WriteContentType(w http.ResponseWriter) {
+	var (
+		jsonBytes []byte
+		err       error
+	)
+	_ = j.Render(w)
+	if jsonBytes, err = json.Marshal(j); err != nil {
+		_ = errors.WithStack(err)
+		return
+	}
+	if _, err = w.Write(jsonBytes); err != nil {
+		_ = errors.WithStack(err)
+	}
+}
+
+func toJson(w http.ResponseWriter, code int, data interface{}, err error) {
+	j := J{
+		Message: "success",
+		TTL:     1,
+		Data:    data,
+	}
+	if err != nil {
+		j.Message = err.Error()
+	}
+	if code != 0 {
+		j.Code = code
+	}
+	j.WriteContentType(w)
+	_ = j.Render(w)
+}
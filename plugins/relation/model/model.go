@@ -0,0 +1,92 @@
+package model
+
+// Multisig multisig account derived from signatories and threshold
+type Multisig struct {
+	ID          uint     `gorm:"primary_key" json:"-"`
+	Address     string   `gorm:"size:100;index:multisig_address,unique" json:"address"`
+	Threshold   uint16   `json:"threshold"`
+	Signatories []string `gorm:"-" json:"signatories"`
+	BlockNum    uint     `gorm:"index" json:"block_num"`
+}
+
+func (m *Multisig) TableName() string {
+	return "relation_multisigs"
+}
+
+// MultisigSignatory signatory of multisig account
+type MultisigSignatory struct {
+	ID        uint   `gorm:"primary_key" json:"-"`
+	Multisig  string `gorm:"size:100;index:multisig_signatory,unique" json:"multisig"`
+	Signatory string `gorm:"size:100;index:multisig_signatory,unique;index:signatory" json:"signatory"`
+	BlockNum  uint   `gorm:"index" json:"-"`
+}
+
+func (m *MultisigSignatory) TableName() string {
+	return "relation_multisig_signatories"
+}
+
+const (
+	MultisigPending   = "pending"
+	MultisigExecuted  = "executed"
+	MultisigCancelled = "cancelled"
+)
+
+// MultisigOperation multisig call identified by multisig, call hash and timepoint
+type MultisigOperation struct {
+	ID                     uint               `gorm:"primary_key" json:"id"`
+	Multisig               string             `gorm:"size:100;index:multisig_operation,unique;index:multisig_status" json:"multisig"`
+	CallHash               string             `gorm:"size:100;index:multisig_operation,unique" json:"call_hash"`
+	TimepointHeight        uint               `gorm:"index:multisig_operation,unique" json:"timepoint_height"`
+	TimepointIndex         uint               `gorm:"index:multisig_operation,unique" json:"timepoint_index"`
+	Depositor              string             `gorm:"size:100" json:"depositor"`
+	Status                 string             `gorm:"size:20;index:multisig_status" json:"status"`
+	Success                bool               `json:"success"`
+	ExecutedExtrinsicIndex string             `gorm:"size:100" json:"executed_extrinsic_index"`
+	BlockNum               uint               `gorm:"index" json:"-"`
+	Approvals              []MultisigApproval `gorm:"-" json:"approvals"`
+}
+
+func (m *MultisigOperation) TableName() string {
+	return "relation_multisig_operations"
+}
+
+// MultisigApproval approval of multisig operation, include depositor
+type MultisigApproval struct {
+	ID             uint   `gorm:"primary_key" json:"-"`
+	OperationId    uint   `gorm:"index:operation_approval,unique" json:"-"`
+	Account        string `gorm:"size:100;index:operation_approval,unique" json:"account"`
+	ExtrinsicIndex string `gorm:"size:100" json:"extrinsic_index"`
+	BlockNum       uint   `gorm:"index" json:"-"`
+}
+
+func (m *MultisigApproval) TableName() string {
+	return "relation_multisig_approvals"
+}
+
+// Proxy delegator account can be controlled by delegatee account
+type Proxy struct {
+	ID                  uint   `gorm:"primary_key" json:"-"`
+	Delegator           string `gorm:"size:100;index:proxy_relation,unique;index:delegator" json:"delegator"`
+	Delegatee           string `gorm:"size:100;index:proxy_relation,unique;index:delegatee" json:"delegatee"`
+	ProxyType           string `gorm:"size:100;index:proxy_relation,unique" json:"proxy_type"`
+	Delay               uint   `gorm:"index:proxy_relation,unique" json:"delay"`
+	Pure                bool   `json:"pure"`
+	Spawner             string `gorm:"size:100;index:spawner" json:"spawner,omitempty"`
+	DisambiguationIndex uint   `json:"disambiguation_index"`
+	ExtrinsicIndex      string `gorm:"size:100" json:"extrinsic_index"`
+	BlockNum            uint   `gorm:"index" json:"-"`
+}
+
+func (m *Proxy) TableName() string {
+	return "relation_proxies"
+}
+
+// AccountRelation multisig and proxy relationships of account in both directions
+type AccountRelation struct {
+	Multisig         *Multisig           `json:"multisig"`
+	MultisigAccounts []string            `json:"multisig_accounts"`
+	PendingMultisig  []MultisigOperation `json:"pending_multisig"`
+	Proxies          []Proxy             `json:"proxies"`
+	ProxyFor         []Proxy             `json:"proxy_for"`
+	PureProxies      []Proxy             `json:"pure_proxies"`
+}
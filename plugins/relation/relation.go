@@ -0,0 +1,105 @@
+package relation
+
+import (
+	"context"
+	subscan_plugin "github.com/itering/subscan-plugin"
+	"github.com/itering/subscan-plugin/router"
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/plugins/relation/http"
+	"github.com/itering/subscan/plugins/relation/model"
+	"github.com/itering/subscan/plugins/relation/service"
+	"github.com/shopspring/decimal"
+	"github.com/urfave/cli"
+)
+
+var srv *service.Service
+
+// Relation index multisig accounts, multisig operations and proxy relationships
+type Relation struct {
+	d   storage.Dao
+	srv *service.Service
+}
+
+func New() *Relation {
+	return &Relation{}
+}
+
+func (a *Relation) Commands() []cli.Command {
+	return nil
+}
+
+func (a *Relation) ConsumptionQueue() []string {
+	return nil
+}
+
+func (a *Relation) Enable() bool {
+	return true
+}
+
+func (a *Relation) InitDao(d storage.Dao) {
+	a.srv = service.New(d)
+	srv = a.srv
+	a.d = d
+	a.Migrate()
+}
+
+// AccountRelation multisig and proxy relationships of account, shown on account view of balance plugin
+func (a *Relation) AccountRelation(ctx context.Context, addr string) interface{} {
+	if a.srv == nil {
+		return nil
+	}
+	return a.srv.GetAccountRelation(ctx, addr)
+}
+
+func (a *Relation) InitHttp() []router.Http {
+	return http.Router(srv)
+}
+
+func (a *Relation) ProcessBlock(context.Context, *storage.Block) error {
+	return nil
+}
+
+// DeliverTx multisig and proxy relationships of block are written through d
+func (a *Relation) DeliverTx(d storage.Dao, deliver func(subscan_plugin.Plugin) error) error {
+	return deliver(&Relation{d: d, srv: service.New(d)})
+}
+
+// Rollback delete relationships from block num before blocks delivered again
+func (a *Relation) Rollback(ctx context.Context, from uint) error {
+	return a.srv.Rollback(ctx, from)
+}
+
+func (a *Relation) ProcessExtrinsic(block *storage.Block, extrinsic *storage.Extrinsic, _ []storage.Event) error {
+	return a.srv.EmitExtrinsic(context.TODO(), block, extrinsic)
+}
+
+func (a *Relation) ProcessEvent(block *storage.Block, event *storage.Event, _ decimal.Decimal) error {
+	if event == nil {
+		return nil
+	}
+	return a.srv.EmitEvent(context.TODO(), block, event)
+}
+
+func (a *Relation) SubscribeExtrinsic() []string {
+	return []string{"multisig", "proxy", "utility", "sudo"}
+}
+
+func (a *Relation) SubscribeEvent() []string {
+	return []string{"multisig", "proxy"}
+}
+
+func (a *Relation) Migrate() {
+	_ = a.d.AutoMigration(&model.Multisig{})
+	_ = a.d.AutoMigration(&model.MultisigSignatory{})
+	_ = a.d.AutoMigration(&model.MultisigOperation{})
+	_ = a.d.AutoMigration(&model.MultisigApproval{})
+	_ = a.d.AutoMigration(&model.Proxy{})
+}
+
+func (a *Relation) SetRedisPool(subscan_plugin.RedisPool) {}
+
+func (a *Relation) Version() string {
+	return "0.1"
+}
+
+func (a *Relation) ExecWorker(context.Context, string, string, interface{}) error { return nil }
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/itering/subscan-plugin/storage"
+	cModel "github.com/itering/subscan/model"
+	"github.com/itering/subscan/plugins/relation/dao"
+	"github.com/itering/subscan/plugins/relation/model"
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/address"
+)
+
+type Service struct {
+	d storage.Dao
+}
+
+func New(d storage.Dao) *Service {
+	return &Service{d: d}
+}
+
+// EmitEvent multisig operation and proxy relationship events
+func (s *Service) EmitEvent(ctx context.Context, block *storage.Block, event *storage.Event) error {
+	var params []storage.EventParam
+	_ = util.UnmarshalAny(&params, event.Params)
+	extrinsicIndex := fmt.Sprintf("%d-%d", event.BlockNum, event.ExtrinsicIdx)
+
+	switch strings.ToLower(event.ModuleId) {
+	case "multisig":
+		switch event.EventId {
+		// [approving, multisig, call_hash]
+		case "NewMultisig":
+			if len(params) < 3 {
+				return nil
+			}
+			return dao.NewMultisigOperation(ctx, s.d, &model.MultisigOperation{
+				Multisig:        cModel.CheckoutParamValueAddress(params[1].Value),
+				CallHash:        util.AddHex(util.ToString(params[2].Value)),
+				TimepointHeight: uint(block.BlockNum),
+				TimepointIndex:  uint(event.ExtrinsicIdx),
+				Depositor:       cModel.CheckoutParamValueAddress(params[0].Value),
+				Status:          model.MultisigPending,
+				BlockNum:        uint(block.BlockNum),
+			}, extrinsicIndex)
+		// [approving, timepoint, multisig, call_hash, (result)]
+		case "MultisigApproval", "MultisigExecuted", "MultisigCancelled":
+			if len(params) < 4 {
+				return nil
+			}
+			var timepoint struct {
+				Height uint `json:"height"`
+				Index  uint `json:"index"`
+			}
+			_ = util.UnmarshalAny(&timepoint, params[1].Value)
+			operation := model.MultisigOperation{
+				Multisig:        cModel.CheckoutParamValueAddress(params[2].Value),
+				CallHash:        util.AddHex(util.ToString(params[3].Value)),
+				TimepointHeight: timepoint.Height,
+				TimepointIndex:  timepoint.Index,
+				Status:          model.MultisigPending,
+				BlockNum:        uint(block.BlockNum),
+			}
+			switch event.EventId {
+			case "MultisigExecuted":
+				operation.Status = model.MultisigExecuted
+				operation.ExecutedExtrinsicIndex = extrinsicIndex
+				operation.Success = true
+				if len(params) > 4 {
+					_, failed := substrate.DispatchResultError(params[4].Value)
+					operation.Success = !failed
+				}
+			case "MultisigCancelled":
+				operation.Status = model.MultisigCancelled
+				operation.ExecutedExtrinsicIndex = extrinsicIndex
+			}
+			return dao.UpdateMultisigOperation(ctx, s.d, &operation, cModel.CheckoutParamValueAddress(params[0].Value), extrinsicIndex)
+		}
+	case "proxy":
+		switch event.EventId {
+		// [delegator, delegatee, proxy_type, delay]
+		case "ProxyAdded", "ProxyRemoved":
+			if len(params) < 4 {
+				return nil
+			}
+			proxy := model.Proxy{
+				Delegator:      cModel.CheckoutParamValueAddress(params[0].Value),
+				Delegatee:      cModel.CheckoutParamValueAddress(params[1].Value),
+				ProxyType:      proxyType(params[2].Value),
+				Delay:          util.UIntFromInterface(params[3].Value),
+				ExtrinsicIndex: extrinsicIndex,
+				BlockNum:       uint(block.BlockNum),
+			}
+			if event.EventId == "ProxyRemoved" {
+				return dao.RemoveProxy(ctx, s.d, &proxy)
+			}
+			return dao.AddProxy(ctx, s.d, &proxy)
+		// [pure, who, proxy_type, disambiguation_index]
+		case "PureCreated", "AnonymousCreated":
+			if len(params) < 4 {
+				return nil
+			}
+			spawner := cModel.CheckoutParamValueAddress(params[1].Value)
+			return dao.AddProxy(ctx, s.d, &model.Proxy{
+				Delegator:           cModel.CheckoutParamValueAddress(params[0].Value),
+				Delegatee:           spawner,
+				ProxyType:           proxyType(params[2].Value),
+				Pure:                true,
+				Spawner:             spawner,
+				DisambiguationIndex: util.UIntFromInterface(params[3].Value),
+				ExtrinsicIndex:      extrinsicIndex,
+				BlockNum:            uint(block.BlockNum),
+			})
+		// [pure, spawner, proxy_type, disambiguation_index]
+		case "PureKilled":
+			if len(params) < 1 {
+				return nil
+			}
+			return dao.RemoveDelegatorProxies(ctx, s.d, cModel.CheckoutParamValueAddress(params[0].Value))
+		}
+	}
+	return nil
+}
+
+// EmitExtrinsic checkout multisig signatories and threshold from multisig calls, include nested calls
+func (s *Service) EmitExtrinsic(ctx context.Context, block *storage.Block, extrinsic *storage.Extrinsic) error {
+	origin := address.Format(extrinsic.AccountId)
+	if origin == "" {
+		return nil
+	}
+	tree := substrate.ParseCallTree(extrinsic.CallModule, extrinsic.CallModuleFunction, extrinsic.Params)
+	for _, multisig := range checkoutMultisig(tree, origin) {
+		multisig.BlockNum = uint(block.BlockNum)
+		if err := dao.CreateMultisig(ctx, s.d, multisig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback delete relationships indexed from block num
+func (s *Service) Rollback(ctx context.Context, from uint) error {
+	return dao.DeleteRelations(ctx, s.d, from)
+}
+
+// checkoutMultisig walk call tree with dispatch origin
+func checkoutMultisig(call *substrate.Call, origin string) (list []*model.Multisig) {
+	if origin == "" {
+		return nil
+	}
+	params := make(map[string]interface{})
+	for _, param := range call.Params {
+		params[param.Name] = param.Value
+	}
+	inner := origin
+	switch strings.ToLower(call.CallModule) + "." + strings.ToLower(call.CallModuleFunction) {
+	case "multisig.as_multi", "multisig.approve_as_multi", "multisig.cancel_as_multi", "multisig.as_multi_threshold_1":
+		threshold := uint16(1)
+		if v, ok := params["threshold"]; ok {
+			threshold = uint16(util.IntFromInterface(v))
+		}
+		var others []interface{}
+		_ = util.UnmarshalAny(&others, params["other_signatories"])
+		signatories := []string{origin}
+		for _, other := range others {
+			if signatory := cModel.CheckoutParamValueAddress(other); signatory != "" {
+				signatories = append(signatories, signatory)
+			}
+		}
+		multisig := &model.Multisig{Address: address.MultisigAccountId(signatories, threshold), Threshold: threshold, Signatories: signatories}
+		if multisig.Address == "" {
+			return nil
+		}
+		list = append(list, multisig)
+		inner = multisig.Address
+	case "proxy.proxy", "proxy.proxy_announced":
+		inner = cModel.CheckoutParamValueAddress(params["real"])
+	case "sudo.sudo_as":
+		inner = cModel.CheckoutParamValueAddress(params["who"])
+	case "utility.as_derivative":
+		inner = address.DerivativeAccountId(origin, uint16(util.IntFromInterface(params["index"])))
+	case "sudo.sudo", "sudo.sudo_unchecked_weight", "utility.dispatch_as":
+		// root or custom origin
+		inner = ""
+	}
+	for _, sub := range call.Calls {
+		list = append(list, checkoutMultisig(sub, inner)...)
+	}
+	return
+}
+
+func proxyType(raw interface{}) string {
+	switch v := raw.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		for k := range v {
+			return k
+		}
+	}
+	return util.ToString(raw)
+}
+
+// GetAccountRelation multisig and proxy relationships of account in both directions
+func (s *Service) GetAccountRelation(ctx context.Context, account string) *model.AccountRelation {
+	relation := model.AccountRelation{
+		Multisig:         dao.GetMultisig(ctx, s.d, account),
+		MultisigAccounts: dao.GetSignatoryMultisig(ctx, s.d, account),
+		Proxies:          dao.GetProxies(ctx, s.d, "delegator = ?", account),
+		ProxyFor:         dao.GetProxies(ctx, s.d, "delegatee = ?", account),
+		PureProxies:      dao.GetProxies(ctx, s.d, "spawner = ? and pure = ?", account, true),
+	}
+	multisig := relation.MultisigAccounts
+	if relation.Multisig != nil {
+		multisig = append(multisig, account)
+		for i := range relation.Multisig.Signatories {
+			relation.Multisig.Signatories[i] = address.Encode(relation.Multisig.Signatories[i])
+		}
+		relation.Multisig.Address = address.Encode(relation.Multisig.Address)
+	}
+	relation.PendingMultisig = dao.PendingMultisigOperations(ctx, s.d, multisig)
+	for i := range relation.MultisigAccounts {
+		relation.MultisigAccounts[i] = address.Encode(relation.MultisigAccounts[i])
+	}
+	encodeOperations(relation.PendingMultisig)
+	for _, proxies := range [][]model.Proxy{relation.Proxies, relation.ProxyFor, relation.PureProxies} {
+		encodeProxies(proxies)
+	}
+	return &relation
+}
+
+func (s *Service) GetMultisigOperationsCursor(ctx context.Context, multisig, status string, limit int, before, after *uint) ([]model.MultisigOperation, map[string]interface{}) {
+	list, hasPrev, hasNext := dao.MultisigOperationsCursor(ctx, s.d, multisig, status, limit, before, after)
+	encodeOperations(list)
+	var start, end *uint
+	if len(list) > 0 {
+		start = &list[0].ID
+		end = &list[len(list)-1].ID
+	}
+	return list, map[string]interface{}{
+		"start_cursor":      start,
+		"end_cursor":        end,
+		"has_previous_page": hasPrev,
+		"has_next_page":     hasNext,
+	}
+}
+
+func encodeOperations(list []model.MultisigOperation) {
+	for i := range list {
+		list[i].Multisig = address.Encode(list[i].Multisig)
+		list[i].Depositor = address.Encode(list[i].Depositor)
+		for j := range list[i].Approvals {
+			list[i].Approvals[j].Account = address.Encode(list[i].Approvals[j].Account)
+		}
+	}
+}
+
+func encodeProxies(list []model.Proxy) {
+	for i := range list {
+		list[i].Delegator = address.Encode(list[i].Delegator)
+		list[i].Delegatee = address.Encode(list[i].Delegatee)
+		if list[i].Spawner != "" {
+			list[i].Spawner = address.Encode(list[i].Spawner)
+		}
+	}
+}
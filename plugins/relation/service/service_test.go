@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itering/subscan-plugin/storage"
+	"github.com/itering/subscan/share/substrate"
+	"github.com/itering/subscan/util/address"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+const (
+	alice   = "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+	bob     = "8eaf04151687736326c9fea17e25fc5287613693c912909cb226aa4794f26a48"
+	charlie = "90b5ab205c6974c9ea841be688864633dc9ca8a357843eeacf2314649965fe22"
+)
+
+func TestCheckoutMultisig(t *testing.T) {
+	asMulti := map[string]interface{}{"call_module": "Multisig", "call_name": "as_multi", "params": []interface{}{
+		map[string]interface{}{"name": "threshold", "type": "U16", "value": float64(2)},
+		map[string]interface{}{"name": "other_signatories", "type": "Vec<AccountId>", "value": []interface{}{bob, "0x" + charlie}},
+		map[string]interface{}{"name": "call", "type": "Call", "value": map[string]interface{}{"call_module": "Balances", "call_name": "transfer_all", "params": []interface{}{}}},
+	}}
+	// alice call as_multi with proxy of bob
+	tree := substrate.ParseCallTree("Proxy", "proxy", []interface{}{
+		map[string]interface{}{"name": "real", "type": "MultiAddress", "value": map[string]interface{}{"Id": alice}},
+		map[string]interface{}{"name": "force_proxy_type", "type": "Option<ProxyType>", "value": nil},
+		map[string]interface{}{"name": "call", "type": "Call", "value": asMulti},
+	})
+	list := checkoutMultisig(tree, bob)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "49daa32c7287890f38b7e1a8cd2961723d36d20baa0bf3b82e0c4bdda93b1c0a", list[0].Address)
+	assert.Equal(t, uint16(2), list[0].Threshold)
+	assert.Equal(t, []string{alice, bob, charlie}, list[0].Signatories)
+
+	// root origin
+	assert.Empty(t, checkoutMultisig(substrate.ParseCallTree("Sudo", "sudo", []interface{}{
+		map[string]interface{}{"name": "call", "type": "Call", "value": asMulti},
+	}), alice))
+
+	// derivative account
+	tree = substrate.ParseCallTree("Utility", "as_derivative", []interface{}{
+		map[string]interface{}{"name": "index", "type": "U16", "value": float64(1)},
+		map[string]interface{}{"name": "call", "type": "Call", "value": asMulti},
+	})
+	list = checkoutMultisig(tree, charlie)
+	assert.Len(t, list, 1)
+	assert.Equal(t, address.DerivativeAccountId(charlie, 1), list[0].Signatories[0])
+}
+
+func TestProxyType(t *testing.T) {
+	assert.Equal(t, "Any", proxyType("Any"))
+	assert.Equal(t, "Staking", proxyType(map[string]interface{}{"Staking": nil}))
+}
+
+type dryRunDao struct {
+	storage.Dao
+	db  *gorm.DB
+	sql []string
+}
+
+func (d *dryRunDao) GetDbInstance() any { return d.db }
+
+func TestService_Rollback(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "root@tcp(127.0.0.1:3306)/subscan", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+	d := &dryRunDao{db: db}
+	_ = db.Callback().Delete().After("gorm:delete").Register("test:record", func(tx *gorm.DB) {
+		d.sql = append(d.sql, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	assert.NoError(t, New(d).Rollback(context.TODO(), 100))
+	assert.Equal(t, []string{
+		"DELETE FROM `relation_multisigs` WHERE block_num >= 100",
+		"DELETE FROM `relation_multisig_signatories` WHERE block_num >= 100",
+		"DELETE FROM `relation_multisig_operations` WHERE block_num >= 100",
+		"DELETE FROM `relation_multisig_approvals` WHERE block_num >= 100",
+		"DELETE FROM `relation_proxies` WHERE block_num >= 100",
+	}, d.sql)
+}
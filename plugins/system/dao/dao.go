@@ -1,18 +1,23 @@
 package dao
 
 import (
+	"context"
 	"github.com/itering/subscan-plugin/storage"
 	"github.com/itering/subscan/plugins/system/model"
 	"github.com/itering/substrate-api-rpc/metadata"
+	"gorm.io/gorm"
 	"strings"
 )
 
-func CreateExtrinsicError(db storage.DB, extrinsicIndex string, moduleError *model.MetadataModuleError) error {
+func CreateExtrinsicError(db storage.DB, blockNum uint, extrinsicIndex, callPath, source string, moduleError *model.MetadataModuleError) error {
 	if moduleError == nil {
 		return nil
 	}
 	err := db.Create(&model.ExtrinsicError{
+		BlockNum:       blockNum,
 		ExtrinsicIndex: extrinsicIndex,
+		CallPath:       callPath,
+		Source:         source,
 		Module:         moduleError.Module,
 		Name:           moduleError.Name,
 		Doc:            strings.Join(moduleError.Doc, ","),
@@ -20,21 +25,42 @@ func CreateExtrinsicError(db storage.DB, extrinsicIndex string, moduleError *mod
 	return err
 }
 
-func ExtrinsicError(db storage.DB, hash string) *model.ExtrinsicError {
-	var e model.ExtrinsicError
-	db.FindBy(&e, map[string]interface{}{"extrinsic_hash": hash}, nil)
-	return &e
+func ExtrinsicErrors(db storage.DB, extrinsicIndex string) []model.ExtrinsicError {
+	var list []model.ExtrinsicError
+	db.FindBy(&list, map[string]interface{}{"extrinsic_index": extrinsicIndex}, &storage.Option{PluginPrefix: "system", Order: "id asc"})
+	return list
+}
+
+// DeleteExtrinsicErrors delete errors from block num
+func DeleteExtrinsicErrors(ctx context.Context, db storage.DB, from uint) error {
+	d := db.GetDbInstance().(*gorm.DB)
+	return d.WithContext(ctx).Where("block_num >= ?", from).Delete(&model.ExtrinsicError{}).Error
 }
 
 func CheckExtrinsicError(spec int, raw string, moduleIndex, errorIndex int) *model.MetadataModuleError {
 
 	modules := metadata.Process(&metadata.RuntimeRaw{Raw: raw, Spec: spec})
+	if modules == nil {
+		return nil
+	}
 
-	if moduleIndex >= len(modules.Metadata.Modules) {
+	// metadata v12+ pallet has explicit index, older use the position of pallet
+	position := -1
+	if modules.MetadataVersion >= 12 {
+		for i, m := range modules.Metadata.Modules {
+			if m.Index == moduleIndex {
+				position = i
+				break
+			}
+		}
+	} else if moduleIndex < len(modules.Metadata.Modules) {
+		position = moduleIndex
+	}
+	if position < 0 {
 		return nil
 	}
 
-	module := modules.Metadata.Modules[moduleIndex]
+	module := modules.Metadata.Modules[position]
 	if errorIndex >= len(module.Errors) {
 		return nil
 	}
@@ -2,7 +2,10 @@ package model
 
 type ExtrinsicError struct {
 	ID             uint   `gorm:"primary_key" json:"-"`
-	ExtrinsicIndex string `json:"-" gorm:"size:100;index:extrinsic_index,unique"`
+	BlockNum       uint   `json:"-" gorm:"index"`
+	ExtrinsicIndex string `json:"-" gorm:"size:100;index:extrinsic_call_path,unique"`
+	CallPath       string `json:"call_path" gorm:"size:100;index:extrinsic_call_path,unique"`
+	Source         string `json:"source" gorm:"size:100"`
 	Module         string `json:"module"`
 	Name           string `json:"name"`
 	Doc            string `json:"doc"`
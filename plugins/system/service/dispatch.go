@@ -0,0 +1,107 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/itering/subscan/plugins/system/dao"
+	"github.com/itering/subscan/plugins/system/model"
+	"github.com/itering/subscan/util"
+)
+
+// dispatchErrorDoc sp_runtime DispatchError variants without metadata error
+var dispatchErrorDoc = map[string]string{
+	"Other":             "Some error occurred",
+	"CannotLookup":      "Failed to lookup some data",
+	"BadOrigin":         "A bad origin",
+	"ConsumerRemaining": "At least one consumer is remaining so the account cannot be destroyed",
+	"NoProviders":       "There are no providers so the account cannot be created",
+	"TooManyConsumers":  "There are too many consumers so the account cannot be created",
+	"Exhausted":         "Resources exhausted, e.g. attempt to read/write data which is too large to manipulate",
+	"Corruption":        "The state is corrupt; this is generally not going to fix itself",
+	"Unavailable":       "Some resource (e.g. a preimage) is unavailable right now. This might fix itself later",
+	"RootNotAllowed":    "Root origin is not allowed",
+	"Token":             "An error to do with tokens",
+	"Arithmetic":        "An arithmetic error",
+	"Transactional":     "The number of transactional layers has been reached, or we are not in a transactional layer",
+	"Trie":              "An error with tries",
+}
+
+// decodeDispatchError decode DispatchError of any runtime version
+// Module error resolve with spec metadata, Token/Arithmetic/Transactional/Trie use inner variant as name
+func (s *Service) decodeDispatchError(spec int, raw interface{}) *model.MetadataModuleError {
+	switch v := raw.(type) {
+	case string:
+		// unit variant
+		return &model.MetadataModuleError{Name: v, Doc: docOf(v)}
+	case map[string]interface{}:
+		// legacy DispatchError {module, error}
+		if e, ok := lookupFold(v, "Error"); ok {
+			m, _ := lookupFold(v, "Module")
+			return dao.CheckExtrinsicError(spec, s.dao.SpecialMetadata(spec), util.IntFromInterface(m), moduleErrorIndex(e))
+		}
+		for variant, value := range v {
+			switch variant = normalizeVariant(variant); variant {
+			case "Module":
+				var module map[string]interface{}
+				if err := util.UnmarshalAny(&module, value); err != nil {
+					return nil
+				}
+				index, _ := lookupFold(module, "index")
+				e, _ := lookupFold(module, "error")
+				return dao.CheckExtrinsicError(spec, s.dao.SpecialMetadata(spec), util.IntFromInterface(index), moduleErrorIndex(e))
+			case "Token", "Arithmetic", "Transactional", "Trie":
+				name := variant
+				if inner, ok := value.(string); ok && inner != "" {
+					name = inner
+				} else if innerMap, ok := value.(map[string]interface{}); ok {
+					for k := range innerMap {
+						name = k
+					}
+				}
+				return &model.MetadataModuleError{Module: variant, Name: name, Doc: docOf(variant)}
+			default:
+				return &model.MetadataModuleError{Name: variant, Doc: docOf(variant)}
+			}
+		}
+	}
+	return nil
+}
+
+// moduleErrorIndex ModuleError.error is u8 before, [u8; 4] now, the first byte is error index
+func moduleErrorIndex(raw interface{}) int {
+	if v, ok := raw.(string); ok && strings.HasPrefix(v, "0x") {
+		if b := util.HexToBytes(v); len(b) > 0 {
+			return int(b[0])
+		}
+		return 0
+	}
+	return util.IntFromInterface(raw)
+}
+
+func docOf(variant string) []string {
+	if doc, ok := dispatchErrorDoc[variant]; ok {
+		return []string{doc}
+	}
+	return nil
+}
+
+func lookupFold(m map[string]interface{}, key string) (interface{}, bool) {
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func normalizeVariant(variant string) string {
+	for known := range dispatchErrorDoc {
+		if strings.EqualFold(known, variant) {
+			return known
+		}
+	}
+	if strings.EqualFold(variant, "Module") {
+		return "Module"
+	}
+	return variant
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeDispatchError(t *testing.T) {
+	s := &Service{}
+	e := s.decodeDispatchError(0, map[string]interface{}{"Arithmetic": "Overflow"})
+	assert.Equal(t, "Arithmetic", e.Module)
+	assert.Equal(t, "Overflow", e.Name)
+	e = s.decodeDispatchError(0, map[string]interface{}{"Transactional": "LimitReached"})
+	assert.Equal(t, "LimitReached", e.Name)
+	e = s.decodeDispatchError(0, map[string]interface{}{"cannotLookup": nil})
+	assert.Equal(t, "CannotLookup", e.Name)
+	assert.Equal(t, "BadOrigin", s.decodeDispatchError(0, "BadOrigin").Name)
+	assert.Equal(t, 2, moduleErrorIndex("0x02000000"))
+	assert.Equal(t, 3, moduleErrorIndex(float64(3)))
+}
@@ -1,11 +1,14 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"github.com/itering/subscan-plugin/storage"
 	"github.com/itering/subscan/plugins/system/dao"
 	"github.com/itering/subscan/plugins/system/model"
+	"github.com/itering/subscan/share/substrate"
 	"github.com/itering/subscan/util"
+	"strings"
 )
 
 type Service struct {
@@ -18,55 +21,50 @@ func New(d storage.Dao) *Service {
 	}
 }
 
-func (s *Service) GetExtrinsicError(hash string) *model.ExtrinsicError {
-	return dao.ExtrinsicError(s.dao, hash)
+func (s *Service) GetExtrinsicErrors(extrinsicIndex string) []model.ExtrinsicError {
+	return dao.ExtrinsicErrors(s.dao, extrinsicIndex)
 }
 
+// ExtrinsicFailed System.ExtrinsicFailed(DispatchError, DispatchInfo), error of extrinsic call
 func (s *Service) ExtrinsicFailed(spec int, event *storage.Event, paramEvent []storage.EventParam) {
-
-	type DispatchErrorModule struct {
-		Index int `json:"index"`
-		Error int `json:"error"`
-	}
-
 	for _, param := range paramEvent {
+		if strings.HasSuffix(param.Type, "DispatchError") {
+			_ = dao.CreateExtrinsicError(s.dao, uint(event.BlockNum), genExtrinsicIndex(event), "", "system.ExtrinsicFailed", s.decodeDispatchError(spec, param.Value))
+			break
+		}
+	}
+}
 
-		if param.Type == "DispatchError" {
-
-			var dr map[string]interface{}
-			_ = util.UnmarshalAny(&dr, param.Value)
-
-			if _, ok := dr["Error"]; ok {
-				_ = dao.CreateExtrinsicError(s.dao,
-					genExtrinsicIndex(event),
-					dao.CheckExtrinsicError(spec, s.dao.SpecialMetadata(spec), util.IntFromInterface(dr["Module"]), util.IntFromInterface(dr["Error"])))
-
-			} else if _, ok := dr["Module"]; ok {
-				var module DispatchErrorModule
-				_ = util.UnmarshalAny(&module, dr["Module"])
-
-				_ = dao.CreateExtrinsicError(s.dao,
-					genExtrinsicIndex(event),
-					dao.CheckExtrinsicError(spec, s.dao.SpecialMetadata(spec), module.Index, module.Error))
-
-			} else if _, ok := dr["BadOrigin"]; ok {
-				_ = dao.CreateExtrinsicError(s.dao, genExtrinsicIndex(event),
-					&model.MetadataModuleError{Name: "BadOrigin"})
-
-			} else if _, ok := dr["CannotLookup"]; ok {
-				_ = dao.CreateExtrinsicError(s.dao, genExtrinsicIndex(event),
-					&model.MetadataModuleError{Name: "CannotLookup"})
-
-			} else if _, ok := dr["Other"]; ok {
-				_ = dao.CreateExtrinsicError(s.dao, genExtrinsicIndex(event),
-					&model.MetadataModuleError{Name: "Other"})
+// NestedCallFailed decode errors of nested calls with utility/proxy/multisig/sudo result events
+func (s *Service) NestedCallFailed(block *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) {
+	var params []storage.ExtrinsicParam
+	_ = util.UnmarshalAny(&params, extrinsic.Params)
+
+	tree := substrate.ParseCallTree(extrinsic.CallModule, extrinsic.CallModuleFunction, params)
+	var callEvents []substrate.CallEvent
+	for _, event := range events {
+		var eventParams []storage.EventParam
+		_ = util.UnmarshalAny(&eventParams, event.Params)
+		callEvent := substrate.CallEvent{EventIndex: fmt.Sprintf("%d-%d", event.BlockNum, event.EventIdx), EventIdx: event.EventIdx, ModuleId: event.ModuleId, EventId: event.EventId}
+		for _, param := range eventParams {
+			callEvent.Params = append(callEvent.Params, param.Value)
+		}
+		callEvents = append(callEvents, callEvent)
+	}
+	tree.AttributeEvents(callEvents)
 
-			}
-			break
+	for _, call := range tree.SubCalls() {
+		if call.Error != nil {
+			_ = dao.CreateExtrinsicError(s.dao, uint(block.BlockNum), extrinsic.ExtrinsicIndex, call.Path, call.Error.Source, s.decodeDispatchError(block.SpecVersion, call.Error.Value))
 		}
 	}
 }
 
+// Rollback delete extrinsic errors from block num
+func (s *Service) Rollback(ctx context.Context, from uint) error {
+	return dao.DeleteExtrinsicErrors(ctx, s.dao, from)
+}
+
 func genExtrinsicIndex(event *storage.Event) string {
 	return fmt.Sprint(event.BlockNum, "-", event.ExtrinsicIdx)
 }
@@ -5,17 +5,20 @@ import (
 	subscan_plugin "github.com/itering/subscan-plugin"
 	"github.com/itering/subscan-plugin/router"
 	"github.com/itering/subscan-plugin/storage"
+	subscanModel "github.com/itering/subscan/model"
 	"github.com/itering/subscan/plugins/system/model"
 	"github.com/itering/subscan/plugins/system/service"
 	"github.com/itering/subscan/util"
 	"github.com/shopspring/decimal"
 	"github.com/urfave/cli"
+	"gorm.io/gorm"
 )
 
 var srv *service.Service
 
 type System struct {
-	d storage.Dao
+	d   storage.Dao
+	srv *service.Service
 }
 
 func (a *System) Commands() []cli.Command {
@@ -34,12 +37,23 @@ func (a *System) ProcessBlock(context.Context, *storage.Block) error {
 	return nil
 }
 
+// DeliverTx extrinsic errors of block are written through d
+func (a *System) DeliverTx(d storage.Dao, deliver func(subscan_plugin.Plugin) error) error {
+	return deliver(&System{d: d, srv: service.New(d)})
+}
+
+// Rollback delete extrinsic errors from block num before blocks delivered again
+func (a *System) Rollback(ctx context.Context, from uint) error {
+	return a.srv.Rollback(ctx, from)
+}
+
 func New() *System {
 	return &System{}
 }
 
 func (a *System) InitDao(d storage.Dao) {
-	srv = service.New(d)
+	a.srv = service.New(d)
+	srv = a.srv
 	a.d = d
 	a.Migrate()
 }
@@ -48,24 +62,40 @@ func (a *System) InitHttp() (routers []router.Http) {
 	return nil
 }
 
-func (a *System) ProcessExtrinsic(*storage.Block, *storage.Extrinsic, []storage.Event) error {
+func (a *System) ProcessExtrinsic(block *storage.Block, extrinsic *storage.Extrinsic, events []storage.Event) error {
+	a.srv.NestedCallFailed(block, extrinsic, events)
 	return nil
 }
 
+// ExtrinsicErrors decoded dispatch errors of extrinsic and nested calls
+func (a *System) ExtrinsicErrors(extrinsicIndex string) []subscanModel.ExtrinsicErrorJson {
+	var list []subscanModel.ExtrinsicErrorJson
+	for _, e := range a.srv.GetExtrinsicErrors(extrinsicIndex) {
+		list = append(list, subscanModel.ExtrinsicErrorJson{CallPath: e.CallPath, Source: e.Source, Module: e.Module, Name: e.Name, Doc: e.Doc})
+	}
+	return list
+}
+
 func (a *System) ProcessEvent(block *storage.Block, event *storage.Event, _ decimal.Decimal) error {
 	var paramEvent []storage.EventParam
 	_ = util.UnmarshalAny(&paramEvent, event.Params)
 	switch event.EventId {
 	case "ExtrinsicFailed":
-		srv.ExtrinsicFailed(block.SpecVersion, event, paramEvent)
+		a.srv.ExtrinsicFailed(block.SpecVersion, event, paramEvent)
 	}
 	return nil
 }
 
 func (a *System) Migrate() {
 	db := a.d
+	if instant, ok := db.GetDbInstance().(*gorm.DB); ok {
+		// extrinsic_index unique index replaced by extrinsic_call_path
+		migrator := instant.Table("system_extrinsic_errors").Migrator()
+		if migrator.HasIndex(&model.ExtrinsicError{}, "extrinsic_index") {
+			_ = migrator.DropIndex(&model.ExtrinsicError{}, "extrinsic_index")
+		}
+	}
 	_ = db.AutoMigration(&model.ExtrinsicError{})
-	_ = db.AddUniqueIndex(&model.ExtrinsicError{}, "extrinsic_hash", "extrinsic_hash")
 }
 
 func (a *System) SetRedisPool(subscan_plugin.RedisPool) {
@@ -77,7 +107,7 @@ func (a *System) Version() string {
 }
 
 func (a *System) SubscribeExtrinsic() []string {
-	return nil
+	return []string{"utility", "proxy", "multisig", "sudo"}
 }
 
 func (a *System) SubscribeEvent() []string {
@@ -0,0 +1,145 @@
+package analytics
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClickHouse record inserted rows of http interface, answer select with fixed data
+type fakeClickHouse struct {
+	mu      sync.Mutex
+	fail    bool
+	queries []string
+	params  []map[string]string
+	rows    map[string][]string
+}
+
+func (f *fakeClickHouse) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail {
+		http.Error(w, "Code: 210. DB::NetException", http.StatusInternalServerError)
+		return
+	}
+	query := r.URL.Query().Get("query")
+	f.queries = append(f.queries, query)
+	params := make(map[string]string)
+	for k, v := range r.URL.Query() {
+		if strings.HasPrefix(k, "param_") {
+			params[strings.TrimPrefix(k, "param_")] = v[0]
+		}
+	}
+	f.params = append(f.params, params)
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO "):
+		table := strings.Fields(query)[2]
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			f.rows[table] = append(f.rows[table], scanner.Text())
+		}
+	case strings.HasPrefix(query, "SELECT"):
+		_, _ = w.Write([]byte(`{"meta":[],"data":[{"key":"balances","count":3,"sum":"300"},{"key":"system","count":1,"sum":"0"}],"rows":2}`))
+	}
+}
+
+func newFakeClickHouse() (*fakeClickHouse, *httptest.Server) {
+	f := &fakeClickHouse{rows: make(map[string][]string)}
+	return f, httptest.NewServer(f)
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.TODO()
+	f, server := newFakeClickHouse()
+	defer server.Close()
+	client := NewClient(server.URL, "subscan", "default", "")
+
+	assert.NoError(t, client.Ping(ctx))
+	assert.NoError(t, client.Migrate(ctx))
+	assert.Equal(t, "CREATE DATABASE IF NOT EXISTS subscan", f.queries[1])
+	assert.Len(t, f.queries, len(tables)+2)
+
+	assert.NoError(t, client.Insert(ctx, TableLogs, []interface{}{Log{BlockNum: 1, LogIndex: "1-0", LogType: "Seal"}}))
+	assert.Equal(t, []string{`{"block_num":1,"block_timestamp":0,"log_index":"1-0","log_type":"Seal","data":""}`}, f.rows[TableLogs])
+
+	list, err := client.Stats(ctx, &StatsParams{Table: TableExtrinsics, GroupBy: "module", Filters: map[string]string{"success": "true"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []StatsRow{{Key: "balances", Count: 3, Sum: "300"}, {Key: "system", Count: 1, Sum: "0"}}, list)
+	assert.Equal(t, map[string]string{"f_success": "true"}, f.params[len(f.params)-1])
+
+	f.fail = true
+	assert.Error(t, client.Ping(ctx))
+}
+
+func TestSink(t *testing.T) {
+	ctx := context.TODO()
+	f, server := newFakeClickHouse()
+	defer server.Close()
+	sink := NewSink(NewClient(server.URL, "subscan", "", ""), 2, time.Hour)
+
+	sink.Push(TableEvents, Event{BlockNum: 1, EventIndex: "1-0"})
+	sink.Push(TableExtrinsics, Extrinsic{BlockNum: 1, ExtrinsicIndex: "1-0"})
+	// batch size reached, flush in background
+	assert.Eventually(t, func() bool {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		return len(f.rows[TableEvents]) == 1 && len(f.rows[TableExtrinsics]) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// failed flush keep rows in buffer
+	f.mu.Lock()
+	f.fail = true
+	f.mu.Unlock()
+	sink.Push(TableLogs, Log{BlockNum: 2, LogIndex: "2-0"})
+	assert.Error(t, sink.Flush(ctx))
+	f.mu.Lock()
+	f.fail = false
+	f.mu.Unlock()
+
+	RegisterBackfill("test", func(ctx context.Context, sink *Sink, start, end uint) error {
+		for i := start; i <= end; i++ {
+			sink.Push(TableLogs, Log{BlockNum: i, LogIndex: "backfill"})
+		}
+		return nil
+	})
+	assert.Contains(t, Backfills(), "test")
+	assert.NoError(t, sink.Backfill(ctx, "test", 3, 5))
+	assert.Len(t, f.rows[TableLogs], 4)
+	sink.Close()
+}
+
+func TestStatsQuery(t *testing.T) {
+	query, params, err := statsQuery(&StatsParams{
+		Table:      TableTokenTransfers,
+		GroupBy:    "day",
+		Filters:    map[string]string{"contract": "0x01", "category": "erc20"},
+		BlockStart: 10,
+		TimeEnd:    1700000000,
+		Limit:      5000,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT toString(toDate(toDateTime(block_timestamp))) AS key, count() AS count, toString(sum(value)) AS sum FROM token_transfers FINAL "+
+		"WHERE toString(category) = {f_category:String} AND toString(contract) = {f_contract:String} AND block_num >= {block_start:UInt64} AND block_timestamp <= {time_end:UInt64} "+
+		"GROUP BY key ORDER BY key ASC LIMIT 100", query)
+	assert.Equal(t, map[string]string{"f_category": "erc20", "f_contract": "0x01", "block_start": "10", "time_end": "1700000000"}, params)
+
+	query, _, err = statsQuery(&StatsParams{Table: TableLogs, GroupBy: "log_type", Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT log_type AS key, count() AS count FROM logs FINAL GROUP BY key ORDER BY count DESC LIMIT 10", query)
+
+	for _, p := range []StatsParams{
+		{Table: "chain_blocks", GroupBy: "day"},
+		{Table: TableEvents, GroupBy: "account"},
+		{Table: TableEvents, GroupBy: "module", Filters: map[string]string{"module_id = 'x' OR 1": "1"}},
+	} {
+		_, _, err = statsQuery(&p)
+		assert.ErrorIs(t, err, ErrStatsParams)
+	}
+}
@@ -0,0 +1,38 @@
+package analytics
+
+import (
+	"context"
+	"sort"
+)
+
+// BackfillFunc push rows of block range [start, end] to sink
+type BackfillFunc func(ctx context.Context, sink *Sink, start, end uint) error
+
+var backfills = make(map[string]BackfillFunc)
+
+// RegisterBackfill register backfill source, core register substrate data, plugins register their own data
+func RegisterBackfill(name string, fn BackfillFunc) {
+	backfills[name] = fn
+}
+
+// Backfills registered backfill source names
+func Backfills() []string {
+	var names []string
+	for name := range backfills {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Backfill push rows of block range [start, end] from source to sink, then flush
+func (s *Sink) Backfill(ctx context.Context, name string, start, end uint) error {
+	fn, ok := backfills[name]
+	if !ok {
+		return nil
+	}
+	if err := fn(ctx, s, start, end); err != nil {
+		return err
+	}
+	return s.Flush(ctx)
+}
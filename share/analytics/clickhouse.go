@@ -0,0 +1,115 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Client clickhouse http interface client, https://clickhouse.com/docs/en/interfaces/http
+type Client struct {
+	endpoint string
+	database string
+	user     string
+	password string
+	http     *http.Client
+}
+
+func NewClient(endpoint, database, user, password string) *Client {
+	return &Client{
+		endpoint: endpoint,
+		database: database,
+		user:     user,
+		password: password,
+		http:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (c *Client) do(ctx context.Context, database, query string, body io.Reader, params map[string]string) ([]byte, error) {
+	u, err := url.Parse(c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	if database != "" {
+		q.Set("database", database)
+	}
+	q.Set("query", query)
+	q.Set("output_format_json_quote_64bit_integers", "0")
+	for k, v := range params {
+		q.Set("param_"+k, v)
+	}
+	u.RawQuery = q.Encode()
+	if body == nil {
+		body = http.NoBody
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if c.user != "" {
+		req.Header.Set("X-ClickHouse-User", c.user)
+		req.Header.Set("X-ClickHouse-Key", c.password)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("clickhouse %d: %s", resp.StatusCode, bytes.TrimSpace(data))
+	}
+	return data, nil
+}
+
+// Exec execute statement without result
+func (c *Client) Exec(ctx context.Context, query string) error {
+	_, err := c.do(ctx, c.database, query, nil, nil)
+	return err
+}
+
+// Insert insert rows into table with JSONEachRow format
+func (c *Client) Insert(ctx context.Context, table string, rows []interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+	_, err := c.do(ctx, c.database, fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", table), &body, nil)
+	return err
+}
+
+// Query select with query parameters like {name:Type}, decode data of JSON format into dest
+func (c *Client) Query(ctx context.Context, query string, params map[string]string, dest interface{}) error {
+	data, err := c.do(ctx, c.database, query+" FORMAT JSON", nil, params)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err = json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+	return json.Unmarshal(result.Data, dest)
+}
+
+// Ping check clickhouse alive
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.do(ctx, "", "SELECT 1", nil, nil)
+	return err
+}
@@ -0,0 +1,132 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	TableExtrinsics      = "extrinsics"
+	TableEvents          = "events"
+	TableLogs            = "logs"
+	TableEvmTransactions = "evm_transactions"
+	TableTokenTransfers  = "token_transfers"
+)
+
+type Extrinsic struct {
+	BlockNum           uint            `json:"block_num"`
+	BlockTimestamp     int             `json:"block_timestamp"`
+	ExtrinsicIndex     string          `json:"extrinsic_index"`
+	ExtrinsicHash      string          `json:"extrinsic_hash"`
+	CallModule         string          `json:"call_module"`
+	CallModuleFunction string          `json:"call_module_function"`
+	AccountId          string          `json:"account_id"`
+	IsSigned           bool            `json:"is_signed"`
+	Success            bool            `json:"success"`
+	Fee                decimal.Decimal `json:"fee"`
+	UsedFee            decimal.Decimal `json:"used_fee"`
+}
+
+type Event struct {
+	BlockNum       uint   `json:"block_num"`
+	BlockTimestamp int    `json:"block_timestamp"`
+	EventIndex     string `json:"event_index"`
+	ExtrinsicIndex string `json:"extrinsic_index"`
+	ModuleId       string `json:"module_id"`
+	EventId        string `json:"event_id"`
+	Phase          int    `json:"phase"`
+	Params         string `json:"params"`
+}
+
+type Log struct {
+	BlockNum       uint   `json:"block_num"`
+	BlockTimestamp int    `json:"block_timestamp"`
+	LogIndex       string `json:"log_index"`
+	LogType        string `json:"log_type"`
+	Data           string `json:"data"`
+}
+
+type EvmTransaction struct {
+	BlockNum          uint            `json:"block_num"`
+	BlockTimestamp    uint            `json:"block_timestamp"`
+	Hash              string          `json:"hash"`
+	ExtrinsicIndex    string          `json:"extrinsic_index"`
+	FromAddress       string          `json:"from_address"`
+	ToAddress         string          `json:"to_address"`
+	Contract          string          `json:"contract"`
+	Value             decimal.Decimal `json:"value"`
+	GasLimit          decimal.Decimal `json:"gas_limit"`
+	GasUsed           decimal.Decimal `json:"gas_used"`
+	EffectiveGasPrice decimal.Decimal `json:"effective_gas_price"`
+	TxnType           uint            `json:"txn_type"`
+	Success           bool            `json:"success"`
+}
+
+type TokenTransfer struct {
+	BlockNum       uint64          `json:"block_num"`
+	BlockTimestamp uint            `json:"block_timestamp"`
+	TransferId     uint64          `json:"transfer_id"`
+	Hash           string          `json:"hash"`
+	Contract       string          `json:"contract"`
+	Category       string          `json:"category"`
+	Sender         string          `json:"sender"`
+	Receiver       string          `json:"receiver"`
+	Value          decimal.Decimal `json:"value"`
+	TokenId        string          `json:"token_id"`
+}
+
+// ReplacingMergeTree dedupe rows of same sorting key, live sink and backfill can safely overlap
+var tables = []struct {
+	name    string
+	columns string
+	orderBy string
+}{
+	{
+		name: TableExtrinsics,
+		columns: `block_num UInt64, block_timestamp UInt64, extrinsic_index String, extrinsic_hash String,
+			call_module LowCardinality(String), call_module_function LowCardinality(String), account_id String,
+			is_signed Bool, success Bool, fee UInt256, used_fee UInt256`,
+		orderBy: "block_num, extrinsic_index",
+	},
+	{
+		name: TableEvents,
+		columns: `block_num UInt64, block_timestamp UInt64, event_index String, extrinsic_index String,
+			module_id LowCardinality(String), event_id LowCardinality(String), phase UInt8, params String`,
+		orderBy: "block_num, event_index",
+	},
+	{
+		name:    TableLogs,
+		columns: `block_num UInt64, block_timestamp UInt64, log_index String, log_type LowCardinality(String), data String`,
+		orderBy: "block_num, log_index",
+	},
+	{
+		name: TableEvmTransactions,
+		columns: `block_num UInt64, block_timestamp UInt64, hash String, extrinsic_index String, from_address String,
+			to_address String, contract String, value UInt256, gas_limit UInt256, gas_used UInt256,
+			effective_gas_price UInt256, txn_type UInt8, success Bool`,
+		orderBy: "block_num, hash",
+	},
+	{
+		name: TableTokenTransfers,
+		columns: `block_num UInt64, block_timestamp UInt64, transfer_id UInt64, hash String, contract String,
+			category LowCardinality(String), sender String, receiver String, value UInt256, token_id String`,
+		orderBy: "block_num, transfer_id",
+	},
+}
+
+// Migrate create database and tables if not exists
+func (c *Client) Migrate(ctx context.Context) error {
+	if _, err := c.do(ctx, "", fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", c.database), nil, nil); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		ddl := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s) ENGINE = ReplacingMergeTree PARTITION BY intDiv(block_num, 1000000) ORDER BY (%s)",
+			table.name, table.columns, table.orderBy)
+		if err := c.Exec(ctx, ddl); err != nil {
+			return fmt.Errorf("create table %s: %v", table.name, err)
+		}
+	}
+	return nil
+}
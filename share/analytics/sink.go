@@ -0,0 +1,155 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/util"
+)
+
+// Sink buffer rows in memory, flush to clickhouse in batch
+// rows of failed flush are kept for next flush until maxBuffer exceeded, lost rows can be recovered by backfill
+type Sink struct {
+	client    *Client
+	batchSize int
+	interval  time.Duration
+	maxBuffer int
+
+	mu      sync.Mutex
+	buffer  map[string][]interface{}
+	pending int
+	notify  chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// Instant analytics sink, nil if analytics disabled
+var Instant *Sink
+
+// Init enable analytics sink if clickhouse endpoint configured
+func Init(c *configs.Analytics) {
+	if c == nil || c.Endpoint == "" || Instant != nil {
+		return
+	}
+	client := NewClient(c.Endpoint, c.Database, c.User, c.Password)
+	if err := client.Migrate(context.Background()); err != nil {
+		util.Logger().Error(fmt.Errorf("analytics sink disabled, migrate clickhouse failed: %v", err))
+		return
+	}
+	Instant = NewSink(client, c.BatchSize, time.Duration(c.FlushInterval)*time.Second)
+}
+
+func NewSink(client *Client, batchSize int, interval time.Duration) *Sink {
+	s := &Sink{
+		client:    client,
+		batchSize: batchSize,
+		interval:  interval,
+		maxBuffer: batchSize * 20,
+		buffer:    make(map[string][]interface{}),
+		notify:    make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Enabled analytics sink enabled
+func Enabled() bool {
+	return Instant != nil
+}
+
+// Push rows to analytics sink, no-op if analytics disabled
+func Push(table string, rows ...interface{}) {
+	if Instant == nil || len(rows) == 0 {
+		return
+	}
+	Instant.Push(table, rows...)
+}
+
+func (s *Sink) Client() *Client {
+	return s.client
+}
+
+func (s *Sink) Push(table string, rows ...interface{}) {
+	s.mu.Lock()
+	s.buffer[table] = append(s.buffer[table], rows...)
+	s.pending += len(rows)
+	full := s.pending >= s.batchSize
+	s.mu.Unlock()
+	if full {
+		select {
+		case s.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *Sink) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		case <-s.notify:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		if err := s.Flush(ctx); err != nil {
+			util.Logger().Error(fmt.Errorf("analytics flush failed: %v", err))
+		}
+		cancel()
+	}
+}
+
+// Flush write all buffered rows to clickhouse
+func (s *Sink) Flush(ctx context.Context) (err error) {
+	s.mu.Lock()
+	buffer := s.buffer
+	s.buffer = make(map[string][]interface{})
+	s.pending = 0
+	s.mu.Unlock()
+
+	for table, rows := range buffer {
+		if insertErr := s.client.Insert(ctx, table, rows); insertErr != nil {
+			err = insertErr
+			s.requeue(table, rows)
+		}
+	}
+	return
+}
+
+func (s *Sink) requeue(table string, rows []interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending+len(rows) > s.maxBuffer {
+		util.Logger().Error(fmt.Errorf("analytics buffer full, drop %d rows of %s", len(rows), table))
+		return
+	}
+	s.buffer[table] = append(rows, s.buffer[table]...)
+	s.pending += len(rows)
+}
+
+// Close stop background flush and flush remaining rows
+func (s *Sink) Close() {
+	close(s.stop)
+	<-s.done
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := s.Flush(ctx); err != nil {
+		util.Logger().Error(fmt.Errorf("analytics flush failed: %v", err))
+	}
+}
+
+// Close flush and close analytics sink if enabled
+func Close() {
+	if Instant != nil {
+		Instant.Close()
+		Instant = nil
+	}
+}
@@ -0,0 +1,170 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// StatsParams aggregate statistics of table, group by dimension, filter by equal conditions and block/time range
+type StatsParams struct {
+	Table      string            `json:"table"`
+	GroupBy    string            `json:"group_by"`
+	Filters    map[string]string `json:"filters"`
+	BlockStart uint              `json:"block_start"`
+	BlockEnd   uint              `json:"block_end"`
+	TimeStart  uint              `json:"time_start"`
+	TimeEnd    uint              `json:"time_end"`
+	Limit      int               `json:"limit"`
+}
+
+type StatsRow struct {
+	Key   string `json:"key"`
+	Count uint64 `json:"count"`
+	Sum   string `json:"sum,omitempty"`
+}
+
+type statsTable struct {
+	dimensions map[string]string // group by name => expression
+	filters    map[string]string // filter name => column
+	sum        string            // column of sum, empty if no summable column
+}
+
+const (
+	dayExpr  = "toString(toDate(toDateTime(block_timestamp)))"
+	hourExpr = "toString(toStartOfHour(toDateTime(block_timestamp)))"
+)
+
+var statsTables = map[string]statsTable{
+	TableExtrinsics: {
+		dimensions: map[string]string{
+			"module":  "call_module",
+			"call":    "concat(call_module, '.', call_module_function)",
+			"account": "account_id",
+			"success": "toString(success)",
+			"day":     dayExpr,
+			"hour":    hourExpr,
+		},
+		filters: map[string]string{"module": "call_module", "call": "call_module_function", "account": "account_id", "is_signed": "is_signed", "success": "success"},
+		sum:     "fee",
+	},
+	TableEvents: {
+		dimensions: map[string]string{
+			"module": "module_id",
+			"event":  "concat(module_id, '.', event_id)",
+			"day":    dayExpr,
+			"hour":   hourExpr,
+		},
+		filters: map[string]string{"module": "module_id", "event": "event_id", "extrinsic_index": "extrinsic_index"},
+	},
+	TableLogs: {
+		dimensions: map[string]string{"log_type": "log_type", "day": dayExpr, "hour": hourExpr},
+		filters:    map[string]string{"log_type": "log_type"},
+	},
+	TableEvmTransactions: {
+		dimensions: map[string]string{
+			"from":     "from_address",
+			"to":       "to_address",
+			"contract": "contract",
+			"success":  "toString(success)",
+			"day":      dayExpr,
+			"hour":     hourExpr,
+		},
+		filters: map[string]string{"from": "from_address", "to": "to_address", "contract": "contract", "success": "success"},
+		sum:     "gas_used",
+	},
+	TableTokenTransfers: {
+		dimensions: map[string]string{
+			"contract": "contract",
+			"category": "category",
+			"sender":   "sender",
+			"receiver": "receiver",
+			"day":      dayExpr,
+			"hour":     hourExpr,
+		},
+		filters: map[string]string{"contract": "contract", "category": "category", "sender": "sender", "receiver": "receiver"},
+		sum:     "value",
+	},
+}
+
+var (
+	ErrStatsParams = errors.New("invalid analytics stats params")
+	ErrDisabled    = errors.New("analytics not enabled")
+)
+
+// statsQuery build aggregate sql with clickhouse query parameters
+func statsQuery(p *StatsParams) (string, map[string]string, error) {
+	table, ok := statsTables[p.Table]
+	if !ok {
+		return "", nil, ErrStatsParams
+	}
+	key, ok := table.dimensions[p.GroupBy]
+	if !ok {
+		return "", nil, ErrStatsParams
+	}
+	var (
+		where  []string
+		params = make(map[string]string)
+	)
+	names := make([]string, 0, len(p.Filters))
+	for name := range p.Filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		column, ok := table.filters[name]
+		if !ok {
+			return "", nil, ErrStatsParams
+		}
+		where = append(where, fmt.Sprintf("toString(%s) = {f_%s:String}", column, name))
+		params["f_"+name] = p.Filters[name]
+	}
+	for _, r := range []struct {
+		value uint
+		cond  string
+		name  string
+	}{
+		{p.BlockStart, "block_num >= {block_start:UInt64}", "block_start"},
+		{p.BlockEnd, "block_num <= {block_end:UInt64}", "block_end"},
+		{p.TimeStart, "block_timestamp >= {time_start:UInt64}", "time_start"},
+		{p.TimeEnd, "block_timestamp <= {time_end:UInt64}", "time_end"},
+	} {
+		if r.value > 0 {
+			where = append(where, r.cond)
+			params[r.name] = fmt.Sprint(r.value)
+		}
+	}
+
+	selects := []string{fmt.Sprintf("%s AS key", key), "count() AS count"}
+	if table.sum != "" {
+		selects = append(selects, fmt.Sprintf("toString(sum(%s)) AS sum", table.sum))
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s FINAL", strings.Join(selects, ", "), p.Table)
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " GROUP BY key"
+	if p.GroupBy == "day" || p.GroupBy == "hour" {
+		query += " ORDER BY key ASC"
+	} else {
+		query += " ORDER BY count DESC"
+	}
+	limit := p.Limit
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	query += fmt.Sprintf(" LIMIT %d", limit)
+	return query, params, nil
+}
+
+// Stats aggregate statistics from analytics tables
+func (c *Client) Stats(ctx context.Context, p *StatsParams) (list []StatsRow, err error) {
+	query, params, err := statsQuery(p)
+	if err != nil {
+		return nil, err
+	}
+	err = c.Query(ctx, query, params, &list)
+	return
+}
@@ -0,0 +1,10 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var ApiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "subscan",
+	Subsystem: "api",
+	Name:      "requests_total",
+	Help:      "public api requests by tier, route and rate limit result",
+}, []string{"tier", "route", "result"})
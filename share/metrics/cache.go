@@ -0,0 +1,11 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// ApiCache hit ratio of route is hit / (hit + miss)
+var ApiCache = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "subscan",
+	Subsystem: "api",
+	Name:      "cache_requests_total",
+	Help:      "cached api route requests by result, hit or miss",
+}, []string{"route", "result"})
@@ -7,6 +7,10 @@ func init() {
 		// block
 		subBlockStatusGauge, SubBlockFillError,
 		// worker
-		WorkerProcessCost,
+		WorkerProcessCost, WorkerRetry, WorkerDeadLetter,
+		// plugin
+		PluginCheckpoint, PluginLag,
+		// api
+		ApiRequests, ApiCache,
 	)
 }
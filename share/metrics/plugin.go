@@ -0,0 +1,19 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	PluginCheckpoint = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "subscan",
+		Subsystem: "plugin",
+		Name:      "checkpoint_block_num",
+		Help:      "next block num delivered to plugin",
+	}, []string{"plugin"})
+
+	PluginLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "subscan",
+		Subsystem: "plugin",
+		Name:      "lag_blocks",
+		Help:      "finalized blocks not delivered to plugin yet",
+	}, []string{"plugin"})
+)
@@ -9,4 +9,16 @@ var (
 		Name:      "process_duration_seconds",
 		Buckets:   []float64{0.05, 0.1, 0.2, 0.5, 1, 2, 5, 10, 20, 30, 60},
 	}, []string{"queue", "class"})
+
+	WorkerRetry = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "subscan",
+		Subsystem: "worker",
+		Name:      "retry_total",
+	}, []string{"queue", "class"})
+
+	WorkerDeadLetter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "subscan",
+		Subsystem: "worker",
+		Name:      "dead_letter_total",
+	}, []string{"queue", "class"})
 )
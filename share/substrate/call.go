@@ -0,0 +1,296 @@
+package substrate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	scalecodec "github.com/itering/scale.go"
+	"github.com/itering/subscan/util"
+)
+
+// nestedResultEvents events carry the result of a nested call, used as the boundary of sub-call events
+var nestedResultEvents = map[string][]string{
+	"utility":  {"ItemCompleted", "ItemFailed", "BatchInterrupted", "BatchCompleted", "BatchCompletedWithErrors", "DispatchedAs"},
+	"proxy":    {"ProxyExecuted"},
+	"multisig": {"MultisigExecuted"},
+	"sudo":     {"Sudid", "SudoAsDone"},
+}
+
+// Call decoded call tree of extrinsic, Call/Vec<Call>/Box<Call> params expanded as sub calls
+type Call struct {
+	Path               string                      `json:"path"`
+	CallModule         string                      `json:"call_module"`
+	CallModuleFunction string                      `json:"call_module_function"`
+	Params             []scalecodec.ExtrinsicParam `json:"params,omitempty"`
+	Events             []string                    `json:"events,omitempty"`
+	Success            bool                        `json:"success"`
+	Error              *CallError                  `json:"error,omitempty"`
+	Calls              []*Call                     `json:"calls,omitempty"`
+}
+
+// CallError DispatchError of call and the event raising it
+type CallError struct {
+	Source string      `json:"source"`
+	Value  interface{} `json:"value"`
+}
+
+// CallEvent event of extrinsic, params only keep decoded value
+type CallEvent struct {
+	EventIndex string
+	EventIdx   int
+	ModuleId   string
+	EventId    string
+	Params     []interface{}
+}
+
+func (e *CallEvent) source() string {
+	return fmt.Sprintf("%s.%s", strings.ToLower(e.ModuleId), e.EventId)
+}
+
+// ParseCallTree decode extrinsic call tree with decoded extrinsic params
+func ParseCallTree(callModule, callModuleFunction string, params interface{}) *Call {
+	return parseCall("", callModule, callModuleFunction, params)
+}
+
+func parseCall(path, callModule, callModuleFunction string, raw interface{}) *Call {
+	c := &Call{Path: path, CallModule: callModule, CallModuleFunction: callModuleFunction, Success: true}
+	for _, param := range callParams(raw) {
+		calls := parseSubCalls(param.Value, path, len(c.Calls))
+		if len(calls) == 0 {
+			c.Params = append(c.Params, param)
+			continue
+		}
+		c.Calls = append(c.Calls, calls...)
+	}
+	return c
+}
+
+// callParams params of call, values of decoded params are kept as is, like json.Number decoded with UseNumber
+func callParams(raw interface{}) []scalecodec.ExtrinsicParam {
+	var params []scalecodec.ExtrinsicParam
+	if list, ok := raw.([]interface{}); ok {
+		for _, item := range list {
+			param, ok := item.(map[string]interface{})
+			if !ok {
+				params = nil
+				break
+			}
+			name, _ := param["name"].(string)
+			typ, _ := param["type"].(string)
+			typeName, _ := param["type_name"].(string)
+			params = append(params, scalecodec.ExtrinsicParam{Name: name, Type: typ, TypeName: typeName, Value: param["value"]})
+		}
+		if params != nil {
+			return params
+		}
+	}
+	_ = util.UnmarshalAny(&params, raw)
+	return params
+}
+
+// parseSubCalls decode Call/Box<Call> value or Vec<Call> value, start is the index of first sub call
+func parseSubCalls(value interface{}, path string, start int) (calls []*Call) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		module, hasModule := v["call_module"].(string)
+		function, hasFunction := v["call_name"].(string)
+		if hasModule && hasFunction {
+			return []*Call{parseCall(joinCallPath(path, start), module, function, v["params"])}
+		}
+	case []interface{}:
+		for _, item := range v {
+			sub := parseSubCalls(item, path, start+len(calls))
+			if len(sub) == 0 {
+				return nil
+			}
+			calls = append(calls, sub...)
+		}
+	}
+	return
+}
+
+// SubCalls all sub calls of the tree, depth first
+func (c *Call) SubCalls() (calls []*Call) {
+	for _, sub := range c.Calls {
+		calls = append(calls, sub)
+		calls = append(calls, sub.SubCalls()...)
+	}
+	return
+}
+
+// AttributeEvents replay the call tree with extrinsic events in execution order, attribute events to sub calls.
+// Wrapper result event is emitted after the inner call, batch emit ItemCompleted/ItemFailed after every item
+// and BatchInterrupted when stopped. Events of failed call are reverted.
+// Extrinsic level events (System, TransactionPayment, fee withdraw) are kept in root call.
+func (c *Call) AttributeEvents(events []CallEvent) {
+	sort.SliceStable(events, func(i, j int) bool { return events[i].EventIdx < events[j].EventIdx })
+	w := &callWalker{}
+	for i, event := range events {
+		if isExtrinsicLevelEvent(i, event) {
+			c.Events = append(c.Events, event.EventIndex)
+			if strings.EqualFold(event.EventId, "ExtrinsicFailed") {
+				c.fail(event.source(), firstParam(event.Params))
+			}
+			continue
+		}
+		w.events = append(w.events, event)
+	}
+	w.walk(c)
+	for ; w.cursor < len(w.events); w.cursor++ {
+		c.Events = append(c.Events, w.events[w.cursor].EventIndex)
+	}
+}
+
+func (c *Call) fail(source string, err interface{}) {
+	c.Success = false
+	if err != nil {
+		c.Error = &CallError{Source: source, Value: err}
+	}
+}
+
+type callWalker struct {
+	events []CallEvent
+	cursor int
+}
+
+func (w *callWalker) peek() *CallEvent {
+	if w.cursor >= len(w.events) {
+		return nil
+	}
+	return &w.events[w.cursor]
+}
+
+// next consume the next event if it is the module event of eventIds
+func (w *callWalker) next(c *Call, module string, eventIds ...string) *CallEvent {
+	event := w.peek()
+	if event == nil || !strings.EqualFold(event.ModuleId, module) || !util.StringInSliceFold(event.EventId, eventIds) {
+		return nil
+	}
+	w.cursor++
+	c.Events = append(c.Events, event.EventIndex)
+	return event
+}
+
+func (w *callWalker) walk(c *Call) {
+	module, function := strings.ToLower(c.CallModule), strings.ToLower(c.CallModuleFunction)
+	switch {
+	case module == "utility" && util.StringInSlice(function, []string{"batch", "batch_all", "force_batch"}):
+		w.walkBatch(c)
+	case module == "utility" && function == "dispatch_as":
+		w.walkWrapper(c, "utility", "DispatchedAs")
+	case module == "proxy" && (function == "proxy" || function == "proxy_announced"):
+		w.walkWrapper(c, "proxy", "ProxyExecuted")
+	case module == "multisig" && function == "as_multi":
+		w.walkWrapper(c, "multisig", "MultisigExecuted")
+	case module == "sudo" && (function == "sudo" || function == "sudo_unchecked_weight"):
+		w.walkWrapper(c, "sudo", "Sudid")
+	case module == "sudo" && function == "sudo_as":
+		w.walkWrapper(c, "sudo", "SudoAsDone")
+	case len(c.Calls) > 0:
+		// wrapper without result event, like utility.as_derivative
+		for _, sub := range c.Calls {
+			w.walk(sub)
+		}
+	default:
+		for event := w.peek(); event != nil && !isNestedResultEvent(event); event = w.peek() {
+			c.Events = append(c.Events, event.EventIndex)
+			w.cursor++
+		}
+	}
+}
+
+func (w *callWalker) walkWrapper(c *Call, module, eventId string) {
+	for _, sub := range c.Calls {
+		w.walk(sub)
+	}
+	event := w.next(c, module, eventId)
+	if event == nil || len(c.Calls) == 0 {
+		return
+	}
+	for _, param := range event.Params {
+		if err, ok := DispatchResultError(param); ok {
+			c.Calls[0].fail(event.source(), err)
+		}
+	}
+}
+
+func (w *callWalker) walkBatch(c *Call) {
+	for _, item := range c.Calls {
+		if event := w.next(c, "utility", "BatchInterrupted"); event != nil {
+			c.interrupted(event)
+			return
+		}
+		w.walk(item)
+		event := w.next(item, "utility", "ItemCompleted", "ItemFailed")
+		if event == nil {
+			continue
+		}
+		if strings.EqualFold(event.EventId, "ItemFailed") {
+			item.fail(event.source(), firstParam(event.Params))
+		}
+	}
+	if event := w.next(c, "utility", "BatchInterrupted"); event != nil {
+		c.interrupted(event)
+		return
+	}
+	w.next(c, "utility", "BatchCompleted", "BatchCompletedWithErrors")
+}
+
+// interrupted BatchInterrupted(index: u32, error: DispatchError), items after index not executed
+func (c *Call) interrupted(event *CallEvent) {
+	if len(event.Params) < 2 {
+		return
+	}
+	index := util.IntFromInterface(event.Params[0])
+	for i := index; i < len(c.Calls); i++ {
+		if i == index {
+			c.Calls[i].fail(event.source(), event.Params[1])
+			continue
+		}
+		c.Calls[i].Success = false
+	}
+}
+
+// DispatchResultError checkout DispatchError from decoded DispatchResult, return false if result is Ok
+func DispatchResultError(raw interface{}) (interface{}, bool) {
+	v, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	for key, value := range v {
+		if strings.EqualFold(key, "Err") || (strings.EqualFold(key, "Error") && len(v) == 1) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func isNestedResultEvent(event *CallEvent) bool {
+	return util.StringInSliceFold(event.EventId, nestedResultEvents[strings.ToLower(event.ModuleId)])
+}
+
+func isExtrinsicLevelEvent(index int, event CallEvent) bool {
+	switch strings.ToLower(event.ModuleId) {
+	case "system", "transactionpayment":
+		return true
+	case "balances":
+		// fee withdraw before call dispatch
+		return index == 0 && strings.EqualFold(event.EventId, "Withdraw")
+	}
+	return false
+}
+
+func firstParam(params []interface{}) interface{} {
+	if len(params) == 0 {
+		return nil
+	}
+	return params[0]
+}
+
+func joinCallPath(path string, index int) string {
+	if path == "" {
+		return fmt.Sprint(index)
+	}
+	return fmt.Sprintf("%s.%d", path, index)
+}
@@ -0,0 +1,88 @@
+package substrate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testTransfer = map[string]interface{}{"call_module": "Balances", "call_name": "transfer_keep_alive", "params": []interface{}{
+		map[string]interface{}{"name": "value", "type": "compact<U128>", "value": "100"},
+	}}
+	testProxy = map[string]interface{}{"call_module": "Proxy", "call_name": "proxy", "params": []interface{}{
+		map[string]interface{}{"name": "real", "type": "AccountId", "value": "0x00"},
+		map[string]interface{}{"name": "call", "type": "Call", "value": testTransfer},
+	}}
+	testBatchParams = []interface{}{
+		map[string]interface{}{"name": "calls", "type": "Vec<Call>", "value": []interface{}{testTransfer, testProxy, testTransfer}},
+	}
+	testTokenErr = map[string]interface{}{"Token": "FundsUnavailable"}
+)
+
+func testCallEvent(idx int, module, eventId string, params ...interface{}) CallEvent {
+	return CallEvent{EventIndex: "1-" + string(rune('0'+idx)), EventIdx: idx, ModuleId: module, EventId: eventId, Params: params}
+}
+
+func TestParseCallTree(t *testing.T) {
+	tree := ParseCallTree("Utility", "batch_all", testBatchParams)
+	assert.Len(t, tree.Calls, 3)
+	assert.Empty(t, tree.Params)
+	assert.Equal(t, "1", tree.Calls[1].Path)
+	assert.Equal(t, "transfer_keep_alive", tree.Calls[1].Calls[0].CallModuleFunction)
+	assert.Equal(t, "1.0", tree.Calls[1].Calls[0].Path)
+	assert.Len(t, tree.Calls[1].Params, 1)
+	assert.Len(t, tree.SubCalls(), 4)
+
+	leaf := ParseCallTree("Balances", "transfer_keep_alive", testTransfer["params"])
+	assert.Empty(t, leaf.Calls)
+	assert.Len(t, leaf.Params, 1)
+
+	// decoded values are kept
+	nested := ParseCallTree("Proxy", "proxy", []interface{}{
+		map[string]interface{}{"name": "call", "type": "Call", "value": map[string]interface{}{"call_module": "Balances", "call_name": "transfer_keep_alive", "params": []interface{}{
+			map[string]interface{}{"name": "value", "type": "compact<U128>", "value": json.Number("123456789012345678901234567890")},
+		}}},
+	})
+	assert.Equal(t, json.Number("123456789012345678901234567890"), nested.Calls[0].Params[0].Value)
+}
+
+func TestCallTreeAttributeEvents(t *testing.T) {
+	// force_batch, item 1 proxy inner call failed, item 2 failed
+	tree := ParseCallTree("Utility", "force_batch", testBatchParams)
+	tree.AttributeEvents([]CallEvent{
+		testCallEvent(0, "balances", "Withdraw"),
+		testCallEvent(1, "balances", "Transfer"),
+		testCallEvent(2, "utility", "ItemCompleted"),
+		testCallEvent(3, "proxy", "ProxyExecuted", map[string]interface{}{"Err": testTokenErr}),
+		testCallEvent(4, "utility", "ItemCompleted"),
+		testCallEvent(5, "utility", "ItemFailed", map[string]interface{}{"BadOrigin": nil}),
+		testCallEvent(6, "utility", "BatchCompletedWithErrors"),
+		testCallEvent(8, "system", "ExtrinsicSuccess"),
+		testCallEvent(7, "transactionpayment", "TransactionFeePaid"),
+	})
+	assert.True(t, tree.Success)
+	assert.Equal(t, []string{"1-0", "1-7", "1-8", "1-6"}, tree.Events)
+	assert.Equal(t, []string{"1-1", "1-2"}, tree.Calls[0].Events)
+	assert.Equal(t, []string{"1-3", "1-4"}, tree.Calls[1].Events)
+	assert.True(t, tree.Calls[1].Success)
+	assert.False(t, tree.Calls[1].Calls[0].Success)
+	assert.Equal(t, "proxy.ProxyExecuted", tree.Calls[1].Calls[0].Error.Source)
+	assert.Equal(t, testTokenErr, tree.Calls[1].Calls[0].Error.Value)
+	assert.Equal(t, "utility.ItemFailed", tree.Calls[2].Error.Source)
+
+	// batch interrupted at item 1
+	tree = ParseCallTree("Utility", "batch", testBatchParams)
+	tree.AttributeEvents([]CallEvent{
+		testCallEvent(2, "utility", "ItemCompleted"),
+		testCallEvent(1, "balances", "Transfer"),
+		testCallEvent(3, "utility", "BatchInterrupted", float64(1), testTokenErr),
+	})
+	assert.True(t, tree.Calls[0].Success)
+	assert.Equal(t, "utility.BatchInterrupted", tree.Calls[1].Error.Source)
+	assert.Equal(t, testTokenErr, tree.Calls[1].Error.Value)
+	assert.False(t, tree.Calls[2].Success)
+	assert.Nil(t, tree.Calls[2].Error)
+	assert.Equal(t, []string{"1-3"}, tree.Events)
+}
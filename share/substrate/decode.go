@@ -0,0 +1,69 @@
+package substrate
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/itering/scale.go/types"
+	"github.com/itering/scale.go/types/scaleBytes"
+	"github.com/itering/subscan/util"
+	substrateRpc "github.com/itering/substrate-api-rpc"
+	"github.com/itering/substrate-api-rpc/hasher"
+	"github.com/itering/substrate-api-rpc/metadata"
+)
+
+// DecodeCall decode call(call index with args) with the metadata of spec
+func DecodeCall(raw string, m *metadata.Instant, spec int) (call map[string]interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("decode call error: %v", r)
+		}
+	}()
+	data := util.HexToBytes(raw)
+	if len(data) < 2 {
+		return nil, fmt.Errorf("call too short")
+	}
+	ms := types.MetadataStruct(*m)
+	if _, ok := ms.CallIndex[util.BytesToHex(data[:2])]; !ok {
+		return nil, fmt.Errorf("call index %s not found", util.BytesToHex(data[:2]))
+	}
+	e := types.ScaleDecoder{}
+	e.Init(scaleBytes.ScaleBytes{Data: data}, &types.ScaleDecoderOption{Metadata: &ms, Spec: spec})
+	call, _ = e.ProcessAndUpdateData("Call").(map[string]interface{})
+	if e.Data.Offset != len(data) {
+		return nil, fmt.Errorf("%d bytes of call remain undecoded", len(data)-e.Data.Offset)
+	}
+	return call, nil
+}
+
+// DecodeEventRecord decode single event record, as element of System.Events
+func DecodeEventRecord(raw string, m *metadata.Instant, spec int) (interface{}, error) {
+	// compact length 1 of vec
+	events, err := substrateRpc.DecodeEvent("0x04"+util.TrimHex(raw), m, spec)
+	if err != nil {
+		return nil, err
+	}
+	if list, ok := events.([]interface{}); ok && len(list) == 1 {
+		return list[0], nil
+	}
+	return nil, fmt.Errorf("invalid event record")
+}
+
+// FindStorageByKey storage item of storage key, matched by twox128 of pallet prefix and item name
+func FindStorageByKey(m *metadata.Instant, key string) (*types.MetadataModules, *types.MetadataStorage) {
+	raw := util.HexToBytes(key)
+	if m == nil || len(raw) < moduleMethodSize {
+		return nil, nil
+	}
+	for i, mm := range m.Metadata.Modules {
+		if !bytes.Equal(hasher.HashByCryptoName([]byte(mm.Prefix), "Twox128"), raw[:16]) {
+			continue
+		}
+		for j, s := range mm.Storage {
+			if bytes.Equal(hasher.HashByCryptoName([]byte(s.Name), "Twox128"), raw[16:32]) {
+				return &m.Metadata.Modules[i], &m.Metadata.Modules[i].Storage[j]
+			}
+		}
+	}
+	return nil, nil
+}
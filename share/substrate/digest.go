@@ -0,0 +1,430 @@
+package substrate
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/itering/subscan/util"
+)
+
+// Consensus engine ids of digest items
+const (
+	EngineAura    = "aura"
+	EngineBabe    = "BABE"
+	EngineGrandpa = "FRNK"
+	EngineBeefy   = "BEEF"
+)
+
+// Digest item types of LogDigest
+const (
+	DigestPreRuntime                = "PreRuntime"
+	DigestSeal                      = "Seal"
+	DigestConsensus                 = "Consensus"
+	DigestRuntimeEnvironmentUpdated = "RuntimeEnvironmentUpdated"
+)
+
+var errDigestTooShort = errors.New("digest data too short")
+
+// DigestItem digest log decoded with its consensus engine
+type DigestItem struct {
+	Engine  string
+	Kind    string
+	Slot    uint64
+	Decoded map[string]interface{}
+}
+
+// DigestDecoder decode data of digest item of engine, kind is the variant name of the item
+type DigestDecoder func(data []byte) (kind string, slot uint64, decoded map[string]interface{}, err error)
+
+var (
+	digestDecodersLock sync.RWMutex
+	digestDecoders     = map[string]DigestDecoder{}
+)
+
+func digestDecoderKey(engine, logType string) string {
+	return engine + "/" + logType
+}
+
+// RegisterDigestDecoder decoder of digest item type of engine, replace the built-in decoder if exists
+func RegisterDigestDecoder(engine, logType string, decoder DigestDecoder) {
+	digestDecodersLock.Lock()
+	defer digestDecodersLock.Unlock()
+	digestDecoders[digestDecoderKey(engine, logType)] = decoder
+}
+
+func digestDecoderOf(engine, logType string) DigestDecoder {
+	digestDecodersLock.RLock()
+	defer digestDecodersLock.RUnlock()
+	return digestDecoders[digestDecoderKey(engine, logType)]
+}
+
+// EngineName 4 bytes engine id of PreRuntime/Seal/Consensus, decoded as little endian u32 by LogDigest
+func EngineName(engine int64) string {
+	raw := make([]byte, 4)
+	binary.LittleEndian.PutUint32(raw, uint32(engine))
+	return string(raw)
+}
+
+// EngineId little endian u32 of 4 bytes engine name
+func EngineId(name string) int64 {
+	var raw [4]byte
+	copy(raw[:], name)
+	return int64(binary.LittleEndian.Uint32(raw[:]))
+}
+
+// DecodeDigest decode value of digest log by engine, nil if the item is not an engine digest or
+// no decoder of the engine is registered
+func DecodeDigest(logType string, value interface{}) (*DigestItem, error) {
+	if strings.EqualFold(logType, DigestRuntimeEnvironmentUpdated) {
+		return &DigestItem{Kind: DigestRuntimeEnvironmentUpdated}, nil
+	}
+	v, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	engine, ok := v["engine"]
+	if !ok {
+		return nil, nil
+	}
+	item := DigestItem{Engine: EngineName(util.Int64FromInterface(engine))}
+	decoder := digestDecoderOf(item.Engine, logType)
+	if decoder == nil {
+		return &item, nil
+	}
+	var err error
+	if item.Kind, item.Slot, item.Decoded, err = decoder(util.HexToBytes(util.ToString(v["data"]))); err != nil {
+		return &item, fmt.Errorf("decode %s %s digest error %v", item.Engine, logType, err)
+	}
+	return &item, nil
+}
+
+// digestReader little endian SCALE reader of digest data
+type digestReader struct {
+	data   []byte
+	offset int
+}
+
+func (r *digestReader) bytes(n int) ([]byte, error) {
+	if n < 0 || r.offset+n > len(r.data) {
+		return nil, errDigestTooShort
+	}
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+	return b, nil
+}
+
+func (r *digestReader) u8() (uint8, error) {
+	b, err := r.bytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (r *digestReader) u32() (uint32, error) {
+	b, err := r.bytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *digestReader) u64() (uint64, error) {
+	b, err := r.bytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (r *digestReader) hex(n int) (string, error) {
+	b, err := r.bytes(n)
+	if err != nil {
+		return "", err
+	}
+	return util.AddHex(util.BytesToHex(b)), nil
+}
+
+// compact SCALE compact integer, used as length of vec
+func (r *digestReader) compact() (int, error) {
+	b, err := r.u8()
+	if err != nil {
+		return 0, err
+	}
+	switch b & 3 {
+	case 0:
+		return int(b >> 2), nil
+	case 1:
+		next, err := r.u8()
+		if err != nil {
+			return 0, err
+		}
+		return int(uint16(b)|uint16(next)<<8) >> 2, nil
+	case 2:
+		rest, err := r.bytes(3)
+		if err != nil {
+			return 0, err
+		}
+		return int(binary.LittleEndian.Uint32([]byte{b, rest[0], rest[1], rest[2]}) >> 2), nil
+	}
+	return 0, fmt.Errorf("compact length of %d bytes is not supported", b>>2+4)
+}
+
+// authorities Vec<(AuthorityId, u64 weight)>
+func (r *digestReader) authorities(keyLen int) ([]map[string]interface{}, error) {
+	n, err := r.compact()
+	if err != nil {
+		return nil, err
+	}
+	list := []map[string]interface{}{}
+	for i := 0; i < n; i++ {
+		key, err := r.hex(keyLen)
+		if err != nil {
+			return nil, err
+		}
+		weight, err := r.u64()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, map[string]interface{}{"authority": key, "weight": weight})
+	}
+	return list, nil
+}
+
+func init() {
+	RegisterDigestDecoder(EngineAura, DigestPreRuntime, decodeAuraPreRuntime)
+	RegisterDigestDecoder(EngineAura, DigestSeal, decodeSeal)
+	RegisterDigestDecoder(EngineAura, DigestConsensus, decodeAuraConsensus)
+	RegisterDigestDecoder(EngineBabe, DigestPreRuntime, decodeBabePreRuntime)
+	RegisterDigestDecoder(EngineBabe, DigestSeal, decodeSeal)
+	RegisterDigestDecoder(EngineBabe, DigestConsensus, decodeBabeConsensus)
+	RegisterDigestDecoder(EngineGrandpa, DigestConsensus, decodeGrandpaConsensus)
+	RegisterDigestDecoder(EngineBeefy, DigestConsensus, decodeBeefyConsensus)
+}
+
+// decodeSeal sr25519/ed25519 signature of block author
+func decodeSeal(data []byte) (string, uint64, map[string]interface{}, error) {
+	return "Seal", 0, map[string]interface{}{"signature": util.AddHex(util.BytesToHex(data))}, nil
+}
+
+// decodeAuraPreRuntime slot u64
+func decodeAuraPreRuntime(data []byte) (string, uint64, map[string]interface{}, error) {
+	r := digestReader{data: data}
+	slot, err := r.u64()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	return "Slot", slot, map[string]interface{}{"slot": slot}, nil
+}
+
+// decodeAuraConsensus ConsensusLog of sp_consensus_aura
+func decodeAuraConsensus(data []byte) (string, uint64, map[string]interface{}, error) {
+	r := digestReader{data: data}
+	index, err := r.u8()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	switch index {
+	case 1:
+		n, err := r.compact()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		authorities := []string{}
+		for i := 0; i < n; i++ {
+			key, err := r.hex(32)
+			if err != nil {
+				return "", 0, nil, err
+			}
+			authorities = append(authorities, key)
+		}
+		return "AuthoritiesChange", 0, map[string]interface{}{"authorities": authorities}, nil
+	case 2:
+		authorityIndex, err := r.u32()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "OnDisabled", 0, map[string]interface{}{"authority_index": authorityIndex}, nil
+	}
+	return "", 0, nil, fmt.Errorf("unknown aura consensus log %d", index)
+}
+
+// decodeBabePreRuntime PreDigest of sp_consensus_babe
+func decodeBabePreRuntime(data []byte) (string, uint64, map[string]interface{}, error) {
+	r := digestReader{data: data}
+	index, err := r.u8()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	var kind string
+	switch index {
+	case 1:
+		kind = "Primary"
+	case 2:
+		kind = "SecondaryPlain"
+	case 3:
+		kind = "SecondaryVRF"
+	default:
+		return "", 0, nil, fmt.Errorf("unknown babe pre digest %d", index)
+	}
+	authorityIndex, err := r.u32()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	slot, err := r.u64()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	decoded := map[string]interface{}{"authority_index": authorityIndex, "slot": slot}
+	if kind != "SecondaryPlain" {
+		if decoded["vrf_output"], err = r.hex(32); err != nil {
+			return "", 0, nil, err
+		}
+		if decoded["vrf_proof"], err = r.hex(64); err != nil {
+			return "", 0, nil, err
+		}
+	}
+	return kind, slot, decoded, nil
+}
+
+// decodeBabeConsensus ConsensusLog of sp_consensus_babe
+func decodeBabeConsensus(data []byte) (string, uint64, map[string]interface{}, error) {
+	r := digestReader{data: data}
+	index, err := r.u8()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	switch index {
+	case 1:
+		authorities, err := r.authorities(32)
+		if err != nil {
+			return "", 0, nil, err
+		}
+		randomness, err := r.hex(32)
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "NextEpochData", 0, map[string]interface{}{"authorities": authorities, "randomness": randomness}, nil
+	case 2:
+		authorityIndex, err := r.u32()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "OnDisabled", 0, map[string]interface{}{"authority_index": authorityIndex}, nil
+	case 3:
+		// NextConfigDescriptor::V1 {c: (u64, u64), allowed_slots}
+		if version, err := r.u8(); err != nil || version != 1 {
+			return "", 0, nil, fmt.Errorf("unknown babe next config version")
+		}
+		numerator, err := r.u64()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		denominator, err := r.u64()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		allowedSlots, err := r.u8()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		slots := []string{"PrimarySlots", "PrimaryAndSecondaryPlainSlots", "PrimaryAndSecondaryVRFSlots"}
+		if int(allowedSlots) >= len(slots) {
+			return "", 0, nil, fmt.Errorf("unknown babe allowed slots %d", allowedSlots)
+		}
+		return "NextConfigData", 0, map[string]interface{}{"c": []uint64{numerator, denominator}, "allowed_slots": slots[allowedSlots]}, nil
+	}
+	return "", 0, nil, fmt.Errorf("unknown babe consensus log %d", index)
+}
+
+// decodeGrandpaConsensus ConsensusLog of sp_consensus_grandpa
+func decodeGrandpaConsensus(data []byte) (string, uint64, map[string]interface{}, error) {
+	r := digestReader{data: data}
+	index, err := r.u8()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	scheduledChange := func(decoded map[string]interface{}) (map[string]interface{}, error) {
+		authorities, err := r.authorities(32)
+		if err != nil {
+			return nil, err
+		}
+		delay, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		decoded["next_authorities"] = authorities
+		decoded["delay"] = delay
+		return decoded, nil
+	}
+	switch index {
+	case 1:
+		decoded, err := scheduledChange(map[string]interface{}{})
+		return "ScheduledChange", 0, decoded, err
+	case 2:
+		median, err := r.u32()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		decoded, err := scheduledChange(map[string]interface{}{"median_last_finalized": median})
+		return "ForcedChange", 0, decoded, err
+	case 3:
+		authorityIndex, err := r.u64()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "OnDisabled", 0, map[string]interface{}{"authority_index": authorityIndex}, nil
+	case 4, 5:
+		delay, err := r.u32()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return map[uint8]string{4: "Pause", 5: "Resume"}[index], 0, map[string]interface{}{"delay": delay}, nil
+	}
+	return "", 0, nil, fmt.Errorf("unknown grandpa consensus log %d", index)
+}
+
+// decodeBeefyConsensus ConsensusLog of sp_consensus_beefy, ecdsa authority ids
+func decodeBeefyConsensus(data []byte) (string, uint64, map[string]interface{}, error) {
+	r := digestReader{data: data}
+	index, err := r.u8()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	switch index {
+	case 1:
+		n, err := r.compact()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		validators := []string{}
+		for i := 0; i < n; i++ {
+			key, err := r.hex(33)
+			if err != nil {
+				return "", 0, nil, err
+			}
+			validators = append(validators, key)
+		}
+		id, err := r.u64()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "AuthoritiesChange", 0, map[string]interface{}{"validators": validators, "id": id}, nil
+	case 2:
+		authorityIndex, err := r.u32()
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "OnDisabled", 0, map[string]interface{}{"authority_index": authorityIndex}, nil
+	case 3:
+		root, err := r.hex(32)
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return "MmrRoot", 0, map[string]interface{}{"mmr_root": root}, nil
+	}
+	return "", 0, nil, fmt.Errorf("unknown beefy consensus log %d", index)
+}
@@ -0,0 +1,101 @@
+package substrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/itering/subscan/util"
+	substrateRpc "github.com/itering/substrate-api-rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDigest(engine, data string) map[string]interface{} {
+	return map[string]interface{}{"engine": EngineId(engine), "data": data}
+}
+
+func TestEngineName(t *testing.T) {
+	assert.Equal(t, int64(1161969986), EngineId(EngineBabe))
+	assert.Equal(t, int64(1634891105), EngineId(EngineAura))
+	assert.Equal(t, EngineGrandpa, EngineName(EngineId(EngineGrandpa)))
+}
+
+func TestDecodeDigest(t *testing.T) {
+	logs, err := substrateRpc.DecodeLogDigest([]string{
+		"0x0642414245b5010102000000efa6cd0f000000004618a29aeb02e8ae7bb2360d8f5f13828c3c2f9fd15bc674be6e2c64be17a00ebb8fa2449c7b19b5988d6110e0f03a44693f246597e7bdf1a4b48aa4c50b600e6252c08951731c00e11a7f5a6b26d7c6bdf421145c575a03c23420bd76decd06",
+		"0x00904d4d5252aec4a1a273aca92e65330af40d9b06447427454910e0e1b9fc9e2157b670a30f",
+		"0x054241424501019e89556620e6f4ed93cf9a939349d6928b38e5688ad0abb7cd3b6f8d9c3016021ac1b30fbf4aec0de00d9a288b261da9e4ed4921f64ed6393309ddc230c9cf8d",
+		"0x08",
+	})
+	assert.NoError(t, err)
+
+	item, err := DecodeDigest(logs[0].Type, logs[0].Value)
+	assert.NoError(t, err)
+	assert.Equal(t, EngineBabe, item.Engine)
+	assert.Equal(t, "Primary", item.Kind)
+	assert.Equal(t, uint64(0x0fcda6ef), item.Slot)
+	assert.Equal(t, uint32(2), item.Decoded["authority_index"])
+	assert.Equal(t, "0x4618a29aeb02e8ae7bb2360d8f5f13828c3c2f9fd15bc674be6e2c64be17a00e", item.Decoded["vrf_output"])
+
+	// Other has no engine
+	item, err = DecodeDigest(logs[1].Type, logs[1].Value)
+	assert.NoError(t, err)
+	assert.Nil(t, item)
+
+	item, err = DecodeDigest(logs[2].Type, logs[2].Value)
+	assert.NoError(t, err)
+	assert.Equal(t, "Seal", item.Kind)
+	assert.Len(t, item.Decoded["signature"], 130)
+
+	item, err = DecodeDigest(logs[3].Type, logs[3].Value)
+	assert.NoError(t, err)
+	assert.Equal(t, DigestRuntimeEnvironmentUpdated, item.Kind)
+}
+
+func TestDecodeDigest_Engines(t *testing.T) {
+	key := strings.Repeat("11", 32)
+	cases := []struct {
+		engine, logType, data string
+		kind                  string
+		slot                  uint64
+		field                 string
+		value                 interface{}
+	}{
+		{EngineAura, DigestPreRuntime, "0x2a00000000000000", "Slot", 42, "slot", uint64(42)},
+		{EngineAura, DigestConsensus, "0x0104" + key, "AuthoritiesChange", 0, "authorities", []string{"0x" + key}},
+		{EngineBabe, DigestPreRuntime, "0x02030000002a00000000000000", "SecondaryPlain", 42, "authority_index", uint32(3)},
+		{EngineBabe, DigestConsensus, "0x03010100000000000000040000000000000002", "NextConfigData", 0, "allowed_slots", "PrimaryAndSecondaryVRFSlots"},
+		{EngineGrandpa, DigestConsensus, "0x0104" + key + "0100000000000000" + "05000000", "ScheduledChange", 0, "delay", uint32(5)},
+		{EngineGrandpa, DigestConsensus, "0x020a000000" + "04" + key + "0100000000000000" + "00000000", "ForcedChange", 0, "median_last_finalized", uint32(10)},
+		{EngineGrandpa, DigestConsensus, "0x0407000000", "Pause", 0, "delay", uint32(7)},
+		{EngineBeefy, DigestConsensus, "0x03" + key, "MmrRoot", 0, "mmr_root", "0x" + key},
+		{EngineBeefy, DigestConsensus, "0x0104" + key + "22" + "0900000000000000", "AuthoritiesChange", 0, "id", uint64(9)},
+	}
+	for _, c := range cases {
+		item, err := DecodeDigest(c.logType, testDigest(c.engine, c.data))
+		assert.NoError(t, err, c.kind)
+		assert.Equal(t, c.engine, item.Engine)
+		assert.Equal(t, c.kind, item.Kind)
+		assert.Equal(t, c.slot, item.Slot)
+		assert.Equal(t, c.value, item.Decoded[c.field], c.kind)
+	}
+}
+
+func TestDecodeDigest_Error(t *testing.T) {
+	// truncated babe primary digest
+	item, err := DecodeDigest(DigestPreRuntime, testDigest(EngineBabe, "0x0102000000"))
+	assert.Error(t, err)
+	assert.Equal(t, EngineBabe, item.Engine)
+
+	// engine without decoder
+	item, err = DecodeDigest(DigestConsensus, testDigest("nmbs", "0x00"))
+	assert.NoError(t, err)
+	assert.Equal(t, "nmbs", item.Engine)
+	assert.Empty(t, item.Kind)
+
+	RegisterDigestDecoder("nmbs", DigestConsensus, func(data []byte) (string, uint64, map[string]interface{}, error) {
+		return "Custom", 0, map[string]interface{}{"data": util.BytesToHex(data)}, nil
+	})
+	item, err = DecodeDigest(DigestConsensus, testDigest("nmbs", "0x00"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Custom", item.Kind)
+}
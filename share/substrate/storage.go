@@ -0,0 +1,208 @@
+package substrate
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/itering/scale.go/types"
+	"github.com/itering/subscan/util"
+	"github.com/itering/subscan/util/address"
+	"github.com/itering/substrate-api-rpc/hasher"
+	"github.com/itering/substrate-api-rpc/metadata"
+	"github.com/itering/substrate-api-rpc/model"
+	"github.com/itering/substrate-api-rpc/rpc"
+	"github.com/itering/substrate-api-rpc/storage"
+	"github.com/itering/substrate-api-rpc/websocket"
+	"github.com/shopspring/decimal"
+)
+
+// FindStorage find pallet storage item from the given metadata
+func FindStorage(m *metadata.Instant, module, method string) (*types.MetadataModules, *types.MetadataStorage) {
+	if m == nil {
+		return nil, nil
+	}
+	for i, mm := range m.Metadata.Modules {
+		if !strings.EqualFold(mm.Name, module) {
+			continue
+		}
+		for j, s := range mm.Storage {
+			if strings.EqualFold(s.Name, method) {
+				return &m.Metadata.Modules[i], &m.Metadata.Modules[i].Storage[j]
+			}
+		}
+		return &m.Metadata.Modules[i], nil
+	}
+	return nil, nil
+}
+
+// FindConstant find pallet constant from the given metadata
+func FindConstant(m *metadata.Instant, module, name string) *types.MetadataConstants {
+	if m == nil {
+		return nil
+	}
+	for _, mm := range m.Metadata.Modules {
+		if !strings.EqualFold(mm.Name, module) {
+			continue
+		}
+		for i, c := range mm.Constants {
+			if strings.EqualFold(c.Name, name) {
+				return &mm.Constants[i]
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeConstant decode pallet constant value with the metadata of spec
+func DecodeConstant(m *metadata.Instant, spec int, module, name string) (storage.StateStorage, error) {
+	constant := FindConstant(m, module, name)
+	if constant == nil {
+		return "", fmt.Errorf("constant %s.%s not found", module, name)
+	}
+	ms := types.MetadataStruct(*m)
+	r, _, err := storage.Decode(constant.ConstantsValue, constant.Type, &types.ScaleDecoderOption{Metadata: &ms, Spec: spec})
+	return r, err
+}
+
+// PlainStorageKey encode plain(no map key) storage key
+func PlainStorageKey(prefix, method string) string {
+	key := append(hasher.HashByCryptoName([]byte(prefix), "Twox128"), hasher.HashByCryptoName([]byte(method), "Twox128")...)
+	return util.AddHex(util.BytesToHex(key))
+}
+
+// ReadPlainStorage read plain storage value at block hash, decode with the metadata of spec
+// return empty StateStorage if storage not exist
+func ReadPlainStorage(ctx context.Context, m *metadata.Instant, spec int, module, method, hash string) (r storage.StateStorage, err error) {
+	mm, s := FindStorage(m, module, method)
+	if s == nil {
+		return "", fmt.Errorf("storage %s.%s not found", module, method)
+	}
+	option := CheckoutHasherAndType(&s.Type)
+	raw, err := ReadStorageRaw(ctx, PlainStorageKey(mm.Prefix, s.Name), hash)
+	if err != nil {
+		return
+	}
+	if raw == "" {
+		return "", nil
+	}
+	ms := types.MetadataStruct(*m)
+	r, _, err = storage.Decode(raw, option.Value, &types.ScaleDecoderOption{Metadata: &ms, Spec: spec})
+	return
+}
+
+// EncodeStorageKey storage key of module.method with the metadata of spec, keys are scale encoded with key types of the item
+// keys fewer than the item make the prefix for map iteration
+func EncodeStorageKey(m *metadata.Instant, spec int, module, method string, keys ...interface{}) (key string, option *StorageOption, err error) {
+	mm, s := FindStorage(m, module, method)
+	if s == nil {
+		return "", nil, fmt.Errorf("storage %s.%s not found", module, method)
+	}
+	option = CheckoutHasherAndType(&s.Type)
+	if len(option.Keys) == 0 {
+		option.Hasher = nil
+	}
+	if len(keys) > len(option.Keys) {
+		return "", nil, fmt.Errorf("storage %s.%s has %d keys, got %d", module, method, len(option.Keys), len(keys))
+	}
+	key = PlainStorageKey(mm.Prefix, s.Name)
+	ms := types.MetadataStruct(*m)
+	for i, arg := range keys {
+		encoded, err := encodeStorageKeyArg(option.Keys[i], arg, &types.ScaleDecoderOption{Metadata: &ms, Spec: spec})
+		if err != nil {
+			return "", nil, fmt.Errorf("encode key %d of %s.%s: %w", i, module, method, err)
+		}
+		key += util.BytesToHex(hasher.HashByCryptoName(util.HexToBytes(encoded), option.Hasher[i]))
+	}
+	return key, option, nil
+}
+
+// encodeStorageKeyArg scale encode key, ss58 address is accepted as account id, number string as integer
+// scale encoder of integer types takes string as zero, number string is converted to decimal, so u128 or balance keys are not truncated
+func encodeStorageKeyArg(keyType string, arg interface{}, option *types.ScaleDecoderOption) (encoded string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	if v, ok := arg.(string); ok && !strings.HasPrefix(v, "0x") {
+		if strings.Contains(strings.ToLower(keyType), "account") {
+			if accountId := address.Decode(v); accountId != "" {
+				arg = accountId
+			}
+		} else if n, err := decimal.NewFromString(v); err == nil && n.IsInteger() {
+			arg = n
+		}
+	}
+	return types.EncodeWithOpt(keyType, arg, option), nil
+}
+
+// StorageKeysPaged keys of storage with prefix at block hash, start after key start
+func StorageKeysPaged(_ context.Context, prefix, start, hash string, row int) (keys []string, err error) {
+	if start == "" {
+		start = prefix
+	}
+	v := &model.JsonRpcResult{}
+	if err = websocket.SendWsRequest(nil, v, StateGetKeysPaged(prefix, start, hash, row)); err != nil {
+		return
+	}
+	if err = v.CheckErr(); err != nil {
+		return
+	}
+	err = util.UnmarshalAny(&keys, v.Result)
+	return
+}
+
+// QueryStorageAt raw values of keys at block hash, value of not exist key is empty
+func QueryStorageAt(_ context.Context, keys []string, hash string) (r map[string]string, err error) {
+	var data []StateStorageResult
+	v := &model.JsonRpcResult{}
+	if err = websocket.SendWsRequest(nil, v, StateQueryStorageAt(keys, hash)); err != nil {
+		return
+	}
+	if err = v.CheckErr(); err != nil {
+		return
+	}
+	_ = util.UnmarshalAny(&data, v.Result)
+	r = make(map[string]string)
+	for _, result := range data {
+		for _, change := range result.Changes {
+			if len(change) == 2 {
+				r[change[0]] = change[1]
+			}
+		}
+	}
+	return
+}
+
+// ReadStorageRaw raw value of key at block hash, empty if not exist
+func ReadStorageRaw(_ context.Context, key, hash string) (string, error) {
+	v := &model.JsonRpcResult{}
+	if err := websocket.SendWsRequest(nil, v, rpc.StateGetStorage(rand.Intn(10000), key, hash)); err != nil {
+		return "", err
+	}
+	if err := v.CheckErr(); err != nil {
+		return "", err
+	}
+	raw, _ := v.ToString()
+	return raw, nil
+}
+
+// DecodeStorageEntry decode value and keys of storage entry with the metadata of spec
+// keys hashed without concat can not be decoded, nil returned
+func DecodeStorageEntry(m *metadata.Instant, spec int, option *StorageOption, key, raw string) (keys KeyStorage, value storage.StateStorage, err error) {
+	ms := types.MetadataStruct(*m)
+	if raw != "" {
+		if value, _, err = storage.Decode(raw, option.Value, &types.ScaleDecoderOption{Metadata: &ms, Spec: spec}); err != nil {
+			return
+		}
+	}
+	for _, h := range option.Hasher {
+		if !strings.HasSuffix(h, "Concat") && h != "Identity" {
+			return nil, value, nil
+		}
+	}
+	keys, err = DecodeStorageKey(key, option.Keys, option.Hasher, &types.ScaleDecoderOption{Metadata: &ms, Spec: spec})
+	return
+}
@@ -0,0 +1,88 @@
+package substrate
+
+import (
+	"testing"
+
+	"github.com/itering/scale.go/types"
+	"github.com/itering/subscan/util"
+	"github.com/itering/substrate-api-rpc/hasher"
+	"github.com/itering/substrate-api-rpc/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func testStorageMetadata() *metadata.Instant {
+	plain := "u32"
+	return &metadata.Instant{Metadata: types.MetadataTag{Modules: []types.MetadataModules{{
+		Name:   "Staking",
+		Prefix: "Staking",
+		Storage: []types.MetadataStorage{
+			{Name: "CurrentEra", Modifier: "Optional", Type: types.StorageType{Origin: "PlainType", PlainType: &plain}},
+			{Name: "ErasPoints", Modifier: "Default", Type: types.StorageType{Origin: "DoubleMapType", DoubleMapType: &types.MapType{
+				Key: "u32", Hasher: "Twox64Concat", Key2: "AccountId", Key2Hasher: "Blake2_128Concat", Value: "u128",
+			}}},
+			{Name: "Hashed", Modifier: "Default", Type: types.StorageType{Origin: "MapType", MapType: &types.MapType{Key: "u32", Hasher: "Blake2_256", Value: "u32"}}},
+			{Name: "Stakes", Modifier: "Default", Type: types.StorageType{Origin: "MapType", MapType: &types.MapType{Key: "u128", Hasher: "Twox64Concat", Value: "u32"}}},
+			{Name: "Bonds", Modifier: "Default", Type: types.StorageType{Origin: "MapType", MapType: &types.MapType{Key: "u64", Hasher: "Twox64Concat", Value: "u32"}}},
+		},
+	}}}}
+}
+
+func TestEncodeStorageKey(t *testing.T) {
+	m := testStorageMetadata()
+	prefix := PlainStorageKey("Staking", "ErasPoints")
+
+	key, option, err := EncodeStorageKey(m, 1, "staking", "currentEra")
+	assert.NoError(t, err)
+	assert.Equal(t, PlainStorageKey("Staking", "CurrentEra"), key)
+	assert.Empty(t, option.Keys)
+	assert.Empty(t, option.Hasher)
+
+	// partial keys, prefix of map iteration
+	key, option, err = EncodeStorageKey(m, 1, "Staking", "ErasPoints", "5")
+	assert.NoError(t, err)
+	assert.Equal(t, prefix+util.BytesToHex(hasher.HashByCryptoName([]byte{5, 0, 0, 0}, "Twox64Concat")), key)
+	assert.Equal(t, []string{"u32", "AccountId"}, option.Keys)
+
+	accountId := "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+	ss58, _, _ := EncodeStorageKey(m, 1, "Staking", "ErasPoints", 5, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY")
+	hexed, _, err := EncodeStorageKey(m, 1, "Staking", "ErasPoints", float64(5), "0x"+accountId)
+	assert.NoError(t, err)
+	assert.Equal(t, hexed, ss58)
+	assert.Equal(t, key+util.BytesToHex(hasher.HashByCryptoName(util.HexToBytes(accountId), "Blake2_128Concat")), hexed)
+
+	// u128 key larger than int64 is not truncated
+	key, _, err = EncodeStorageKey(m, 1, "Staking", "Stakes", "340282366920938463463374607431768211455")
+	assert.NoError(t, err)
+	max128 := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	assert.Equal(t, PlainStorageKey("Staking", "Stakes")+util.BytesToHex(hasher.HashByCryptoName(max128, "Twox64Concat")), key)
+	key, _, err = EncodeStorageKey(m, 1, "Staking", "Stakes", "10000000000000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, PlainStorageKey("Staking", "Stakes")+util.BytesToHex(hasher.HashByCryptoName([]byte{0, 0, 0xe8, 0x89, 0x04, 0x23, 0xc7, 0x8a, 0, 0, 0, 0, 0, 0, 0, 0}, "Twox64Concat")), key)
+	// u64 balance larger than int64 is not taken as zero
+	key, _, err = EncodeStorageKey(m, 1, "Staking", "Bonds", "10000000000000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, PlainStorageKey("Staking", "Bonds")+util.BytesToHex(hasher.HashByCryptoName([]byte{0, 0, 0xe8, 0x89, 0x04, 0x23, 0xc7, 0x8a}, "Twox64Concat")), key)
+
+	_, _, err = EncodeStorageKey(m, 1, "Staking", "ErasPoints", 5, accountId, 1)
+	assert.Error(t, err)
+	_, _, err = EncodeStorageKey(m, 1, "Staking", "NotExist")
+	assert.Error(t, err)
+}
+
+func TestDecodeStorageEntry(t *testing.T) {
+	m := testStorageMetadata()
+	accountId := "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+	key, option, _ := EncodeStorageKey(m, 1, "Staking", "ErasPoints", 5, accountId)
+	keys, value, err := DecodeStorageEntry(m, 1, option, key, "0x0a000000000000000000000000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, "10", value.ToString())
+	assert.Len(t, keys, 2)
+	assert.Equal(t, "5", keys[0].ToString())
+
+	// not concat hasher, keys can not be decoded
+	key, option, _ = EncodeStorageKey(m, 1, "Staking", "Hashed", 5)
+	keys, value, err = DecodeStorageEntry(m, 1, option, key, "0x07000000")
+	assert.NoError(t, err)
+	assert.Nil(t, keys)
+	assert.Equal(t, "7", value.ToString())
+}
@@ -53,3 +53,22 @@ func TestFormat(t *testing.T) {
 	assert.Equal(t, Format("0x3a370c6e4af506123c30e091a1cbfbc3728e1ec5"), "0x3a370c6e4af506123c30e091a1cbfbc3728e1ec5")
 	assert.Equal(t, Format("3a370c6e4af506123c30e091a1cbfbc3728e1ec5"), "0x3a370c6e4af506123c30e091a1cbfbc3728e1ec5")
 }
+
+func TestMultisigAccountId(t *testing.T) {
+	alice := "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+	bob := "8eaf04151687736326c9fea17e25fc5287613693c912909cb226aa4794f26a48"
+	charlie := "0x90b5ab205c6974c9ea841be688864633dc9ca8a357843eeacf2314649965fe22"
+	multisig := "49daa32c7287890f38b7e1a8cd2961723d36d20baa0bf3b82e0c4bdda93b1c0a"
+	assert.Equal(t, multisig, MultisigAccountId([]string{alice, bob, charlie}, 2))
+	// signatories order independent
+	assert.Equal(t, multisig, MultisigAccountId([]string{charlie, alice, bob}, 2))
+	assert.NotEqual(t, multisig, MultisigAccountId([]string{alice, bob, charlie}, 3))
+	assert.Equal(t, "", MultisigAccountId(nil, 2))
+}
+
+func TestDerivativeAccountId(t *testing.T) {
+	alice := "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+	assert.Len(t, DerivativeAccountId(alice, 0), 64)
+	assert.NotEqual(t, DerivativeAccountId(alice, 0), DerivativeAccountId(alice, 1))
+	assert.Equal(t, "", DerivativeAccountId("0x3a370c6e4af506123c30e091a1cbfbc3728e1ec5", 0))
+}
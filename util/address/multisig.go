@@ -0,0 +1,44 @@
+package address
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/itering/scale.go/types"
+	"github.com/itering/subscan/util"
+	"github.com/itering/substrate-api-rpc/hasher"
+)
+
+// multisigPrefix pallet_multisig multi_account_id and pallet_utility derivative_account_id entropy prefix
+const multisigPrefix = "modlpy/utilisuba"
+
+// MultisigAccountId derive multisig account id from signatories and threshold,
+// same as pallet_multisig::multi_account_id, return account id without 0x
+func MultisigAccountId(signatories []string, threshold uint16) string {
+	var accounts []string
+	for _, signatory := range signatories {
+		if VerifySubstrateAddress(signatory) {
+			accounts = append(accounts, strings.ToLower(util.TrimHex(signatory)))
+		}
+	}
+	if len(accounts) == 0 {
+		return ""
+	}
+	sort.Strings(accounts)
+	entropy := append([]byte(multisigPrefix), util.HexToBytes(types.Encode("Compact<U32>", len(accounts)))...)
+	for _, account := range accounts {
+		entropy = append(entropy, util.HexToBytes(account)...)
+	}
+	entropy = append(entropy, util.HexToBytes(util.U16Encode(threshold))...)
+	return util.BytesToHex(hasher.HashByCryptoName(entropy, "Blake2_256"))
+}
+
+// DerivativeAccountId derive utility.as_derivative account id, same as pallet_utility::derivative_account_id
+func DerivativeAccountId(account string, index uint16) string {
+	if !VerifySubstrateAddress(account) {
+		return ""
+	}
+	entropy := append([]byte(multisigPrefix), util.HexToBytes(account)...)
+	entropy = append(entropy, util.HexToBytes(util.U16Encode(index))...)
+	return util.BytesToHex(hasher.HashByCryptoName(entropy, "Blake2_256"))
+}
@@ -0,0 +1,107 @@
+package content
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	s := NewStore(t.TempDir(), 0)
+	hash, err := s.Put([]byte("<svg></svg>"))
+	assert.NoError(t, err)
+	assert.Equal(t, Hash([]byte("<svg></svg>")), hash)
+	assert.True(t, s.Has(hash))
+
+	again, err := s.Put([]byte("<svg></svg>"))
+	assert.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	data, contentType, err := s.Get(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, "<svg></svg>", string(data))
+	assert.Equal(t, "image/svg+xml", contentType)
+
+	_, _, err = s.Get(Hash([]byte("missing")))
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, _, err = s.Get("../../etc/passwd")
+	assert.Error(t, err)
+	assert.False(t, s.Has("../../etc/passwd"))
+}
+
+func TestStoreEviction(t *testing.T) {
+	s := NewStore(t.TempDir(), 100)
+	first, err := s.Put(bytes.Repeat([]byte("a"), 40))
+	assert.NoError(t, err)
+	second, err := s.Put(bytes.Repeat([]byte("b"), 40))
+	assert.NoError(t, err)
+	// read first, second is least recently used
+	past := time.Now().Add(-time.Minute)
+	assert.NoError(t, os.Chtimes(s.path(first), past, past))
+	assert.NoError(t, os.Chtimes(s.path(second), past, past))
+	_, _, err = s.Get(first)
+	assert.NoError(t, err)
+
+	third, err := s.Put(bytes.Repeat([]byte("c"), 40))
+	assert.NoError(t, err)
+	assert.True(t, s.Has(first))
+	assert.False(t, s.Has(second))
+	assert.True(t, s.Has(third))
+
+	// size of content saved before start is counted
+	s = NewStore(s.dir, 100)
+	_, err = s.Put(bytes.Repeat([]byte("d"), 40))
+	assert.NoError(t, err)
+	files, err := s.files()
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+}
+
+func TestThumbnail(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	for x := 0; x < 400; x++ {
+		for y := 0; y < 200; y++ {
+			src.SetNRGBA(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, src))
+
+	data, err := Thumbnail(buf.Bytes(), 100)
+	assert.NoError(t, err)
+	thumb, _, err := image.Decode(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), thumb.Bounds())
+	assert.Equal(t, color.NRGBA{R: 255, A: 255}, color.NRGBAModel.Convert(thumb.At(50, 25)))
+
+	// never enlarge
+	data, err = Thumbnail(buf.Bytes(), 1000)
+	assert.NoError(t, err)
+	thumb, _, _ = image.Decode(bytes.NewReader(data))
+	assert.Equal(t, image.Rect(0, 0, 400, 200), thumb.Bounds())
+
+	data, err = Thumbnail([]byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`), 100)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "<svg")
+
+	_, err = Thumbnail([]byte("not image"), 100)
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+
+	// only header of large image is decoded
+	buf.Reset()
+	assert.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+	header := buf.Bytes()
+	binary.BigEndian.PutUint32(header[16:], 100000)
+	binary.BigEndian.PutUint32(header[20:], 100000)
+	binary.BigEndian.PutUint32(header[29:], crc32.ChecksumIEEE(header[12:29]))
+	_, err = Thumbnail(header, 100)
+	assert.ErrorIs(t, err, ErrImageTooLarge)
+}
@@ -0,0 +1,194 @@
+package content
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrNotFound = errors.New("content not found")
+
+// Store local content addressed store, content is saved as dir/{hash[:2]}/{hash}, hash is sha256 of content
+// total size is capped by maxBytes, least recently read or written content is evicted, 0 is unlimited
+type Store struct {
+	dir      string
+	maxBytes int64
+
+	mu     sync.Mutex
+	loaded bool
+	size   int64
+}
+
+// evicted down to evictRatio of maxBytes, avoid evicting on every put
+const evictRatio = 0.9
+
+func NewStore(dir string, maxBytes int64) *Store {
+	return &Store{dir: dir, maxBytes: maxBytes}
+}
+
+// Hash sha256 hex of data
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func validHash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+func (s *Store) path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash)
+}
+
+// Put save data, the same data is saved only once
+func (s *Store) Put(data []byte) (string, error) {
+	hash := Hash(data)
+	p := s.path(hash)
+	if _, err := os.Stat(p); err == nil {
+		if s.maxBytes > 0 {
+			touch(p)
+		}
+		return hash, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return "", err
+	}
+	// write to temp file then rename, readers never see partial content
+	tmp, err := os.CreateTemp(filepath.Dir(p), hash+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return "", err
+	}
+	if err = tmp.Close(); err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmp.Name(), p); err != nil {
+		return "", err
+	}
+	return hash, s.grow(int64(len(data)))
+}
+
+// touch update modification time, used as last access time of eviction
+func touch(p string) {
+	now := time.Now()
+	_ = os.Chtimes(p, now, now)
+}
+
+// grow add size of saved content, evict least recently used content if over maxBytes
+func (s *Store) grow(n int64) error {
+	if s.maxBytes <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.loaded {
+		// size of content saved before start, includes n
+		files, err := s.files()
+		if err != nil {
+			return err
+		}
+		s.size, s.loaded = 0, true
+		for _, f := range files {
+			s.size += f.size
+		}
+	} else {
+		s.size += n
+	}
+	if s.size <= s.maxBytes {
+		return nil
+	}
+	files, err := s.files()
+	if err != nil {
+		return err
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+	for _, f := range files {
+		if s.size <= int64(float64(s.maxBytes)*evictRatio) {
+			break
+		}
+		if err = os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		s.size -= f.size
+	}
+	return nil
+}
+
+type storeFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func (s *Store) files() ([]storeFile, error) {
+	var files []storeFile
+	err := filepath.WalkDir(s.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || !validHash(d.Name()) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		files = append(files, storeFile{path: p, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	return files, err
+}
+
+// Get data and media type of hash
+func (s *Store) Get(hash string) ([]byte, string, error) {
+	if !validHash(hash) {
+		return nil, "", fmt.Errorf("invalid content hash %s", hash)
+	}
+	data, err := os.ReadFile(s.path(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, "", ErrNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if s.maxBytes > 0 {
+		touch(s.path(hash))
+	}
+	return data, DetectContentType(data), nil
+}
+
+// Has content of hash is saved
+func (s *Store) Has(hash string) bool {
+	if !validHash(hash) {
+		return false
+	}
+	_, err := os.Stat(s.path(hash))
+	return err == nil
+}
+
+// DetectContentType media type of data, svg is detected as image/svg+xml
+func DetectContentType(data []byte) string {
+	if isSvg(data) {
+		return "image/svg+xml"
+	}
+	return http.DetectContentType(data)
+}
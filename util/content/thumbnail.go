@@ -0,0 +1,89 @@
+package content
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+)
+
+// max width x height of decoded image, decoded image takes 4 bytes per pixel
+const maxImagePixels = 40_000_000
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image")
+	ErrImageTooLarge    = errors.New("image too large")
+)
+
+func isSvg(data []byte) bool {
+	head := bytes.TrimSpace(data)
+	if len(head) > 512 {
+		head = head[:512]
+	}
+	return bytes.Contains(bytes.ToLower(head), []byte("<svg"))
+}
+
+// Thumbnail png of image fit in size x size, keeps aspect ratio and never enlarges
+// svg is vector, returned as is, image larger than maxImagePixels is rejected before decoding
+func Thumbnail(data []byte, size int) ([]byte, error) {
+	if isSvg(data) {
+		return data, nil
+	}
+	conf, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	if conf.Width <= 0 || conf.Height <= 0 {
+		return nil, ErrUnsupportedImage
+	}
+	if int64(conf.Width)*int64(conf.Height) > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w == 0 || h == 0 {
+		return nil, ErrUnsupportedImage
+	}
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, max(1, h*size/w)
+		} else {
+			w, h = max(1, w*size/h), size
+		}
+	}
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, resize(src, w, h)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resize by averaging source pixels covered by each destination pixel
+func resize(src image.Image, w, h int) image.Image {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, max((y+1)*sh/h, y*sh/h+1)
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, max((x+1)*sw/w, x*sw/w+1)
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := color.NRGBAModel.Convert(src.At(b.Min.X+sx, b.Min.Y+sy)).(color.NRGBA)
+					r, g, bl, a = r+uint64(c.R), g+uint64(c.G), bl+uint64(c.B), a+uint64(c.A)
+					n++
+				}
+			}
+			dst.SetNRGBA(x, y, color.NRGBA{R: uint8(r / n), G: uint8(g / n), B: uint8(bl / n), A: uint8(a / n)})
+		}
+	}
+	return dst
+}
@@ -7,6 +7,8 @@ var (
 	InvalidAccountAddress = ecode.New(10002)
 	RecordNotFound        = ecode.New(10004)
 	InvalidPagination     = ecode.New(10005)
+	Unauthorized          = ecode.New(10006)
+	TooManyRequests       = ecode.New(10007)
 )
 
 func init() {
@@ -16,6 +18,8 @@ func init() {
 		10002: "Invalid Account Address",
 		10004: "Record Not Found",
 		10005: "Paging limit exceeded, please use the after_id parameter to continue paging",
+		10006: "Unauthorized",
+		10007: "Too Many Requests",
 	})
 
 }
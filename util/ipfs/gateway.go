@@ -0,0 +1,259 @@
+package ipfs
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	SchemeIpfs    = "ipfs"
+	SchemeArweave = "ar"
+)
+
+const (
+	// max size of fetched content
+	maxContentSize = 20 << 20
+	// max redirects followed by fetch
+	maxRedirects = 3
+)
+
+var (
+	ErrUnsupportedUri = errors.New("unsupported uri")
+	// ErrForbiddenAddress uri resolved to loopback, private, link local, unspecified or multicast address
+	ErrForbiddenAddress = fmt.Errorf("%w, forbidden address", ErrUnsupportedUri)
+)
+
+// Content fetched content with media type
+type Content struct {
+	Data        []byte
+	ContentType string
+}
+
+// Gateway http gateway of content addressed scheme, such as https://ipfs.io/ipfs/
+type Gateway interface {
+	Scheme() string
+	Endpoint(path string) string
+}
+
+type prefixGateway struct {
+	scheme string
+	prefix string
+}
+
+func (g prefixGateway) Scheme() string { return g.scheme }
+
+func (g prefixGateway) Endpoint(path string) string {
+	return strings.TrimSuffix(g.prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+// NewGateway gateway of scheme, path of uri is appended to prefix
+func NewGateway(scheme, prefix string) Gateway {
+	return prefixGateway{scheme: scheme, prefix: prefix}
+}
+
+var (
+	gatewaysLock sync.RWMutex
+	gateways     = map[string][]Gateway{}
+)
+
+func init() {
+	for scheme, env := range map[string]string{SchemeIpfs: "IPFS_GATEWAYS", SchemeArweave: "ARWEAVE_GATEWAYS"} {
+		prefixes := []string{"https://ipfs.io/ipfs/", "https://nftstorage.link/ipfs/", "https://dweb.link/ipfs/"}
+		if scheme == SchemeArweave {
+			prefixes = []string{"https://arweave.net/"}
+		}
+		if v := os.Getenv(env); v != "" {
+			prefixes = strings.Split(v, ",")
+		}
+		for _, prefix := range prefixes {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				gateways[scheme] = append(gateways[scheme], NewGateway(scheme, prefix))
+			}
+		}
+	}
+}
+
+// RegisterGateway gateways of scheme, tried in order, replace gateways of env IPFS_GATEWAYS or ARWEAVE_GATEWAYS
+func RegisterGateway(scheme string, gw ...Gateway) {
+	gatewaysLock.Lock()
+	defer gatewaysLock.Unlock()
+	gateways[scheme] = gw
+}
+
+func gatewaysOf(scheme string) []Gateway {
+	gatewaysLock.RLock()
+	defer gatewaysLock.RUnlock()
+	return gateways[scheme]
+}
+
+// ParseUri scheme and path of content addressed uri, ipfs path of http gateway url is treated as ipfs uri
+func ParseUri(uri string) (scheme, path string, ok bool) {
+	uri = strings.TrimSpace(uri)
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		return SchemeIpfs, strings.TrimPrefix(strings.TrimPrefix(uri, "ipfs://"), "ipfs/"), true
+	case strings.HasPrefix(uri, "ar://"):
+		return SchemeArweave, strings.TrimPrefix(uri, "ar://"), true
+	case strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://"):
+		u, err := url.Parse(uri)
+		if err != nil {
+			return "", "", false
+		}
+		if _, p, found := strings.Cut(u.Path, "/ipfs/"); found && verifyCid(p) == nil {
+			return SchemeIpfs, p, true
+		}
+		// cid as subdomain, https://{cid}.ipfs.dweb.link/path
+		if host := strings.Split(u.Host, "."); len(host) > 2 && host[1] == "ipfs" && verifyCid(host[0]) == nil {
+			return SchemeIpfs, host[0] + u.Path, true
+		}
+	}
+	return "", "", false
+}
+
+// Fetch content of ipfs, ar, data or http uri, content addressed uri is fetched through gateways in order
+func Fetch(ctx context.Context, uri string) (*Content, error) {
+	uri = strings.TrimSpace(uri)
+	if strings.HasPrefix(uri, "data:") {
+		return DecodeDataUri(uri)
+	}
+	if scheme, path, ok := ParseUri(uri); ok {
+		if scheme == SchemeIpfs {
+			if err := verifyCid(path); err != nil {
+				return nil, fmt.Errorf("cid %s verify failed %s", path, err)
+			}
+		}
+		list := gatewaysOf(scheme)
+		if len(list) == 0 {
+			return nil, fmt.Errorf("no gateway of %s", scheme)
+		}
+		var errs []error
+		for _, gw := range list {
+			content, err := httpFetch(ctx, gatewayClient, gw.Endpoint(path))
+			if err == nil {
+				return content, nil
+			}
+			errs = append(errs, err)
+		}
+		return nil, errors.Join(errs...)
+	}
+	if strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://") {
+		u, err := url.Parse(uri)
+		if err != nil {
+			return nil, err
+		}
+		if u.Hostname() == "localhost" {
+			return nil, fmt.Errorf("%w %s", ErrForbiddenAddress, uri)
+		}
+		return httpFetch(ctx, fetchClient, uri)
+	}
+	return nil, fmt.Errorf("%w %s", ErrUnsupportedUri, uri)
+}
+
+func isForbiddenIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// forbidAddress dialer control, address is checked after dns resolution, so host resolved or redirected to internal address is rejected
+func forbidAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || isForbiddenIP(ip) {
+		return fmt.Errorf("%w %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	return nil
+}
+
+var (
+	// gatewayClient client of configured gateways, gateway of operator may be local
+	gatewayClient = &http.Client{Timeout: 30 * time.Second, CheckRedirect: checkRedirect}
+	// fetchClient client of http uri of token, only public address is dialed, proxy of env is not used
+	fetchClient = &http.Client{
+		Timeout:       30 * time.Second,
+		CheckRedirect: checkRedirect,
+		Transport: &http.Transport{
+			DialContext:         (&net.Dialer{Timeout: 10 * time.Second, Control: forbidAddress}).DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+)
+
+func httpFetch(ctx context.Context, client *http.Client, endpoint string) (*Content, error) {
+	subCtx, cancel := context.WithTimeout(ctx, time.Second*20)
+	defer cancel()
+	req, err := http.NewRequestWithContext(subCtx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint: errcheck
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("http error: %d %s", resp.StatusCode, endpoint)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxContentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxContentSize {
+		return nil, fmt.Errorf("content of %s too large", endpoint)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" || strings.HasPrefix(contentType, "application/octet-stream") || strings.HasPrefix(contentType, "text/plain") {
+		contentType = http.DetectContentType(data)
+	}
+	return &Content{Data: data, ContentType: contentType}, nil
+}
+
+// DecodeDataUri content of data uri, data:[<media type>][;base64],<data>
+func DecodeDataUri(uri string) (*Content, error) {
+	header, data, found := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !found {
+		return nil, errors.New("invalid data uri")
+	}
+	params := strings.Split(header, ";")
+	content := &Content{ContentType: params[0]}
+	if content.ContentType == "" {
+		content.ContentType = "text/plain"
+	}
+	if params[len(params)-1] == "base64" {
+		raw, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			if raw, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(data, "=")); err != nil {
+				return nil, fmt.Errorf("invalid base64 data uri %w", err)
+			}
+		}
+		content.Data = raw
+		return content, nil
+	}
+	// utf8 json is usually not escaped
+	if unescaped, err := url.PathUnescape(data); err == nil {
+		data = unescaped
+	}
+	content.Data = []byte(data)
+	return content, nil
+}
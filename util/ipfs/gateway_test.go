@@ -0,0 +1,138 @@
+package ipfs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCid = "bafkreidyeivj7adnnac6ljvzj2e3rd5xdw3revw4da7mx2ckrstapoupoq"
+
+func TestParseUri(t *testing.T) {
+	tests := []struct {
+		uri    string
+		scheme string
+		path   string
+		ok     bool
+	}{
+		{"ipfs://" + testCid + "/1.json", SchemeIpfs, testCid + "/1.json", true},
+		{"ipfs://ipfs/" + testCid, SchemeIpfs, testCid, true},
+		{"ar://sDPTwwsvz_FtwuHUWkJ2lXzVSiQDldK_tgTYVIgxA3M", SchemeArweave, "sDPTwwsvz_FtwuHUWkJ2lXzVSiQDldK_tgTYVIgxA3M", true},
+		{"https://gateway.pinata.cloud/ipfs/" + testCid + "/1.json", SchemeIpfs, testCid + "/1.json", true},
+		{"https://" + testCid + ".ipfs.dweb.link/1.json", SchemeIpfs, testCid + "/1.json", true},
+		{"https://example.com/ipfs/1.json", "", "", false},
+		{"https://example.com/1.json", "", "", false},
+	}
+	for _, tt := range tests {
+		scheme, path, ok := ParseUri(tt.uri)
+		assert.Equal(t, tt.ok, ok, tt.uri)
+		assert.Equal(t, tt.scheme, scheme, tt.uri)
+		assert.Equal(t, tt.path, path, tt.uri)
+	}
+}
+
+func TestDecodeDataUri(t *testing.T) {
+	content, err := DecodeDataUri("data:application/json;base64,eyJuYW1lIjoiMSJ9")
+	assert.NoError(t, err)
+	assert.Equal(t, "application/json", content.ContentType)
+	assert.Equal(t, `{"name":"1"}`, string(content.Data))
+
+	content, err = DecodeDataUri(`data:application/json;utf8,{"name":"a%20b"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"a b"}`, string(content.Data))
+
+	content, err = DecodeDataUri(`data:image/svg+xml;base64,PHN2Zz48L3N2Zz4`)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/svg+xml", content.ContentType)
+	assert.Equal(t, "<svg></svg>", string(content.Data))
+
+	_, err = DecodeDataUri("data:application/json")
+	assert.Error(t, err)
+}
+
+func TestFetch(t *testing.T) {
+	var requests []string
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		switch r.URL.Path {
+		case "/ipfs/" + testCid + "/1.json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name":"1"}`))
+		case "/ar/tx":
+			_, _ = w.Write([]byte(`{"name":"ar"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer stub.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer broken.Close()
+
+	ipfsGateways, arGateways := gatewaysOf(SchemeIpfs), gatewaysOf(SchemeArweave)
+	defer func() {
+		RegisterGateway(SchemeIpfs, ipfsGateways...)
+		RegisterGateway(SchemeArweave, arGateways...)
+	}()
+	RegisterGateway(SchemeIpfs, NewGateway(SchemeIpfs, broken.URL+"/ipfs"), NewGateway(SchemeIpfs, stub.URL+"/ipfs/"))
+	RegisterGateway(SchemeArweave, NewGateway(SchemeArweave, stub.URL+"/ar"))
+
+	ctx := context.Background()
+	// fallback to next gateway
+	content, err := Fetch(ctx, "ipfs://"+testCid+"/1.json")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"1"}`, string(content.Data))
+	assert.Equal(t, "application/json", content.ContentType)
+
+	// public gateway url is fetched through registered gateways
+	content, err = Fetch(ctx, "https://ipfs.io/ipfs/"+testCid+"/1.json")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"1"}`, string(content.Data))
+
+	content, err = Fetch(ctx, "ar://tx")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"ar"}`, string(content.Data))
+	assert.Equal(t, "text/plain; charset=utf-8", content.ContentType)
+
+	_, err = Fetch(ctx, "ipfs://"+testCid+"/2.json")
+	assert.Error(t, err)
+	_, err = Fetch(ctx, "ipfs://fff")
+	assert.Error(t, err)
+	_, err = Fetch(ctx, stub.URL+"/ar/tx")
+	assert.ErrorIs(t, err, ErrUnsupportedUri)
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	_, err = Fetch(ctx, strings.Replace(stub.URL, "127.0.0.1", "localhost", 1)+"/ar/tx")
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	_, err = Fetch(ctx, "did:dkg:1")
+	assert.ErrorIs(t, err, ErrUnsupportedUri)
+
+	content, err = Fetch(ctx, "data:application/json;base64,eyJuYW1lIjoiMSJ9")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"1"}`, string(content.Data))
+}
+
+func TestForbidAddress(t *testing.T) {
+	for _, address := range []string{"127.0.0.1:80", "10.0.0.1:80", "192.168.1.1:443", "169.254.169.254:80", "0.0.0.0:80", "224.0.0.1:80", "[::1]:80", "[fe80::1]:80", "[fd00::1]:80"} {
+		assert.ErrorIs(t, forbidAddress("tcp", address, nil), ErrForbiddenAddress, address)
+	}
+	for _, address := range []string{"1.1.1.1:443", "[2606:4700:4700::1111]:443"} {
+		assert.NoError(t, forbidAddress("tcp", address, nil), address)
+	}
+}
+
+func TestFetchRedirect(t *testing.T) {
+	var redirects int
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirects++
+		http.Redirect(w, r, r.URL.Path, http.StatusFound)
+	}))
+	defer stub.Close()
+	_, err := httpFetch(context.Background(), gatewayClient, stub.URL+"/loop")
+	assert.Error(t, err)
+	assert.Equal(t, maxRedirects, redirects)
+}
@@ -0,0 +1,199 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/itering/subscan/configs"
+	"github.com/stretchr/testify/assert"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, p.Delay(1))
+	assert.Equal(t, 2*time.Second, p.Delay(2))
+	assert.Equal(t, 4*time.Second, p.Delay(3))
+	assert.Equal(t, 5*time.Second, p.Delay(4))
+	assert.Equal(t, 5*time.Second, p.Delay(100))
+}
+
+func TestMemory(t *testing.T) {
+	ctx := context.TODO()
+	retryPolicies["memory-test"] = RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	defer delete(retryPolicies, "memory-test")
+	DeadLetters = newMemoryDeadLetters()
+
+	m := NewMemory()
+	Instant = m
+	defer func() { Instant = nil }()
+
+	var (
+		attempts  int32
+		processed = make(chan int, 10)
+		fail      atomic.Bool
+	)
+	fail.Store(true)
+	m.Process("memory-test", 2, func(ctx context.Context, queue, class string, args *simplejson.Json) error {
+		if class == "panic" {
+			panic("unexpected")
+		}
+		if fail.Load() {
+			atomic.AddInt32(&attempts, 1)
+			return errors.New("failed")
+		}
+		processed <- args.Get("block_num").MustInt()
+		return nil
+	})
+	done := make(chan struct{})
+	go func() {
+		m.Consumption()
+		close(done)
+	}()
+
+	assert.NoError(t, m.Publish("memory-test", "block", map[string]interface{}{"block_num": 1}))
+	assert.NoError(t, m.Publish("memory-test", "panic", nil))
+	assert.Eventually(t, func() bool {
+		_, count, _ := DeadLetters.List(ctx, "memory-test", 0, 10)
+		return count == 2
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+
+	list, _, err := DeadLetters.List(ctx, "memory-test", 0, 10)
+	assert.NoError(t, err)
+	var letter Message
+	for _, msg := range list {
+		assert.Equal(t, 3, msg.Attempt)
+		assert.NotZero(t, msg.FailedAt)
+		if msg.Class == "block" {
+			letter = msg
+		} else {
+			assert.Equal(t, "panic: unexpected", msg.Error)
+		}
+	}
+	assert.Equal(t, "failed", letter.Error)
+
+	queues, err := DeadLetters.Queues(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"memory-test": 2}, queues)
+
+	// replay after fixed
+	fail.Store(false)
+	assert.NoError(t, Replay(ctx, "memory-test", letter.Id))
+	assert.Equal(t, 1, <-processed)
+	_, err = DeadLetters.Get(ctx, "memory-test", letter.Id)
+	assert.ErrorIs(t, err, ErrDeadLetterNotFound)
+	assert.ErrorIs(t, Replay(ctx, "memory-test", letter.Id), ErrDeadLetterNotFound)
+
+	assert.NoError(t, m.SubscribePublish(&CommonMessage{Queue: "memory-test", Class: "block", Data: []byte(`{"block_num":2}`)}))
+	assert.Equal(t, 2, <-processed)
+
+	assert.NoError(t, m.Shutdown(ctx))
+	<-done
+}
+
+func TestNatsOptions(t *testing.T) {
+	options, err := natsOptions(configs.Nats{Url: "nats://127.0.0.1:4222"})
+	assert.NoError(t, err)
+	base := len(options)
+	options, err = natsOptions(configs.Nats{Token: "token", TlsCa: "ca.pem", TlsCert: "cert.pem", TlsKey: "key.pem"})
+	assert.NoError(t, err)
+	assert.Len(t, options, base+3)
+	_, err = natsOptions(configs.Nats{NkeySeed: "/not/exists.nk"})
+	assert.Error(t, err)
+}
+
+func TestKafkaOptions(t *testing.T) {
+	options, err := kafkaOptions(configs.Kafka{Brokers: "127.0.0.1:9092"})
+	assert.NoError(t, err)
+	base := len(options)
+	options, err = kafkaOptions(configs.Kafka{Brokers: "127.0.0.1:9092", SaslMechanism: "scram-sha-512", Username: "u", Password: "p", Tls: true})
+	assert.NoError(t, err)
+	assert.Len(t, options, base+2)
+	_, err = kafkaOptions(configs.Kafka{SaslMechanism: "GSSAPI"})
+	assert.Error(t, err)
+	_, err = kafkaOptions(configs.Kafka{TlsCa: "/not/exists.pem"})
+	assert.Error(t, err)
+}
+
+func TestKafkaSettle(t *testing.T) {
+	records := []*kgo.Record{
+		{Topic: "a", Partition: 0, Offset: 1},
+		{Topic: "a", Partition: 1, Offset: 5},
+		{Topic: "a", Partition: 0, Offset: 2},
+		{Topic: "a", Partition: 0, Offset: 3},
+		{Topic: "a", Partition: 1, Offset: 6},
+	}
+	commit, rewind := kafkaSettle(records, []bool{true, true, false, true, true})
+	// offset 3 of partition 0 is handled after failed offset 2, consumed again
+	assert.Equal(t, []*kgo.Record{records[0], records[1], records[4]}, commit)
+	assert.Equal(t, map[string]map[int32]kgo.EpochOffset{"a": {0: {Offset: 2}}}, rewind)
+
+	commit, rewind = kafkaSettle(records, []bool{true, true, true, true, true})
+	assert.Len(t, commit, 5)
+	assert.Nil(t, rewind)
+}
+
+func TestKafka(t *testing.T) {
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.AllowAutoTopicCreation())
+	assert.NoError(t, err)
+	defer cluster.Close()
+	retryPolicies["kafka-test"] = RetryPolicy{MaxAttempts: 5, Backoff: 10 * time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	defer delete(retryPolicies, "kafka-test")
+
+	k := NewKafka(configs.Kafka{Brokers: strings.Join(cluster.ListenAddrs(), ","), Group: "subscan"})
+	k.Init()
+	var (
+		calls     int32
+		processed = make(chan int, 10)
+	)
+	k.Process("kafka-test", 2, func(ctx context.Context, queue, class string, args *simplejson.Json) error {
+		// first attempt failed, retried from retry topic
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return errors.New("failed")
+		}
+		processed <- args.Get("block_num").MustInt()
+		return nil
+	})
+	done := make(chan struct{})
+	go func() {
+		k.Consumption()
+		close(done)
+	}()
+	assert.NoError(t, k.Publish("kafka-test", "block", map[string]int{"block_num": 10}))
+
+	select {
+	case blockNum := <-processed:
+		assert.Equal(t, 10, blockNum)
+	case <-time.After(30 * time.Second):
+		t.Fatal("kafka job not processed")
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// offsets of main and retry topic are committed
+	topic := k.topic("kafka-test")
+	assert.Eventually(t, func() bool {
+		offsets, err := kadm.NewClient(k.client).FetchOffsets(context.TODO(), "subscan")
+		if err != nil {
+			return false
+		}
+		committed := make(map[string]int64)
+		offsets.Each(func(o kadm.OffsetResponse) {
+			committed[o.Topic] += o.At
+		})
+		return committed[topic] == 1 && committed[topic+".retry"] == 1
+	}, 10*time.Second, 100*time.Millisecond)
+	depth, err := k.QueueDepth(context.TODO(), "kafka-test")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, depth)
+
+	assert.NoError(t, k.Shutdown(context.TODO()))
+	<-done
+}
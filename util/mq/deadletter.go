@@ -0,0 +1,225 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/itering/subscan/util"
+	redisUtil "github.com/itering/subscan/util/redis"
+)
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetterStore keep jobs exceeded max attempts for inspect and replay
+type DeadLetterStore interface {
+	Add(ctx context.Context, msg *Message) error
+	List(ctx context.Context, queue string, page, row int) ([]Message, int, error)
+	Get(ctx context.Context, queue, id string) (*Message, error)
+	Remove(ctx context.Context, queue, id string) error
+	// Queues dead letter count of queues
+	Queues(ctx context.Context) (map[string]int, error)
+}
+
+// DeadLetters dead letter store of current backend
+var DeadLetters DeadLetterStore = newMemoryDeadLetters()
+
+// Replay publish dead letter to its queue again with attempts reset
+func Replay(ctx context.Context, queue, id string) error {
+	if Instant == nil {
+		return errors.New("mq not initialized")
+	}
+	msg, err := DeadLetters.Get(ctx, queue, id)
+	if err != nil {
+		return err
+	}
+	if err = Instant.ForcePublish(msg.Queue, msg.Class, msg.Args); err != nil {
+		return err
+	}
+	return DeadLetters.Remove(ctx, queue, id)
+}
+
+// redisDeadLetters hash of id => message, sorted set of id by failed time for paging
+type redisDeadLetters struct{}
+
+func (r *redisDeadLetters) key(queue string) string {
+	return fmt.Sprintf("%s:mq:dead:%s", util.NetworkNode, queue)
+}
+
+func (r *redisDeadLetters) indexKey(queue string) string {
+	return r.key(queue) + ":index"
+}
+
+func (r *redisDeadLetters) queuesKey() string {
+	return fmt.Sprintf("%s:mq:dead:queues", util.NetworkNode)
+}
+
+func (r *redisDeadLetters) Add(ctx context.Context, msg *Message) error {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	conn, err := redisUtil.SubPool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close() // nolint: errcheck
+	_ = conn.Send("MULTI")
+	_ = conn.Send("HSET", r.key(msg.Queue), msg.Id, raw)
+	_ = conn.Send("ZADD", r.indexKey(msg.Queue), msg.FailedAt, msg.Id)
+	_ = conn.Send("SADD", r.queuesKey(), msg.Queue)
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+func (r *redisDeadLetters) List(ctx context.Context, queue string, page, row int) ([]Message, int, error) {
+	conn, err := redisUtil.SubPool.GetContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer conn.Close() // nolint: errcheck
+	count, err := redis.Int(conn.Do("ZCARD", r.indexKey(queue)))
+	if err != nil || count == 0 {
+		return nil, count, err
+	}
+	ids, err := redis.Strings(conn.Do("ZREVRANGE", r.indexKey(queue), page*row, (page+1)*row-1))
+	if err != nil || len(ids) == 0 {
+		return nil, count, err
+	}
+	args := redis.Args{}.Add(r.key(queue)).AddFlat(ids)
+	raws, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		return nil, count, err
+	}
+	var list []Message
+	for _, raw := range raws {
+		var msg Message
+		if raw == nil || json.Unmarshal(raw, &msg) != nil {
+			continue
+		}
+		list = append(list, msg)
+	}
+	return list, count, nil
+}
+
+func (r *redisDeadLetters) Get(ctx context.Context, queue, id string) (*Message, error) {
+	conn, err := redisUtil.SubPool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close() // nolint: errcheck
+	raw, err := redis.Bytes(conn.Do("HGET", r.key(queue), id))
+	if errors.Is(err, redis.ErrNil) {
+		return nil, ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var msg Message
+	if err = json.Unmarshal(raw, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+func (r *redisDeadLetters) Remove(ctx context.Context, queue, id string) error {
+	conn, err := redisUtil.SubPool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close() // nolint: errcheck
+	_ = conn.Send("MULTI")
+	_ = conn.Send("HDEL", r.key(queue), id)
+	_ = conn.Send("ZREM", r.indexKey(queue), id)
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+func (r *redisDeadLetters) Queues(ctx context.Context) (map[string]int, error) {
+	conn, err := redisUtil.SubPool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close() // nolint: errcheck
+	queues, err := redis.Strings(conn.Do("SMEMBERS", r.queuesKey()))
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, queue := range queues {
+		if counts[queue], err = redis.Int(conn.Do("ZCARD", r.indexKey(queue))); err != nil {
+			return nil, err
+		}
+	}
+	return counts, nil
+}
+
+type memoryDeadLetters struct {
+	mu     sync.Mutex
+	queues map[string]map[string]Message
+}
+
+func newMemoryDeadLetters() *memoryDeadLetters {
+	return &memoryDeadLetters{queues: make(map[string]map[string]Message)}
+}
+
+func (m *memoryDeadLetters) Add(_ context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.queues[msg.Queue] == nil {
+		m.queues[msg.Queue] = make(map[string]Message)
+	}
+	m.queues[msg.Queue][msg.Id] = *msg
+	return nil
+}
+
+func (m *memoryDeadLetters) List(_ context.Context, queue string, page, row int) ([]Message, int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var list []Message
+	for _, msg := range m.queues[queue] {
+		list = append(list, msg)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].FailedAt == list[j].FailedAt {
+			return list[i].Id > list[j].Id
+		}
+		return list[i].FailedAt > list[j].FailedAt
+	})
+	count := len(list)
+	if page*row >= count {
+		return nil, count, nil
+	}
+	return list[page*row : min(count, (page+1)*row)], count, nil
+}
+
+func (m *memoryDeadLetters) Get(_ context.Context, queue, id string) (*Message, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	msg, ok := m.queues[queue][id]
+	if !ok {
+		return nil, ErrDeadLetterNotFound
+	}
+	return &msg, nil
+}
+
+func (m *memoryDeadLetters) Remove(_ context.Context, queue, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.queues[queue], id)
+	return nil
+}
+
+func (m *memoryDeadLetters) Queues(context.Context) (map[string]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counts := make(map[string]int)
+	for queue, letters := range m.queues {
+		counts[queue] = len(letters)
+	}
+	return counts, nil
+}
@@ -2,16 +2,26 @@ package mq
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/itering/subscan/configs"
 	"github.com/itering/subscan/util"
+	redisUtil "github.com/itering/subscan/util/redis"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
 
 	"github.com/itering/go-workers"
 )
 
 type GoWorker struct{}
 
-func (g *GoWorker) SubscribePublish(any) error {
-	return nil
+func (g *GoWorker) SubscribePublish(message any) error {
+	m, err := commonMessage(message)
+	if err != nil {
+		return err
+	}
+	return g.ForcePublish(m.Queue, m.Class, json.RawMessage(m.Data))
 }
 
 func (g *GoWorker) Type() string {
@@ -46,6 +56,29 @@ func (g *GoWorker) Shutdown(context.Context) error {
 	return nil
 }
 
+// Process failed job is enqueued to scheduled set with retry_count, go-workers poller enqueue it after delay
+func (g *GoWorker) Process(queue string, concurrency int, handler Handler) {
+	workers.Process(queue, func(m *workers.Msg) {
+		args, _ := m.Get("args").MarshalJSON()
+		msg := &Message{
+			Id:      m.Jid(),
+			Queue:   queue,
+			Class:   m.Get("class").MustString(),
+			Args:    args,
+			Attempt: m.Get("retry_count").MustInt(),
+		}
+		if retry, delay := Handle(context.Background(), msg, handler); retry {
+			_, err := workers.EnqueueWithOptions(msg.Queue, msg.Class, msg.Args, workers.EnqueueOptions{
+				RetryCount: msg.Attempt,
+				At:         float64(time.Now().Add(delay).UnixNano()) / workers.NanoSecondPrecision,
+			})
+			if err != nil {
+				util.Logger().Error(fmt.Errorf("go-worker retry %s/%s failed: %v", msg.Queue, msg.Id, err))
+			}
+		}
+	}, concurrency)
+}
+
 func (g *GoWorker) Consumption() {
 	workers.Run()
 }
@@ -56,3 +89,13 @@ func (g *GoWorker) ForcePublish(queue, class string, args interface{}) error {
 	}
 	return nil
 }
+
+// QueueDepth count of jobs waiting in queue, go-workers queue key is {namespace}:queue:{queue}
+func (g *GoWorker) QueueDepth(ctx context.Context, queue string) (int, error) {
+	conn, err := redisUtil.SubPool.GetContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close() // nolint: errcheck
+	return redis.Int(conn.Do("llen", fmt.Sprintf("%s:queue:%s", util.NetworkNode, queue)))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/md5"
 	"fmt"
+	"github.com/itering/subscan/configs"
 	"github.com/itering/subscan/util"
 	redisUtil "github.com/itering/subscan/util/redis"
 
@@ -13,13 +14,21 @@ import (
 type IJob interface {
 	Type() string
 	Init()
+	// Process register handler of queue, consumed after Consumption
+	Process(queue string, concurrency int, handler Handler)
+	// Consumption block consume registered queues until Shutdown
 	Consumption()
 	Publish(string, string, interface{}) error
 	ForcePublish(string, string, interface{}) error
 	Shutdown(_ context.Context) error
+	// SubscribePublish publish *CommonMessage, data is json of args
 	SubscribePublish(any) error
+	QueueDepth(ctx context.Context, queue string) (int, error)
 }
 
+// CoreQueues worker queues consumed by observer, plugins queues not included
+var CoreQueues = []string{"block", "balance"}
+
 func rateLimit(c context.Context, queue, class string, args interface{}) bool {
 	hash := md5.Sum([]byte(fmt.Sprintf("%s:%s:%s", queue, class, util.ToString(args))))
 	formatKey := fmt.Sprintf("%s:rateLimit:%x", util.NetworkNode, hash)
@@ -38,6 +47,9 @@ var (
 
 const (
 	GoWorkerName = "go-worker"
+	NatsName     = "nats"
+	KafkaName    = "kafka"
+	MemoryName   = "memory"
 )
 
 type CommonMessage struct {
@@ -46,8 +58,38 @@ type CommonMessage struct {
 	Class string `json:"class"`
 }
 
+// New init mq backend selected by config, go-worker by default
 func New() IJob {
-	Instant = &GoWorker{}
+	c := configs.Boot.MQ
+	setRetryPolicies(c)
+	backend := GoWorkerName
+	if c != nil {
+		backend = c.Backend
+	}
+	switch backend {
+	case NatsName:
+		Instant = NewNats(*c.Nats)
+	case KafkaName:
+		Instant = NewKafka(*c.Kafka)
+	case MemoryName:
+		Instant = NewMemory()
+	default:
+		Instant = &GoWorker{}
+	}
+	if backend != MemoryName {
+		DeadLetters = &redisDeadLetters{}
+	}
 	Instant.Init()
 	return Instant
 }
+
+// commonMessage decode SubscribePublish message
+func commonMessage(message any) (*CommonMessage, error) {
+	switch m := message.(type) {
+	case *CommonMessage:
+		return m, nil
+	case CommonMessage:
+		return &m, nil
+	}
+	return nil, fmt.Errorf("unsupported subscribe publish message %T", message)
+}
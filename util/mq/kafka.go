@@ -0,0 +1,377 @@
+package mq
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/util"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+// Kafka backend, topic of queue is subscan.{network}.{queue}, consumed by consumer group with manual offset commit
+// failed job is republished to retry topic {topic}.retry with not_before, retry consumer wait until not_before
+// offset of a record is committed only after it is handled or republished, otherwise partition is rewound to it
+type Kafka struct {
+	conf   configs.Kafka
+	prefix string
+
+	mu        sync.Mutex
+	client    *kgo.Client // producer and admin client
+	consumers []*kgo.Client
+	handlers  map[string]queueHandler
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+const kafkaRequestTimeout = 10 * time.Second
+
+var kafkaNameReplacer = strings.NewReplacer("/", "_", ":", "_", " ", "_", "*", "_")
+
+func NewKafka(conf configs.Kafka) *Kafka {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Kafka{
+		conf:     conf,
+		prefix:   "subscan." + kafkaNameReplacer.Replace(strings.ToLower(util.NetworkNode)),
+		handlers: make(map[string]queueHandler),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+func (k *Kafka) Type() string {
+	return KafkaName
+}
+
+// Init create producer client, brokers are connected lazily by client
+func (k *Kafka) Init() {
+	client, err := k.newClient()
+	if err != nil {
+		panic(fmt.Errorf("kafka init client %s failed: %v", k.conf.Brokers, err))
+	}
+	k.mu.Lock()
+	k.client = client
+	k.mu.Unlock()
+}
+
+// kafkaOptions brokers, sasl and tls options of config
+func kafkaOptions(conf configs.Kafka) ([]kgo.Opt, error) {
+	options := []kgo.Opt{
+		kgo.SeedBrokers(strings.Split(conf.Brokers, ",")...),
+		kgo.ClientID("subscan"),
+		kgo.AllowAutoTopicCreation(),
+	}
+	switch strings.ToUpper(conf.SaslMechanism) {
+	case "":
+	case "PLAIN":
+		options = append(options, kgo.SASL(plain.Auth{User: conf.Username, Pass: conf.Password}.AsMechanism()))
+	case "SCRAM-SHA-256":
+		options = append(options, kgo.SASL(scram.Auth{User: conf.Username, Pass: conf.Password}.AsSha256Mechanism()))
+	case "SCRAM-SHA-512":
+		options = append(options, kgo.SASL(scram.Auth{User: conf.Username, Pass: conf.Password}.AsSha512Mechanism()))
+	default:
+		return nil, fmt.Errorf("unsupported kafka sasl mechanism %s", conf.SaslMechanism)
+	}
+	if conf.Tls || conf.TlsCa != "" || conf.TlsCert != "" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if conf.TlsCa != "" {
+			ca, err := os.ReadFile(conf.TlsCa)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("invalid kafka tls ca %s", conf.TlsCa)
+			}
+		}
+		if conf.TlsCert != "" || conf.TlsKey != "" {
+			cert, err := tls.LoadX509KeyPair(conf.TlsCert, conf.TlsKey)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		options = append(options, kgo.DialTLSConfig(tlsConfig))
+	}
+	return options, nil
+}
+
+func (k *Kafka) newClient(opts ...kgo.Opt) (*kgo.Client, error) {
+	options, err := kafkaOptions(k.conf)
+	if err != nil {
+		return nil, err
+	}
+	return kgo.NewClient(append(options, opts...)...)
+}
+
+func (k *Kafka) producer() (*kgo.Client, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.client == nil {
+		return nil, errors.New("kafka client closed")
+	}
+	return k.client, nil
+}
+
+func (k *Kafka) topic(queue string) string {
+	return fmt.Sprintf("%s.%s", k.prefix, kafkaNameReplacer.Replace(queue))
+}
+
+// Publish same as ForcePublish, jobs are not deduplicated by rateLimit,
+// a job deduplicated while the previous one is consuming would be lost
+func (k *Kafka) Publish(queue, class string, args interface{}) error {
+	return k.ForcePublish(queue, class, args)
+}
+
+// ForcePublish produce and wait broker ack, job persisted when return nil
+func (k *Kafka) ForcePublish(queue, class string, args interface{}) error {
+	msg, err := NewMessage(queue, class, args)
+	if err != nil {
+		return err
+	}
+	return k.produce(k.topic(queue), msg)
+}
+
+func (k *Kafka) SubscribePublish(message any) error {
+	m, err := commonMessage(message)
+	if err != nil {
+		return err
+	}
+	return k.ForcePublish(m.Queue, m.Class, json.RawMessage(m.Data))
+}
+
+func (k *Kafka) produce(topic string, msg *Message) error {
+	client, err := k.producer()
+	if err != nil {
+		return err
+	}
+	data, _ := json.Marshal(msg)
+	ctx, cancel := context.WithTimeout(context.Background(), kafkaRequestTimeout)
+	defer cancel()
+	return client.ProduceSync(ctx, &kgo.Record{Topic: topic, Key: []byte(msg.Id), Value: data}).FirstErr()
+}
+
+func (k *Kafka) Process(queue string, concurrency int, handler Handler) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.handlers[queue] = queueHandler{concurrency: max(concurrency, 1), handler: handler}
+}
+
+func (k *Kafka) Consumption() {
+	k.mu.Lock()
+	handlers := make(map[string]queueHandler, len(k.handlers))
+	for queue, h := range k.handlers {
+		handlers[queue] = h
+	}
+	k.mu.Unlock()
+	var topics []string
+	for queue := range handlers {
+		topics = append(topics, k.topic(queue), k.topic(queue)+".retry")
+	}
+	if err := k.ensureTopics(topics); err != nil {
+		panic(fmt.Errorf("kafka create topics failed: %v", err))
+	}
+	for queue, h := range handlers {
+		k.wg.Add(2)
+		go k.consume(k.topic(queue), h, false)
+		go k.consume(k.topic(queue)+".retry", queueHandler{concurrency: 1, handler: h.handler}, true)
+	}
+	k.wg.Wait()
+}
+
+// ensureTopics create topics with default partitions and replication factor of brokers
+func (k *Kafka) ensureTopics(topics []string) error {
+	if len(topics) == 0 {
+		return nil
+	}
+	client, err := k.producer()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), kafkaRequestTimeout)
+	defer cancel()
+	resp, err := kadm.NewClient(client).CreateTopics(ctx, -1, -1, nil, topics...)
+	if err != nil {
+		return err
+	}
+	for _, t := range resp {
+		if t.Err != nil && !errors.Is(t.Err, kerr.TopicAlreadyExists) {
+			return fmt.Errorf("%s: %v", t.Topic, t.Err)
+		}
+	}
+	return nil
+}
+
+// consume poll records, process concurrently, commit offsets after records of poll are handled,
+// rebalance is blocked while a poll is processing
+func (k *Kafka) consume(topic string, h queueHandler, delayed bool) {
+	defer k.wg.Done()
+	client, err := k.newClient(
+		kgo.ConsumerGroup(k.conf.Group),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.DisableAutoCommit(),
+		kgo.BlockRebalanceOnPoll(),
+	)
+	if err != nil {
+		util.Logger().Error(fmt.Errorf("kafka create consumer of %s failed: %v", topic, err))
+		return
+	}
+	k.mu.Lock()
+	k.consumers = append(k.consumers, client)
+	k.mu.Unlock()
+	for k.ctx.Err() == nil {
+		fetches := client.PollRecords(k.ctx, h.concurrency)
+		if fetches.IsClientClosed() {
+			return
+		}
+		fetches.EachError(func(t string, p int32, err error) {
+			if !errors.Is(err, context.Canceled) {
+				util.Logger().Error(fmt.Errorf("kafka poll %s/%d failed: %v", t, p, err))
+			}
+		})
+		records := fetches.Records()
+		if len(records) == 0 {
+			client.AllowRebalance()
+			continue
+		}
+		done := make([]bool, len(records))
+		var (
+			wg  sync.WaitGroup
+			sem = make(chan struct{}, h.concurrency)
+		)
+		for i := range records {
+			if delayed && !k.waitNotBefore(records[i].Value) {
+				break
+			}
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int) {
+				defer func() { <-sem; wg.Done() }()
+				if err := k.handle(records[i], h.handler); err != nil {
+					util.Logger().Error(err)
+					return
+				}
+				done[i] = true
+			}(i)
+		}
+		wg.Wait()
+		commit, rewind := kafkaSettle(records, done)
+		if len(commit) > 0 {
+			ctx, cancel := context.WithTimeout(context.Background(), kafkaRequestTimeout)
+			if err = client.CommitRecords(ctx, commit...); err != nil {
+				util.Logger().Error(fmt.Errorf("kafka commit %s failed: %v", topic, err))
+			}
+			cancel()
+		}
+		if len(rewind) > 0 {
+			// records not handled are consumed again from the first of them
+			client.SetOffsets(rewind)
+		}
+		client.AllowRebalance()
+		if len(rewind) > 0 && k.ctx.Err() == nil {
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// kafkaSettle records of every partition before the first not done record are committed,
+// partition is rewound to the first not done record, records are ordered by offset in a partition
+func kafkaSettle(records []*kgo.Record, done []bool) (commit []*kgo.Record, rewind map[string]map[int32]kgo.EpochOffset) {
+	for i, r := range records {
+		if _, failed := rewind[r.Topic][r.Partition]; failed {
+			continue
+		}
+		if done[i] {
+			commit = append(commit, r)
+			continue
+		}
+		if rewind == nil {
+			rewind = make(map[string]map[int32]kgo.EpochOffset)
+		}
+		if rewind[r.Topic] == nil {
+			rewind[r.Topic] = make(map[int32]kgo.EpochOffset)
+		}
+		rewind[r.Topic][r.Partition] = kgo.EpochOffset{Epoch: r.LeaderEpoch, Offset: r.Offset}
+	}
+	return commit, rewind
+}
+
+// waitNotBefore wait retry record until not_before, return false if stopped
+func (k *Kafka) waitNotBefore(value []byte) bool {
+	var msg Message
+	_ = json.Unmarshal(value, &msg)
+	if wait := time.Until(time.UnixMilli(msg.NotBefore)); wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-k.ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// handle process record, error is returned if failed job can not be republished to retry topic
+func (k *Kafka) handle(r *kgo.Record, handler Handler) error {
+	var msg Message
+	if err := json.Unmarshal(r.Value, &msg); err != nil {
+		util.Logger().Error(fmt.Errorf("kafka drop invalid record %s/%d/%d: %v", r.Topic, r.Partition, r.Offset, err))
+		return nil
+	}
+	if retry, delay := Handle(context.Background(), &msg, handler); retry {
+		msg.NotBefore = time.Now().Add(delay).UnixMilli()
+		if err := k.produce(k.topic(msg.Queue)+".retry", &msg); err != nil {
+			return fmt.Errorf("kafka retry %s/%s failed: %v", msg.Queue, msg.Id, err)
+		}
+	}
+	return nil
+}
+
+func (k *Kafka) Shutdown(context.Context) error {
+	k.cancel()
+	k.wg.Wait()
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for _, consumer := range k.consumers {
+		consumer.Close()
+	}
+	k.consumers = nil
+	if k.client != nil {
+		k.client.Close()
+		k.client = nil
+	}
+	return nil
+}
+
+// QueueDepth sum lag of consumer group on topic partitions
+func (k *Kafka) QueueDepth(ctx context.Context, queue string) (int, error) {
+	client, err := k.producer()
+	if err != nil {
+		return 0, err
+	}
+	lags, err := kadm.NewClient(client).Lag(ctx, k.conf.Group)
+	if err != nil {
+		return 0, err
+	}
+	lag, ok := lags[k.conf.Group]
+	if !ok {
+		return 0, nil
+	}
+	if err = lag.Error(); err != nil {
+		return 0, err
+	}
+	return int(lag.Lag.TotalByTopic()[k.topic(queue)].Lag), nil
+}
@@ -0,0 +1,123 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Memory in process queue backend for tests and single process development, jobs lost on exit
+type Memory struct {
+	mu       sync.Mutex
+	queues   map[string]chan *Message
+	handlers map[string]queueHandler
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// queueHandler handler of queue registered by Process
+type queueHandler struct {
+	concurrency int
+	handler     Handler
+}
+
+const memoryQueueSize = 100000
+
+func NewMemory() *Memory {
+	return &Memory{
+		queues:   make(map[string]chan *Message),
+		handlers: make(map[string]queueHandler),
+		stop:     make(chan struct{}),
+	}
+}
+
+func (m *Memory) Type() string {
+	return MemoryName
+}
+
+func (m *Memory) Init() {}
+
+func (m *Memory) queue(name string) chan *Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	q, ok := m.queues[name]
+	if !ok {
+		q = make(chan *Message, memoryQueueSize)
+		m.queues[name] = q
+	}
+	return q
+}
+
+func (m *Memory) Publish(queue, class string, args interface{}) error {
+	return m.ForcePublish(queue, class, args)
+}
+
+func (m *Memory) ForcePublish(queue, class string, args interface{}) error {
+	msg, err := NewMessage(queue, class, args)
+	if err != nil {
+		return err
+	}
+	m.queue(queue) <- msg
+	return nil
+}
+
+func (m *Memory) SubscribePublish(message any) error {
+	c, err := commonMessage(message)
+	if err != nil {
+		return err
+	}
+	return m.ForcePublish(c.Queue, c.Class, json.RawMessage(c.Data))
+}
+
+func (m *Memory) Process(queue string, concurrency int, handler Handler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[queue] = queueHandler{concurrency: max(concurrency, 1), handler: handler}
+}
+
+func (m *Memory) Consumption() {
+	m.mu.Lock()
+	handlers := make(map[string]queueHandler, len(m.handlers))
+	for queue, h := range m.handlers {
+		handlers[queue] = h
+	}
+	m.mu.Unlock()
+	for queue, h := range handlers {
+		q := m.queue(queue)
+		for i := 0; i < h.concurrency; i++ {
+			m.wg.Add(1)
+			go m.consume(q, h.handler)
+		}
+	}
+	m.wg.Wait()
+}
+
+func (m *Memory) consume(q chan *Message, handler Handler) {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case msg := <-q:
+			if retry, delay := Handle(context.Background(), msg, handler); retry {
+				time.AfterFunc(delay, func() { q <- msg })
+			}
+		}
+	}
+}
+
+func (m *Memory) Shutdown(context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	select {
+	case <-m.stop:
+	default:
+		close(m.stop)
+	}
+	return nil
+}
+
+func (m *Memory) QueueDepth(_ context.Context, queue string) (int, error) {
+	return len(m.queue(queue)), nil
+}
@@ -0,0 +1,127 @@
+package mq
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/share/metrics"
+	"github.com/itering/subscan/util"
+)
+
+// Message job envelope shared by all backends
+type Message struct {
+	Id        string          `json:"id"`
+	Queue     string          `json:"queue"`
+	Class     string          `json:"class"`
+	Args      json.RawMessage `json:"args"`
+	Attempt   int             `json:"attempt,omitempty"`    // failed attempts
+	NotBefore int64           `json:"not_before,omitempty"` // unix milli, retry not delivered before
+	Error     string          `json:"error,omitempty"`      // last error
+	FailedAt  int64           `json:"failed_at,omitempty"`  // unix, moved to dead letter queue at
+}
+
+// Handler process job of queue, args is json of published args
+type Handler func(ctx context.Context, queue, class string, args *simplejson.Json) error
+
+func NewMessage(queue, class string, args interface{}) (*Message, error) {
+	raw, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{Id: newMessageId(), Queue: queue, Class: class, Args: raw}, nil
+}
+
+func newMessageId() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%x", b)
+}
+
+// RetryPolicy failed job retried with exponential backoff, MaxAttempts <= 0 means retry forever
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Delay of retry after attempt times failed
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+var (
+	defaultRetry  = RetryPolicy{MaxAttempts: 10, Backoff: time.Second, MaxBackoff: 5 * time.Minute}
+	retryPolicies = make(map[string]RetryPolicy)
+)
+
+func setRetryPolicies(c *configs.MQ) {
+	if c == nil || c.Retry == nil {
+		return
+	}
+	convert := func(p *configs.RetryPolicy) RetryPolicy {
+		return RetryPolicy{MaxAttempts: p.MaxAttempts, Backoff: time.Duration(p.Backoff) * time.Second, MaxBackoff: time.Duration(p.MaxBackoff) * time.Second}
+	}
+	defaultRetry = convert(c.Retry)
+	for queue, p := range c.Queues {
+		retryPolicies[queue] = convert(p)
+	}
+}
+
+// Policy retry policy of queue
+func Policy(queue string) RetryPolicy {
+	if p, ok := retryPolicies[queue]; ok {
+		return p
+	}
+	return defaultRetry
+}
+
+// Handle process message by handler, failed message is retried with backoff, moved to dead letter queue after max attempts
+// return retry and delay if backend should deliver message again
+func Handle(ctx context.Context, msg *Message, handler Handler) (retry bool, delay time.Duration) {
+	startTime := time.Now()
+	err := call(ctx, msg, handler)
+	metrics.WorkerProcessCost.WithLabelValues(msg.Queue, msg.Class).Observe(time.Since(startTime).Seconds())
+	if err == nil {
+		return false, 0
+	}
+	msg.Attempt++
+	msg.Error = err.Error()
+	policy := Policy(msg.Queue)
+	delay = policy.Delay(msg.Attempt)
+	if policy.MaxAttempts > 0 && msg.Attempt >= policy.MaxAttempts {
+		msg.FailedAt = time.Now().Unix()
+		if err = DeadLetters.Add(ctx, msg); err != nil {
+			util.Logger().Error(fmt.Errorf("mq add dead letter %s/%s failed: %v", msg.Queue, msg.Id, err))
+			return true, delay
+		}
+		metrics.WorkerDeadLetter.WithLabelValues(msg.Queue, msg.Class).Inc()
+		return false, 0
+	}
+	metrics.WorkerRetry.WithLabelValues(msg.Queue, msg.Class).Inc()
+	return true, delay
+}
+
+func call(ctx context.Context, msg *Message, handler Handler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	args, err := simplejson.NewJson(msg.Args)
+	if err != nil {
+		return err
+	}
+	return handler(ctx, msg.Queue, msg.Class, args)
+}
@@ -0,0 +1,317 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itering/subscan/configs"
+	"github.com/itering/subscan/util"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Nats jetstream backend, one work queue stream of network, one durable pull consumer of every queue
+// failed job is NAK with backoff delay, attempt is delivered count of jetstream
+// reconnect and re-subscribe are handled by nats.go, every fetch is a new pull request
+type Nats struct {
+	conf   configs.Nats
+	stream string
+	prefix string // subject prefix, subject of queue is {prefix}.{queue}
+
+	mu       sync.Mutex
+	conn     *nats.Conn
+	js       jetstream.JetStream
+	handlers map[string]queueHandler
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+const (
+	natsRequestTimeout = 5 * time.Second
+	natsFetchExpires   = 5 * time.Second
+	natsAckWait        = 10 * time.Minute
+)
+
+var natsNameReplacer = strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_", "/", "_", "\\", "_")
+
+func NewNats(conf configs.Nats) *Nats {
+	network := natsNameReplacer.Replace(strings.ToLower(util.NetworkNode))
+	stream := conf.Stream
+	if stream == "" {
+		stream = "subscan_" + network
+	}
+	return &Nats{
+		conf:     conf,
+		stream:   natsNameReplacer.Replace(stream),
+		prefix:   "subscan." + network,
+		handlers: make(map[string]queueHandler),
+		stop:     make(chan struct{}),
+	}
+}
+
+func (n *Nats) Type() string {
+	return NatsName
+}
+
+// Init connect server, create work queue stream if not exists
+func (n *Nats) Init() {
+	if err := n.connect(); err != nil {
+		panic(fmt.Errorf("nats connect %s failed: %v", n.conf.Url, err))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), natsRequestTimeout)
+	defer cancel()
+	if err := n.ensureStream(ctx); err != nil {
+		panic(fmt.Errorf("nats init stream %s failed: %v", n.stream, err))
+	}
+}
+
+// natsOptions auth and tls options of config, tls is also enabled by tls:// url
+func natsOptions(conf configs.Nats) ([]nats.Option, error) {
+	options := []nats.Option{
+		nats.Name("subscan"),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				util.Logger().Error(fmt.Errorf("nats connection lost: %v", err))
+			}
+		}),
+		nats.ReconnectHandler(func(conn *nats.Conn) {
+			util.Logger().Info(fmt.Sprintf("nats reconnected to %s", conn.ConnectedUrl()))
+		}),
+	}
+	if conf.Token != "" {
+		options = append(options, nats.Token(conf.Token))
+	}
+	if conf.Creds != "" {
+		options = append(options, nats.UserCredentials(conf.Creds))
+	}
+	if conf.NkeySeed != "" {
+		option, err := nats.NkeyOptionFromSeed(conf.NkeySeed)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	if conf.TlsCa != "" {
+		options = append(options, nats.RootCAs(conf.TlsCa))
+	}
+	if conf.TlsCert != "" || conf.TlsKey != "" {
+		options = append(options, nats.ClientCert(conf.TlsCert, conf.TlsKey))
+	}
+	return options, nil
+}
+
+func (n *Nats) connect() error {
+	options, err := natsOptions(n.conf)
+	if err != nil {
+		return err
+	}
+	conn, err := nats.Connect(n.conf.Url, options...)
+	if err != nil {
+		return err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	n.mu.Lock()
+	n.conn, n.js = conn, js
+	n.mu.Unlock()
+	return nil
+}
+
+func (n *Nats) jetStream() (jetstream.JetStream, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.js == nil {
+		return nil, errors.New("nats connection closed")
+	}
+	return n.js, nil
+}
+
+func (n *Nats) subject(queue string) string {
+	return fmt.Sprintf("%s.%s", n.prefix, natsNameReplacer.Replace(queue))
+}
+
+func (n *Nats) durable(queue string) string {
+	return natsNameReplacer.Replace(queue)
+}
+
+func (n *Nats) ensureStream(ctx context.Context) error {
+	js, err := n.jetStream()
+	if err != nil {
+		return err
+	}
+	_, err = js.Stream(ctx, n.stream)
+	if !errors.Is(err, jetstream.ErrStreamNotFound) {
+		return err
+	}
+	_, err = js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:      n.stream,
+		Subjects:  []string{n.prefix + ".>"},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	})
+	return err
+}
+
+func (n *Nats) ensureConsumer(ctx context.Context, queue string) (jetstream.Consumer, error) {
+	js, err := n.jetStream()
+	if err != nil {
+		return nil, err
+	}
+	return js.CreateOrUpdateConsumer(ctx, n.stream, jetstream.ConsumerConfig{
+		Durable:       n.durable(queue),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		DeliverPolicy: jetstream.DeliverAllPolicy,
+		FilterSubject: n.subject(queue),
+		AckWait:       natsAckWait,
+		MaxDeliver:    -1,
+	})
+}
+
+func (n *Nats) Publish(queue, class string, args interface{}) error {
+	if rateLimit(context.TODO(), queue, class, args) {
+		return nil
+	}
+	return n.ForcePublish(queue, class, args)
+}
+
+// ForcePublish publish and wait jetstream ack, job persisted when return nil
+func (n *Nats) ForcePublish(queue, class string, args interface{}) error {
+	msg, err := NewMessage(queue, class, args)
+	if err != nil {
+		return err
+	}
+	js, err := n.jetStream()
+	if err != nil {
+		return err
+	}
+	data, _ := json.Marshal(msg)
+	ctx, cancel := context.WithTimeout(context.Background(), natsRequestTimeout)
+	defer cancel()
+	_, err = js.Publish(ctx, n.subject(queue), data)
+	return err
+}
+
+func (n *Nats) SubscribePublish(message any) error {
+	m, err := commonMessage(message)
+	if err != nil {
+		return err
+	}
+	return n.ForcePublish(m.Queue, m.Class, json.RawMessage(m.Data))
+}
+
+func (n *Nats) Process(queue string, concurrency int, handler Handler) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.handlers[queue] = queueHandler{concurrency: max(concurrency, 1), handler: handler}
+}
+
+func (n *Nats) Consumption() {
+	n.mu.Lock()
+	handlers := make(map[string]queueHandler, len(n.handlers))
+	for queue, h := range n.handlers {
+		handlers[queue] = h
+	}
+	n.mu.Unlock()
+	for queue, h := range handlers {
+		ctx, cancel := context.WithTimeout(context.Background(), natsRequestTimeout)
+		consumer, err := n.ensureConsumer(ctx, queue)
+		cancel()
+		if err != nil {
+			panic(fmt.Errorf("nats create consumer %s failed: %v", queue, err))
+		}
+		n.wg.Add(1)
+		go n.consume(queue, consumer, h)
+	}
+	n.wg.Wait()
+}
+
+// consume pull batch of concurrency jobs, process concurrently, ack or nak with delay
+func (n *Nats) consume(queue string, consumer jetstream.Consumer, h queueHandler) {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.stop:
+			return
+		default:
+		}
+		batch, err := consumer.Fetch(h.concurrency, jetstream.FetchMaxWait(natsFetchExpires))
+		if err != nil {
+			util.Logger().Error(fmt.Errorf("nats fetch %s failed: %v", queue, err))
+			time.Sleep(time.Second)
+			continue
+		}
+		var wg sync.WaitGroup
+		for m := range batch.Messages() {
+			wg.Add(1)
+			go func(m jetstream.Msg) {
+				defer wg.Done()
+				n.handle(m, h.handler)
+			}(m)
+		}
+		wg.Wait()
+		if err = batch.Error(); err != nil && !errors.Is(err, nats.ErrTimeout) {
+			util.Logger().Error(fmt.Errorf("nats fetch %s failed: %v", queue, err))
+		}
+	}
+}
+
+func (n *Nats) handle(m jetstream.Msg, handler Handler) {
+	var msg Message
+	if err := json.Unmarshal(m.Data(), &msg); err != nil {
+		util.Logger().Error(fmt.Errorf("nats drop invalid message of %s: %v", m.Subject(), err))
+		_ = m.Term()
+		return
+	}
+	if meta, err := m.Metadata(); err == nil && meta.NumDelivered > 0 {
+		msg.Attempt = int(meta.NumDelivered) - 1
+	}
+	if retry, delay := Handle(context.Background(), &msg, handler); retry {
+		_ = m.NakWithDelay(delay)
+		return
+	}
+	_ = m.Ack()
+}
+
+func (n *Nats) Shutdown(context.Context) error {
+	select {
+	case <-n.stop:
+	default:
+		close(n.stop)
+	}
+	n.wg.Wait()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn != nil {
+		_ = n.conn.Drain()
+		n.conn, n.js = nil, nil
+	}
+	return nil
+}
+
+func (n *Nats) QueueDepth(ctx context.Context, queue string) (int, error) {
+	js, err := n.jetStream()
+	if err != nil {
+		return 0, err
+	}
+	consumer, err := js.Consumer(ctx, n.stream, n.durable(queue))
+	if errors.Is(err, jetstream.ErrConsumerNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	info, err := consumer.Info(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int(info.NumPending), nil
+}